@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 const (
@@ -33,12 +34,23 @@ const (
 	DefaultNamedPortGRPC = "grpc"
 	// DefaultNamedPortMetrics is the default name for metrics port.
 	DefaultNamedPortMetrics = "metrics"
+	// DefaultEndpointPickerPort is the default gRPC port of the inference pool endpoint picker.
+	DefaultEndpointPickerPort = 9002
+
+	// InferenceExtensionGroup is the API group of the Gateway API Inference Extension.
+	InferenceExtensionGroup = "inference.networking.x-k8s.io"
+	// InferenceExtensionVersion is the API version of the Gateway API Inference Extension.
+	InferenceExtensionVersion = "v1alpha2"
+	// InferencePoolLabelKey is the pod label selecting the members of an inference pool.
+	InferencePoolLabelKey = "nvidia.com/inference-pool"
 )
 
 // Expose defines attributes to expose the service.
 type Expose struct {
 	Service Service `json:"service,omitempty"`
 	Ingress Ingress `json:"ingress,omitempty"`
+	// Router exposes the service through Gateway API routes attached to an existing Gateway.
+	Router *Router `json:"router,omitempty"`
 }
 
 // Service defines attributes to create a service.
@@ -71,6 +83,8 @@ type Service struct {
 type ExposeV1 struct {
 	Service Service   `json:"service,omitempty"`
 	Ingress IngressV1 `json:"ingress,omitempty"`
+	// Router exposes the service through Gateway API routes attached to an existing Gateway.
+	Router *Router `json:"router,omitempty"`
 }
 
 // Metrics defines attributes to setup metrics collection.
@@ -88,13 +102,93 @@ type ServiceMonitor struct {
 	ScrapeTimeout    promv1.Duration   `json:"scrapeTimeout,omitempty"`
 }
 
+// AutoscalingBackend is the autoscaler used to scale a service.
+// +kubebuilder:validation:Enum=HPA;KEDA
+type AutoscalingBackend string
+
+const (
+	// AutoscalingBackendHPA scales the service with a HorizontalPodAutoscaler on the given metric specs.
+	AutoscalingBackendHPA AutoscalingBackend = "HPA"
+	// AutoscalingBackendKEDA scales the service with a KEDA ScaledObject on Prometheus triggers.
+	AutoscalingBackendKEDA AutoscalingBackend = "KEDA"
+)
+
 // Autoscaling defines attributes to automatically scale the service based on metrics.
 type Autoscaling struct {
-	Enabled     *bool                       `json:"enabled,omitempty"`
+	Enabled *bool `json:"enabled,omitempty"`
+	// Backend is the autoscaler used to scale the service, KEDA is only supported for NIMService.
+	// +kubebuilder:default=HPA
+	Backend     AutoscalingBackend          `json:"backend,omitempty"`
 	HPA         HorizontalPodAutoscalerSpec `json:"hpa,omitempty"`
+	KEDA        *KEDAScaledObjectSpec       `json:"keda,omitempty"`
 	Annotations map[string]string           `json:"annotations,omitempty"`
 }
 
+// GetBackend returns the autoscaler used to scale the service.
+func (a *Autoscaling) GetBackend() AutoscalingBackend {
+	if a.Backend == "" {
+		return AutoscalingBackendHPA
+	}
+	return a.Backend
+}
+
+// KEDAScaledObjectSpec defines the parameters required to setup a KEDA ScaledObject.
+type KEDAScaledObjectSpec struct {
+	// MinReplicas is the minimum number of replicas, 0 scales the service to zero when all triggers are inactive.
+	// Defaults to 1, as KEDA would otherwise scale the service to zero.
+	// +kubebuilder:validation:Minimum=0
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// PollingInterval is the interval in seconds at which KEDA checks each trigger.
+	// +kubebuilder:validation:Minimum=1
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+	// CooldownPeriod is the period in seconds to wait after the last active trigger before scaling to zero.
+	// +kubebuilder:validation:Minimum=0
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+	// PrometheusServerAddress is the address of the Prometheus server scraping the NIM metrics.
+	// +kubebuilder:validation:MinLength=1
+	PrometheusServerAddress string `json:"prometheusServerAddress"`
+	// +kubebuilder:validation:MinItems=1
+	Triggers []KEDAPrometheusTrigger                        `json:"triggers"`
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// GetMinReplicas returns the minimum number of replicas of the ScaledObject.
+func (k *KEDAScaledObjectSpec) GetMinReplicas() int32 {
+	if k.MinReplicas == nil {
+		return 1
+	}
+	return *k.MinReplicas
+}
+
+// NIMMetric is a NIM inference metric to scale on.
+// +kubebuilder:validation:Enum=QueueDepth;RequestsWaiting;KVCacheUtilization
+type NIMMetric string
+
+const (
+	// NIMMetricQueueDepth is the number of requests running and waiting, averaged per replica.
+	NIMMetricQueueDepth NIMMetric = "QueueDepth"
+	// NIMMetricRequestsWaiting is the number of requests waiting to be scheduled, averaged per replica.
+	NIMMetricRequestsWaiting NIMMetric = "RequestsWaiting"
+	// NIMMetricKVCacheUtilization is the GPU KV-cache usage ratio, between 0 and 1, averaged across replicas.
+	NIMMetricKVCacheUtilization NIMMetric = "KVCacheUtilization"
+)
+
+// KEDAPrometheusTrigger defines a Prometheus trigger scaling the service on a NIM metric or a custom query.
+type KEDAPrometheusTrigger struct {
+	// Metric is the NIM inference metric to scale on, exclusive with query.
+	Metric NIMMetric `json:"metric,omitempty"`
+	// Query is a custom PromQL query to scale on, exclusive with metric.
+	Query string `json:"query,omitempty"`
+	// Threshold is the target value of the trigger.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Threshold string `json:"threshold"`
+	// ActivationThreshold is the value above which the trigger is active, scaling the service up from zero.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	ActivationThreshold string `json:"activationThreshold,omitempty"`
+}
+
 // HorizontalPodAutoscalerSpec defines the parameters required to setup HPA.
 type HorizontalPodAutoscalerSpec struct {
 	MinReplicas *int32                                         `json:"minReplicas,omitempty"`
@@ -128,6 +222,88 @@ type IngressV1 struct {
 	Spec        *IngressSpec      `json:"spec,omitempty"`
 }
 
+// Router defines attributes to expose the service through Gateway API routes.
+// An HTTPRoute is created for the api port, and a GRPCRoute for the grpc port when it is set.
+type Router struct {
+	// Gateway is the Gateway the routes are attached to.
+	Gateway GatewayReference `json:"gateway"`
+	// Hostnames are the hostnames the routes match requests against.
+	// Defaults to the hostnames of the Gateway listener.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^(\*\.)?[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Hostnames   []string          `json:"hostnames,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// InferencePool routes requests through a Gateway API Inference Extension InferencePool instead of the service,
+	// so that an endpoint picker can balance requests on model, queue and KV-cache awareness.
+	// Note: This is only applicable to NIMService.
+	InferencePool *InferencePoolRouting `json:"inferencePool,omitempty"`
+}
+
+// InferencePoolRouting defines attributes for model-aware routing with the Gateway API Inference Extension.
+type InferencePoolRouting struct {
+	// Name is the name of the InferencePool. Services in the same namespace using the same pool name
+	// share the pool, and requests for a model are routed across all of them.
+	//
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+	// EndpointPicker is the endpoint picker extension selecting the pool endpoint for each request.
+	EndpointPicker EndpointPickerReference `json:"endpointPicker"`
+	// Criticality is the criticality of the models served through the pool.
+	//
+	// +kubebuilder:validation:Enum=Critical;Standard;Sheddable
+	// +kubebuilder:default:=Standard
+	Criticality string `json:"criticality,omitempty"`
+}
+
+// EndpointPickerReference references the endpoint picker service of an inference pool.
+type EndpointPickerReference struct {
+	// Name is the name of the endpoint picker service.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Port is the gRPC port of the endpoint picker service (default: 9002).
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default:=9002
+	Port *int32 `json:"port,omitempty"`
+	// FailureMode configures the gateway behavior when the endpoint picker is unavailable.
+	//
+	// +kubebuilder:validation:Enum=FailOpen;FailClose
+	// +kubebuilder:default:=FailClose
+	FailureMode string `json:"failureMode,omitempty"`
+}
+
+// GetPort returns the port of the endpoint picker service.
+func (e *EndpointPickerReference) GetPort() int32 {
+	if e.Port == nil {
+		return DefaultEndpointPickerPort
+	}
+	return *e.Port
+}
+
+// GetFailureMode returns the failure mode of the endpoint picker.
+func (e *EndpointPickerReference) GetFailureMode() string {
+	if e.FailureMode == "" {
+		return "FailClose"
+	}
+	return e.FailureMode
+}
+
+// GatewayReference identifies the Gateway API Gateway to attach routes to.
+type GatewayReference struct {
+	// Name is the name of the Gateway.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Namespace is the namespace of the Gateway, defaults to the namespace of the service.
+	Namespace string `json:"namespace,omitempty"`
+	// SectionName is the name of the Gateway listener to attach to, defaults to all listeners.
+	SectionName string `json:"sectionName,omitempty"`
+}
+
 // ResourceRequirements defines the resources required for a container.
 type ResourceRequirements struct {
 	// Limits describes the maximum amount of compute resources allowed.
@@ -184,6 +360,98 @@ func (i *IngressV1) GenerateNetworkingV1IngressSpec(name string) networkingv1.In
 	return ingressSpec
 }
 
+func (r *Router) getParentRefs() []gatewayv1.ParentReference {
+	parentRef := gatewayv1.ParentReference{
+		Name: gatewayv1.ObjectName(r.Gateway.Name),
+	}
+	if r.Gateway.Namespace != "" {
+		parentRef.Namespace = ptr.To(gatewayv1.Namespace(r.Gateway.Namespace))
+	}
+	if r.Gateway.SectionName != "" {
+		parentRef.SectionName = ptr.To(gatewayv1.SectionName(r.Gateway.SectionName))
+	}
+	return []gatewayv1.ParentReference{parentRef}
+}
+
+func (r *Router) getHostnames() []gatewayv1.Hostname {
+	var hostnames []gatewayv1.Hostname
+	for _, hostname := range r.Hostnames {
+		hostnames = append(hostnames, gatewayv1.Hostname(hostname))
+	}
+	return hostnames
+}
+
+// GenerateGatewayHTTPRouteSpec returns the HTTPRoute spec routing all requests to the given service port.
+func (r *Router) GenerateGatewayHTTPRouteSpec(name string, port int32) gatewayv1.HTTPRouteSpec {
+	backendRef := gatewayv1.BackendObjectReference{
+		Name: gatewayv1.ObjectName(name),
+		Port: ptr.To(gatewayv1.PortNumber(port)),
+	}
+	return r.generateGatewayHTTPRouteSpec(backendRef)
+}
+
+// GenerateGatewayInferencePoolHTTPRouteSpec returns the HTTPRoute spec routing all requests to the inference pool.
+func (r *Router) GenerateGatewayInferencePoolHTTPRouteSpec() gatewayv1.HTTPRouteSpec {
+	backendRef := gatewayv1.BackendObjectReference{
+		Group: ptr.To(gatewayv1.Group(InferenceExtensionGroup)),
+		Kind:  ptr.To(gatewayv1.Kind("InferencePool")),
+		Name:  gatewayv1.ObjectName(r.InferencePool.Name),
+	}
+	return r.generateGatewayHTTPRouteSpec(backendRef)
+}
+
+func (r *Router) generateGatewayHTTPRouteSpec(backendRef gatewayv1.BackendObjectReference) gatewayv1.HTTPRouteSpec {
+	return gatewayv1.HTTPRouteSpec{
+		CommonRouteSpec: gatewayv1.CommonRouteSpec{
+			ParentRefs: r.getParentRefs(),
+		},
+		Hostnames: r.getHostnames(),
+		Rules: []gatewayv1.HTTPRouteRule{
+			{
+				Matches: []gatewayv1.HTTPRouteMatch{
+					{
+						Path: &gatewayv1.HTTPPathMatch{
+							Type:  ptr.To(gatewayv1.PathMatchPathPrefix),
+							Value: ptr.To("/"),
+						},
+					},
+				},
+				BackendRefs: []gatewayv1.HTTPBackendRef{
+					{
+						BackendRef: gatewayv1.BackendRef{
+							BackendObjectReference: backendRef,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// GenerateGatewayGRPCRouteSpec returns the GRPCRoute spec routing all requests to the given service port.
+func (r *Router) GenerateGatewayGRPCRouteSpec(name string, port int32) gatewayv1.GRPCRouteSpec {
+	return gatewayv1.GRPCRouteSpec{
+		CommonRouteSpec: gatewayv1.CommonRouteSpec{
+			ParentRefs: r.getParentRefs(),
+		},
+		Hostnames: r.getHostnames(),
+		Rules: []gatewayv1.GRPCRouteRule{
+			{
+				BackendRefs: []gatewayv1.GRPCBackendRef{
+					{
+						BackendRef: gatewayv1.BackendRef{
+							BackendObjectReference: gatewayv1.BackendObjectReference{
+								Name: gatewayv1.ObjectName(name),
+								Port: ptr.To(gatewayv1.PortNumber(port)),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 type IngressSpec struct {
 	// +kubebuilder:validation:Pattern=`[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*`
 	IngressClassName string        `json:"ingressClassName"`
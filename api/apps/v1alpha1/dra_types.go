@@ -30,7 +30,7 @@ import (
 //
 // When creating the NIMService pods, it adds a name (`DNS_LABEL` format) to it
 // that uniquely identifies the DRA resource.
-// +kubebuilder:validation:XValidation:rule="(has(self.resourceClaimName) ? 1 : 0) + (has(self.resourceClaimTemplateName) ? 1 : 0) + (has(self.claimCreationSpec) ? 1 : 0) == 1",message="exactly one of spec.resourceClaimName, spec.resourceClaimTemplateName, or spec.claimCreationSpec must be set."
+// +kubebuilder:validation:XValidation:rule="(has(self.resourceClaimName) ? 1 : 0) + (has(self.resourceClaimTemplateName) ? 1 : 0) + (has(self.claimCreationSpec) ? 1 : 0) + (has(self.auto) ? 1 : 0) == 1",message="exactly one of spec.resourceClaimName, spec.resourceClaimTemplateName, spec.claimCreationSpec, or spec.auto must be set."
 type DRAResource struct {
 	// ResourceClaimName is the name of a DRA resource claim object in the same
 	// namespace as the NIMService.
@@ -61,6 +61,12 @@ type DRAResource struct {
 	// Only one of ClaimCreationSpec, ResourceClaimName or ResourceClaimTemplateName must be specified.
 	ClaimCreationSpec *DRAClaimCreationSpec `json:"claimCreationSpec,omitempty"`
 
+	// Auto auto-generates a DRA resource claim template from the NIMCache profile served,
+	// requesting as many devices as its tensor parallelism, matching the GPU product and memory it is optimized for.
+	// Only one of Auto, ClaimCreationSpec, ResourceClaimName or ResourceClaimTemplateName must be specified.
+	// Only supported for single-node NIMServices on the standalone platform, with either a NIMCache profile or a NIMBuild selected.
+	Auto *DRAAutoClaimSpec `json:"auto,omitempty"`
+
 	// Requests is the list of requests in the referenced DRA resource claim.
 	// to be made available to the model container of the NIMService pods.
 	//
@@ -266,6 +272,85 @@ type DRADeviceSpec struct {
 	CapacitySelectors []DRAResourceQuantitySelector `json:"capacitySelectors,omitempty"`
 	// CELExpressions is a list of CEL expressions that must be satisfied by the DRA device.
 	CELExpressions []string `json:"celExpressions,omitempty"`
+	// MIG requests MIG partitions of GPUs instead of full GPUs.
+	// The devices are then requested from the mig.nvidia.com device class unless another device class is set.
+	MIG *DRAMIGSpec `json:"mig,omitempty"`
+	// Sharing defines how the requested GPUs or MIG partitions are shared between the containers consuming them.
+	Sharing *DRADeviceSharingSpec `json:"sharing,omitempty"`
+}
+
+// GetDeviceClassName returns the device class to request the devices from.
+func (d *DRADeviceSpec) GetDeviceClassName() string {
+	if d.MIG != nil && d.DeviceClassName == DefaultDRADeviceClassName {
+		return DefaultDRAMIGDeviceClassName
+	}
+	return d.DeviceClassName
+}
+
+// IsPartitioned returns true if the device request is for MIG partitions or shared GPUs.
+func (d *DRADeviceSpec) IsPartitioned() bool {
+	return d.MIG != nil || d.Sharing != nil
+}
+
+const (
+	// DefaultDRADeviceClassName is the default device class for full GPUs of the NVIDIA DRA driver.
+	DefaultDRADeviceClassName = "gpu.nvidia.com"
+	// DefaultDRAMIGDeviceClassName is the default device class for MIG partitions of the NVIDIA DRA driver.
+	DefaultDRAMIGDeviceClassName = "mig.nvidia.com"
+	// DefaultDRADriverName is the name of the NVIDIA DRA driver.
+	DefaultDRADriverName = "gpu.nvidia.com"
+)
+
+// DRAMIGSpec defines the MIG partitions to request.
+type DRAMIGSpec struct {
+	// Profile is the MIG profile of the partitions to request, e.g. 1g.10gb or 3g.40gb.
+	// Any MIG partition is requested if not set.
+	//
+	// +kubebuilder:validation:Pattern=`^([0-9]+c\.)?[0-9]+g\.[0-9]+gb(\+[a-z]+)*$`
+	// +kubebuilder:validation:MaxLength=32
+	Profile string `json:"profile,omitempty"`
+}
+
+// DRADeviceSharingStrategy defines the strategy to share a device between containers.
+type DRADeviceSharingStrategy string
+
+const (
+	// DRADeviceSharingStrategyTimeSlicing shares the device by time-slicing its compute between the containers.
+	DRADeviceSharingStrategyTimeSlicing DRADeviceSharingStrategy = "TimeSlicing"
+	// DRADeviceSharingStrategyMPS shares the device through the CUDA Multi-Process Service.
+	DRADeviceSharingStrategyMPS DRADeviceSharingStrategy = "MPS"
+)
+
+// DRATimeSlicingInterval defines the time slice given to each container sharing a device.
+type DRATimeSlicingInterval string
+
+const (
+	DRATimeSlicingIntervalDefault DRATimeSlicingInterval = "Default"
+	DRATimeSlicingIntervalShort   DRATimeSlicingInterval = "Short"
+	DRATimeSlicingIntervalMedium  DRATimeSlicingInterval = "Medium"
+	DRATimeSlicingIntervalLong    DRATimeSlicingInterval = "Long"
+)
+
+// DRADeviceSharingSpec defines how the requested devices are shared between containers.
+type DRADeviceSharingSpec struct {
+	// Strategy is the sharing strategy. Supported strategies are:
+	// * TimeSlicing: the device compute is time-sliced between the containers.
+	// * MPS: the device is shared through the CUDA Multi-Process Service.
+	//
+	// +kubebuilder:validation:Enum=TimeSlicing;MPS
+	// +kubebuilder:default=TimeSlicing
+	Strategy DRADeviceSharingStrategy `json:"strategy"`
+	// TimeSlicingInterval is the time slice given to each container when time-slicing the device.
+	//
+	// +kubebuilder:validation:Enum=Default;Short;Medium;Long
+	TimeSlicingInterval DRATimeSlicingInterval `json:"timeSlicingInterval,omitempty"`
+	// MPSActiveThreadPercentage limits the compute threads available to each container when sharing the device through MPS.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	MPSActiveThreadPercentage *int32 `json:"mpsActiveThreadPercentage,omitempty"`
+	// MPSPinnedMemoryLimit limits the device memory available to each container when sharing the device through MPS.
+	MPSPinnedMemoryLimit *apiresource.Quantity `json:"mpsPinnedMemoryLimit,omitempty"`
 }
 
 // DRAClaimCreationSpec defines the spec for generating a DRA resource claim template.
@@ -287,6 +372,47 @@ func (d *DRAClaimCreationSpec) GetNamePrefix() string {
 	return "claimtemplate"
 }
 
+// DRAAutoClaimSpec defines the spec for generating a DRA resource claim template from the NIMCache profile.
+type DRAAutoClaimSpec struct {
+	// GenerateName is an optional name prefix to use for generating the resource claim template.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=16
+	GenerateName string `json:"generateName,omitempty"`
+	// DeviceClassName references a specific DeviceClass to inherit configuration and selectors from.
+	// +kubebuilder:default=gpu.nvidia.com
+	DeviceClassName string `json:"deviceClassName,omitempty"`
+	// DriverName is the name of the DRA driver providing the device attributes and capacity.
+	// Must be a DNS subdomain.
+	//
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*`
+	// +kubebuilder:default=gpu.nvidia.com
+	DriverName string `json:"driverName,omitempty"`
+}
+
+func (d *DRAAutoClaimSpec) GetNamePrefix() string {
+	namePrefix := d.GenerateName
+	if namePrefix != "" {
+		return namePrefix
+	}
+	return "claimtemplate"
+}
+
+func (d *DRAAutoClaimSpec) GetDeviceClassName() string {
+	if d.DeviceClassName != "" {
+		return d.DeviceClassName
+	}
+	return DefaultDRADeviceClassName
+}
+
+func (d *DRAAutoClaimSpec) GetDriverName() string {
+	if d.DriverName != "" {
+		return d.DriverName
+	}
+	return DefaultDRADriverName
+}
+
 // DRAResourceStatus defines the status of the DRAResource.
 // +kubebuilder:validation:XValidation:rule="has(self.resourceClaimStatus) != has(self.resourceClaimTemplateStatus)",message="exactly one of resourceClaimStatus and resourceClaimTemplateStatus must be set."
 type DRAResourceStatus struct {
@@ -323,4 +449,20 @@ type DRAResourceClaimTemplateStatusInfo struct {
 	Name string `json:"name"`
 	// ResourceClaimStatuses is the statuses of the generated resource claims from this resource claim template.
 	ResourceClaimStatuses []DRAResourceClaimStatusInfo `json:"resourceClaimStatuses,omitempty"`
+	// Devices is the devices requested by the resource claim template, when generated from a claim creation spec.
+	Devices []DRADeviceRequestStatus `json:"devices,omitempty"`
+}
+
+// DRADeviceRequestStatus defines the devices requested by a generated resource claim template.
+type DRADeviceRequestStatus struct {
+	// Name is the name of the device request.
+	Name string `json:"name"`
+	// Count is the number of devices requested.
+	Count uint32 `json:"count"`
+	// DeviceClassName is the device class the devices are requested from.
+	DeviceClassName string `json:"deviceClassName"`
+	// MIGProfile is the MIG profile of the requested partitions, if MIG partitions are requested.
+	MIGProfile string `json:"migProfile,omitempty"`
+	// SharingStrategy is the strategy the requested devices are shared with, if shared.
+	SharingStrategy DRADeviceSharingStrategy `json:"sharingStrategy,omitempty"`
 }
@@ -0,0 +1,348 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
+	utils "github.com/NVIDIA/k8s-nim-operator/internal/utils"
+)
+
+const (
+	// LLMDRoleLabelKey is the label holding the llm-d role of the NIMService pods.
+	LLMDRoleLabelKey = "llm-d.ai/role"
+	// LLMDInferenceServingLabelKey is the label the llm-d endpoint picker selects the serving pods by.
+	LLMDInferenceServingLabelKey = "llm-d.ai/inferenceServing"
+	// LLMDModelLabelKey is the label holding the name of the NIMService served by the llm-d pods.
+	LLMDModelLabelKey = "llm-d.ai/model"
+
+	// DefaultLLMDRoutingSidecarImage is the default image of the llm-d routing sidecar.
+	DefaultLLMDRoutingSidecarImage = "ghcr.io/llm-d/llm-d-routing-sidecar:v0.2.0"
+	// DefaultLLMDDecodeTargetPort is the default port NIM listens on in the decode pods, behind the routing sidecar.
+	DefaultLLMDDecodeTargetPort = 8200
+	// DefaultLLMDNamedPortDecode is the name of the NIM container port in the decode pods.
+	DefaultLLMDNamedPortDecode = "decode-api"
+	// DefaultLLMDNIXLSideChannelPort is the port the NIXL side channel of vLLM listens on.
+	DefaultLLMDNIXLSideChannelPort = 5557
+)
+
+// LLMDRole is the role of the workers of an llm-d disaggregated deployment.
+type LLMDRole string
+
+const (
+	// LLMDRolePrefill runs the prefill phase of the requests and hands the KV cache over to the decode workers.
+	LLMDRolePrefill LLMDRole = "prefill"
+	// LLMDRoleDecode runs the decode phase of the requests, fronted by the routing sidecar.
+	LLMDRoleDecode LLMDRole = "decode"
+)
+
+// LLMDKVConnector is the connector transferring the KV cache between the prefill and decode workers.
+type LLMDKVConnector string
+
+const (
+	// LLMDKVConnectorNIXL uses the first version of the NIXL connector protocol.
+	LLMDKVConnectorNIXL LLMDKVConnector = "nixl"
+	// LLMDKVConnectorNIXLV2 uses the NIXL connector with the decode workers pulling the KV cache.
+	LLMDKVConnectorNIXLV2 LLMDKVConnector = "nixlv2"
+	// LLMDKVConnectorLMCache uses the LMCache connector.
+	LLMDKVConnectorLMCache LLMDKVConnector = "lmcache"
+)
+
+// LLMDSpec defines the llm-d specific options of a NIMService.
+type LLMDSpec struct {
+	// Prefill defines the prefill workers of the disaggregated deployment.
+	Prefill LLMDRoleSpec `json:"prefill,omitempty"`
+	// Decode defines the decode workers of the disaggregated deployment.
+	Decode LLMDRoleSpec `json:"decode,omitempty"`
+	// KVConnector is the connector transferring the KV cache from the prefill to the decode workers.
+	// +kubebuilder:validation:Enum=nixl;nixlv2;lmcache
+	// +kubebuilder:default:="nixlv2"
+	KVConnector LLMDKVConnector `json:"kvConnector,omitempty"`
+	// KVConnectorExtraConfig is passed to the KV cache connector as the kv_connector_extra_config of the vLLM KV transfer config.
+	KVConnectorExtraConfig map[string]string `json:"kvConnectorExtraConfig,omitempty"`
+	// NIXLSideChannelPort is the port the NIXL side channel listens on in the prefill and decode pods.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	NIXLSideChannelPort *int32 `json:"nixlSideChannelPort,omitempty"`
+	// RoutingSidecar defines the routing proxy running next to NIM in the decode pods.
+	RoutingSidecar LLMDRoutingSidecarSpec `json:"routingSidecar,omitempty"`
+}
+
+// LLMDRoleSpec defines the workers of a role of an llm-d disaggregated deployment.
+type LLMDRoleSpec struct {
+	// Replicas is the number of workers of the role. Defaults to the replicas of the NIMService.
+	// +kubebuilder:validation:Minimum=1
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resources overrides the resource requirements of the NIMService for the workers of the role.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// DRAResources is the list of DRA resource claims of the workers of the role.
+	DRAResources []DRAResource `json:"draResources,omitempty"`
+	// NodeSelector overrides the node selector of the NIMService for the workers of the role.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations overrides the tolerations of the NIMService for the workers of the role.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// LLMDStatus defines the observed state of an llm-d disaggregated deployment.
+type LLMDStatus struct {
+	// Roles is the observed state of the workers of each role.
+	// +listType=map
+	// +listMapKey=role
+	Roles []LLMDRoleStatus `json:"roles,omitempty"`
+}
+
+// LLMDRoleStatus defines the observed state of the workers of an llm-d role.
+type LLMDRoleStatus struct {
+	// Role is the role of the workers.
+	Role LLMDRole `json:"role"`
+	// Deployment is the name of the deployment running the workers.
+	Deployment string `json:"deployment"`
+	// Replicas is the desired number of workers.
+	Replicas int32 `json:"replicas"`
+	// UpdatedReplicas is the number of workers running the latest spec.
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// AvailableReplicas is the number of available workers.
+	AvailableReplicas int32 `json:"availableReplicas"`
+	// DRAResourceStatuses is the status of the DRA resources of the workers.
+	// +listType=map
+	// +listMapKey=name
+	DRAResourceStatuses []DRAResourceStatus `json:"draResourceStatuses,omitempty"`
+}
+
+// LLMDRoutingSidecarSpec defines the routing sidecar of the llm-d decode pods.
+type LLMDRoutingSidecarSpec struct {
+	// Image is the routing sidecar image. Defaults to the llm-d routing sidecar.
+	Image *Image `json:"image,omitempty"`
+	// TargetPort is the port NIM listens on in the decode pods, behind the routing sidecar.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	TargetPort *int32 `json:"targetPort,omitempty"`
+}
+
+// IsLLMDEnabled returns true if the NIMService is deployed on the llm-d inference platform.
+func (n *NIMService) IsLLMDEnabled() bool {
+	return n.Spec.InferencePlatform == PlatformTypeLLMD
+}
+
+// GetLLMDSpec returns the llm-d options of the NIMService.
+func (n *NIMService) GetLLMDSpec() LLMDSpec {
+	if n.Spec.LLMD == nil {
+		return LLMDSpec{}
+	}
+	return *n.Spec.LLMD
+}
+
+// GetLLMDRoleSpec returns the options of the workers of the given llm-d role.
+func (n *NIMService) GetLLMDRoleSpec(role LLMDRole) LLMDRoleSpec {
+	spec := n.GetLLMDSpec()
+	if role == LLMDRolePrefill {
+		return spec.Prefill
+	}
+	return spec.Decode
+}
+
+// GetLLMDDeploymentName returns the name of the deployment running the workers of the given llm-d role.
+func (n *NIMService) GetLLMDDeploymentName(role LLMDRole) string {
+	return fmt.Sprintf("%s-%s", n.GetName(), role)
+}
+
+// GetLLMDReplicas returns the number of workers of the given llm-d role.
+func (n *NIMService) GetLLMDReplicas(role LLMDRole) int {
+	if replicas := n.GetLLMDRoleSpec(role).Replicas; replicas != nil {
+		return int(*replicas)
+	}
+	return n.Spec.Replicas
+}
+
+// GetLLMDKVConnector returns the KV cache connector of the llm-d deployment.
+func (n *NIMService) GetLLMDKVConnector() LLMDKVConnector {
+	if connector := n.GetLLMDSpec().KVConnector; connector != "" {
+		return connector
+	}
+	return LLMDKVConnectorNIXLV2
+}
+
+// GetNIXLSideChannelPort returns the port the NIXL side channel listens on.
+func (l *LLMDSpec) GetNIXLSideChannelPort() int32 {
+	if l == nil || l.NIXLSideChannelPort == nil {
+		return DefaultLLMDNIXLSideChannelPort
+	}
+	return *l.NIXLSideChannelPort
+}
+
+// GetDecodeTargetPort returns the port NIM listens on in the decode pods.
+func (l *LLMDSpec) GetDecodeTargetPort() int32 {
+	if l == nil || l.RoutingSidecar.TargetPort == nil {
+		return DefaultLLMDDecodeTargetPort
+	}
+	return *l.RoutingSidecar.TargetPort
+}
+
+// GetLLMDDecodeTargetPort returns the port NIM listens on in the decode pods.
+func (n *NIMService) GetLLMDDecodeTargetPort() int32 {
+	return n.Spec.LLMD.GetDecodeTargetPort()
+}
+
+// GetLLMDRoleLabels returns the labels identifying the pods of the given llm-d role.
+func (n *NIMService) GetLLMDRoleLabels(role LLMDRole) map[string]string {
+	return map[string]string{
+		LLMDRoleLabelKey:             string(role),
+		LLMDInferenceServingLabelKey: "true",
+		LLMDModelLabelKey:            n.GetName(),
+	}
+}
+
+// llmdKVTransferConfig is the vLLM KV transfer config of the prefill and decode workers.
+type llmdKVTransferConfig struct {
+	KVConnector            string            `json:"kv_connector"`
+	KVRole                 string            `json:"kv_role"`
+	KVConnectorExtraConfig map[string]string `json:"kv_connector_extra_config,omitempty"`
+}
+
+// GetLLMDKVTransferEnv returns the env configuring the KV cache transfer of the NIM container.
+func (n *NIMService) GetLLMDKVTransferEnv() []corev1.EnvVar {
+	kvTransferConfig := llmdKVTransferConfig{
+		KVConnector:            "NixlConnector",
+		KVRole:                 "kv_both",
+		KVConnectorExtraConfig: n.GetLLMDSpec().KVConnectorExtraConfig,
+	}
+	if n.GetLLMDKVConnector() == LLMDKVConnectorLMCache {
+		kvTransferConfig.KVConnector = "LMCacheConnectorV1"
+	}
+	// Marshalling a struct of strings cannot fail.
+	kvTransferConfigJSON, _ := json.Marshal(kvTransferConfig)
+	return []corev1.EnvVar{
+		{
+			Name:  "NIM_PASSTHROUGH_ARGS",
+			Value: fmt.Sprintf("--kv-transfer-config %s", kvTransferConfigJSON),
+		},
+		{
+			Name: "VLLM_NIXL_SIDE_CHANNEL_HOST",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "status.podIP",
+				},
+			},
+		},
+		{
+			Name:  "VLLM_NIXL_SIDE_CHANNEL_PORT",
+			Value: fmt.Sprintf("%d", n.Spec.LLMD.GetNIXLSideChannelPort()),
+		},
+	}
+}
+
+// GetLLMDDeploymentParams returns the params to render the deployment of the given llm-d role.
+// The decode pods serve NIM on the decode target port, behind the routing sidecar listening on the service port.
+func (n *NIMService) GetLLMDDeploymentParams(role LLMDRole) *rendertypes.DeploymentParams {
+	params := n.GetDeploymentParams()
+	params.Name = n.GetLLMDDeploymentName(role)
+	params.Replicas = n.GetLLMDReplicas(role)
+	params.Labels = utils.MergeMaps(n.GetLLMDRoleLabels(role), params.Labels)
+	params.SelectorLabels = map[string]string{"app": params.Name}
+	roleSpec := n.GetLLMDRoleSpec(role)
+	if roleSpec.Resources != nil {
+		params.Resources = roleSpec.Resources.DeepCopy()
+	}
+	if roleSpec.NodeSelector != nil {
+		params.NodeSelector = roleSpec.NodeSelector
+	}
+	if roleSpec.Tolerations != nil {
+		params.Tolerations = roleSpec.Tolerations
+	}
+	// User provided env takes precedence over the KV cache transfer defaults.
+	params.Env = utils.MergeEnvVars(n.GetLLMDKVTransferEnv(), params.Env)
+	if role == LLMDRolePrefill {
+		return params
+	}
+
+	targetPort := n.GetLLMDDecodeTargetPort()
+	params.Env = utils.MergeEnvVars(params.Env, []corev1.EnvVar{
+		{Name: "NIM_SERVER_PORT", Value: fmt.Sprintf("%d", targetPort)},
+		{Name: "NIM_HTTP_API_PORT", Value: fmt.Sprintf("%d", targetPort)},
+	})
+	for i := range params.Ports {
+		if params.Ports[i].Name == DefaultNamedPortAPI {
+			params.Ports[i].Name = DefaultLLMDNamedPortDecode
+			params.Ports[i].ContainerPort = targetPort
+		}
+	}
+	params.LivenessProbe = getLLMDDecodeProbe(params.LivenessProbe)
+	params.ReadinessProbe = getLLMDDecodeProbe(params.ReadinessProbe)
+	params.StartupProbe = getLLMDDecodeProbe(params.StartupProbe)
+	return params
+}
+
+// getLLMDDecodeProbe returns a copy of the probe checking the NIM container port of the decode pods
+// instead of the API port served by the routing sidecar.
+func getLLMDDecodeProbe(probe *corev1.Probe) *corev1.Probe {
+	if probe == nil || probe.HTTPGet == nil || probe.HTTPGet.Port.String() != DefaultNamedPortAPI {
+		return probe
+	}
+	probe = probe.DeepCopy()
+	probe.HTTPGet.Port = intstr.FromString(DefaultLLMDNamedPortDecode)
+	return probe
+}
+
+// GetLLMDRoutingSidecarContainer returns the routing sidecar of the decode pods, proxying the API port to NIM and
+// running the prefill phase of the requests on the prefill worker selected by the endpoint picker.
+func (n *NIMService) GetLLMDRoutingSidecarContainer() corev1.Container {
+	image := DefaultLLMDRoutingSidecarImage
+	var pullPolicy corev1.PullPolicy
+	if sidecarImage := n.GetLLMDSpec().RoutingSidecar.Image; sidecarImage != nil {
+		image = fmt.Sprintf("%s:%s", sidecarImage.Repository, sidecarImage.Tag)
+		pullPolicy = corev1.PullPolicy(sidecarImage.PullPolicy)
+	}
+	return corev1.Container{
+		Name:            "routing-proxy",
+		Image:           image,
+		ImagePullPolicy: pullPolicy,
+		Args: []string{
+			fmt.Sprintf("--port=%d", n.GetServicePort()),
+			fmt.Sprintf("--vllm-port=%d", n.GetLLMDDecodeTargetPort()),
+			fmt.Sprintf("--connector=%s", n.GetLLMDKVConnector()),
+			"--secure-proxy=false",
+		},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          DefaultNamedPortAPI,
+				Protocol:      corev1.ProtocolTCP,
+				ContainerPort: n.GetServicePort(),
+			},
+		},
+	}
+}
+
+// GetLLMDImagePullSecrets returns the image pull secrets of the routing sidecar.
+func (n *NIMService) GetLLMDImagePullSecrets() []string {
+	if sidecarImage := n.GetLLMDSpec().RoutingSidecar.Image; sidecarImage != nil {
+		return sidecarImage.PullSecrets
+	}
+	return nil
+}
+
+// GetLLMDServiceParams returns the params to render the router service of an llm-d NIMService.
+// The service routes the requests to the routing sidecar of the decode pods.
+func (n *NIMService) GetLLMDServiceParams() *rendertypes.ServiceParams {
+	params := n.GetServiceParams()
+	params.SelectorLabels = map[string]string{"app": n.GetLLMDDeploymentName(LLMDRoleDecode)}
+	return params
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+const (
+	// DefaultLoRAStorePath is the directory the LoRA adapters are made available in for NIM.
+	DefaultLoRAStorePath = "/loras"
+)
+
+// LoRASpec defines the PEFT (LoRA) adapters served on top of the NIMService base model.
+type LoRASpec struct {
+	// Adapters is the list of LoRA adapters to serve.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Adapters []LoRAAdapter `json:"adapters"`
+	// RefreshInterval is the interval in seconds at which NIM checks the adapter store for new or removed adapters.
+	// +kubebuilder:validation:Minimum=1
+	RefreshInterval *int32 `json:"refreshInterval,omitempty"`
+	// MaxGPULoRAs is the maximum number of adapters held in GPU memory at once.
+	// +kubebuilder:validation:Minimum=1
+	MaxGPULoRAs *int32 `json:"maxGPULoRAs,omitempty"`
+	// MaxCPULoRAs is the maximum number of adapters cached in host memory.
+	// +kubebuilder:validation:Minimum=1
+	MaxCPULoRAs *int32 `json:"maxCPULoRAs,omitempty"`
+}
+
+// LoRAAdapter references a single LoRA adapter.
+// +kubebuilder:validation:XValidation:rule="(has(self.pvc) ? 1 : 0) + (has(self.nimCache) ? 1 : 0) + (has(self.hf) ? 1 : 0) + (has(self.dataStore) ? 1 : 0) + (has(self.nemoCustomizer) ? 1 : 0) == 1",message="exactly one of pvc, nimCache, hf, dataStore or nemoCustomizer must be set"
+type LoRAAdapter struct {
+	// Name is the name the adapter is served as. It is used as the directory name of the adapter in the adapter store.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`
+	// +kubebuilder:validation:MaxLength=128
+	Name string `json:"name"`
+	// PVC references an existing PVC storing the adapter.
+	PVC *LoRAPVCSource `json:"pvc,omitempty"`
+	// NIMCache references a NIMCache in the same namespace that cached the adapter.
+	NIMCache *LoRANIMCacheSource `json:"nimCache,omitempty"`
+	// HF references an adapter stored in HuggingFace Hub, downloaded when the NIMService pods start.
+	HF *HuggingFaceHubSource `json:"hf,omitempty"`
+	// DataStore references an adapter stored in NVIDIA NeMo DataStore, downloaded when the NIMService pods start.
+	DataStore *NemoDataStoreSource `json:"dataStore,omitempty"`
+	// NemoCustomizer references an adapter produced by a NemoCustomizer customization job.
+	NemoCustomizer *LoRANemoCustomizerSource `json:"nemoCustomizer,omitempty"`
+}
+
+// LoRAPVCSource references an adapter stored in an existing PVC.
+type LoRAPVCSource struct {
+	// Name is the name of the PVC in the same namespace.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// SubPath is the path of the adapter within the PVC.
+	SubPath string `json:"subPath,omitempty"`
+}
+
+// LoRANIMCacheSource references an adapter cached by a NIMCache.
+type LoRANIMCacheSource struct {
+	// Name is the name of the NIMCache in the same namespace.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Path is the path of the adapter within the NIMCache. Defaults to the root of the cache.
+	Path string `json:"path,omitempty"`
+}
+
+// LoRANemoCustomizerSource references an adapter produced by a NemoCustomizer.
+// The adapter is downloaded from the NeMo DataStore the NemoCustomizer writes its output models to.
+type LoRANemoCustomizerSource struct {
+	// Name is the name of the NemoCustomizer in the same namespace.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Namespace is the namespace of the output model within NeMo DataStore
+	// +kubebuilder:default="default"
+	Namespace string `json:"namespace,omitempty"`
+	// ModelName is the name of the customization output model
+	// +kubebuilder:validation:MinLength=1
+	ModelName string `json:"modelName"`
+	// Revision is the revision of the output model
+	Revision *string `json:"revision,omitempty"`
+	// AuthSecret is the name of the secret containing the "HF_TOKEN" token
+	AuthSecret string `json:"authSecret,omitempty"`
+	// ModelPuller is the containerized huggingface-cli image to pull the adapter
+	// +kubebuilder:validation:MinLength=1
+	ModelPuller string `json:"modelPuller"`
+	// PullSecret is the name of the image pull secret for the modelPuller image
+	PullSecret string `json:"pullSecret,omitempty"`
+}
+
+// IsLoRAEnabled returns true if LoRA adapters are configured for the NIMService.
+func (n *NIMService) IsLoRAEnabled() bool {
+	return n.Spec.LoRA != nil && len(n.Spec.LoRA.Adapters) > 0
+}
+
+// GetLoRANIMCacheNames returns the names of the NIMCaches referenced by LoRA adapters.
+func (n *NIMService) GetLoRANIMCacheNames() []string {
+	if n.Spec.LoRA == nil {
+		return nil
+	}
+	var names []string
+	for _, adapter := range n.Spec.LoRA.Adapters {
+		if adapter.NIMCache != nil {
+			names = append(names, adapter.NIMCache.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// ModelRouterImageEnv is the operator env holding the default model router image, i.e. the operator image.
+	ModelRouterImageEnv = "MODEL_ROUTER_IMAGE"
+	// DefaultModelRouterContainerName is the name of the model router container of a multi-model NIMService.
+	DefaultModelRouterContainerName = "model-router"
+	// DefaultModelRouterCommand is the model router binary of the model router image.
+	DefaultModelRouterCommand = "/model-router"
+	// DefaultMultiModelBasePort is the port of the first NIM container of a multi-model NIMService,
+	// the NIM container of the i-th model listening on DefaultMultiModelBasePort + i.
+	DefaultMultiModelBasePort = 8100
+)
+
+// MultiModelSpec defines the additional models served by a NIMService next to the model of its storage.
+// Each model is served by its own NIM container in the NIMService pods, the requests being routed to the
+// NIM container serving the requested model by an in-pod model router listening on the service port.
+type MultiModelSpec struct {
+	// Models are the additional models served by the NIMService.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Models []NIMServiceModelSpec `json:"models"`
+	// Router defines the model router of the NIMService pods.
+	Router ModelRouterSpec `json:"router,omitempty"`
+}
+
+// NIMServiceModelSpec defines an additional model served by a NIMService.
+type NIMServiceModelSpec struct {
+	// Name identifies the model in the NIMService and names the NIM container serving it.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=40
+	Name string `json:"name"`
+	// NIMCache is the NIMCache, in the namespace of the NIMService, holding the model and the profile to serve.
+	NIMCache NIMCacheVolSpec `json:"nimCache"`
+	// Image overrides the NIM image of the NIMService to serve the model.
+	Image *Image `json:"image,omitempty"`
+	// Resources overrides the resource requirements of the NIMService for the NIM container serving the model.
+	// GPUs are assigned from the NIMCache profile when not set.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Env are additional environment variables of the NIM container serving the model.
+	Env []corev1.EnvVar `json:"env,omitempty"`
+}
+
+// ModelRouterSpec defines the model router of a multi-model NIMService.
+type ModelRouterSpec struct {
+	// Image is the model router image, running /model-router.
+	// Defaults to the operator image, which ships the model router.
+	Image *Image `json:"image,omitempty"`
+}
+
+// ServedModelStatus defines a model served by the NIMService, as listed by its /v1/models endpoint.
+type ServedModelStatus struct {
+	// Name is the id of the model.
+	Name string `json:"name"`
+	// Root is the base model of the model, differing from its name for LoRA adapters.
+	Root string `json:"root,omitempty"`
+}
+
+// IsMultiModelEnabled returns true if the NIMService serves additional models behind a model router.
+func (n *NIMService) IsMultiModelEnabled() bool {
+	return n.Spec.MultiModel != nil && len(n.Spec.MultiModel.Models) > 0
+}
+
+// GetMultiModels returns the additional models served by the NIMService.
+func (n *NIMService) GetMultiModels() []NIMServiceModelSpec {
+	if n.Spec.MultiModel == nil {
+		return nil
+	}
+	return n.Spec.MultiModel.Models
+}
+
+// GetMultiModelNIMCacheNames returns the names of the NIMCaches of the additional models.
+func (n *NIMService) GetMultiModelNIMCacheNames() []string {
+	var names []string
+	for _, model := range n.GetMultiModels() {
+		names = append(names, model.NIMCache.Name)
+	}
+	return names
+}
+
+// GetMultiModelPort returns the port of the NIM container of the i-th model, the model of the NIMService storage being the first.
+func GetMultiModelPort(i int) int32 {
+	return int32(DefaultMultiModelBasePort + i)
+}
+
+// GetMultiModelPortName returns the name of the port of the NIM container of the i-th model.
+func GetMultiModelPortName(i int) string {
+	return fmt.Sprintf("model-%d", i)
+}
+
+// GetMultiModelVolumeName returns the name of the model store volume of the given additional model.
+func GetMultiModelVolumeName(model NIMServiceModelSpec) string {
+	return fmt.Sprintf("model-store-%s", model.Name)
+}
+
+// GetModelRouterImage returns the model router image and its pull policy.
+// An empty image is returned when neither the NIMService nor the operator configure one.
+func (n *NIMService) GetModelRouterImage() (string, corev1.PullPolicy) {
+	if n.Spec.MultiModel != nil && n.Spec.MultiModel.Router.Image != nil {
+		image := n.Spec.MultiModel.Router.Image
+		return fmt.Sprintf("%s:%s", image.Repository, image.Tag), corev1.PullPolicy(image.PullPolicy)
+	}
+	return os.Getenv(ModelRouterImageEnv), ""
+}
+
+// GetModelRouterImagePullSecrets returns the image pull secrets of the model router.
+func (n *NIMService) GetModelRouterImagePullSecrets() []string {
+	if n.Spec.MultiModel != nil && n.Spec.MultiModel.Router.Image != nil {
+		return n.Spec.MultiModel.Router.Image.PullSecrets
+	}
+	return nil
+}
+
+// GetModelRouterContainer returns the model router container listening on the API port and
+// routing the requests to the NIM containers of the NIMService pods.
+func (n *NIMService) GetModelRouterContainer() corev1.Container {
+	image, pullPolicy := n.GetModelRouterImage()
+	args := []string{fmt.Sprintf("--port=%d", n.GetServicePort())}
+	for i := 0; i <= len(n.GetMultiModels()); i++ {
+		args = append(args, fmt.Sprintf("--backend=http://127.0.0.1:%d", GetMultiModelPort(i)))
+	}
+	return corev1.Container{
+		Name:            DefaultModelRouterContainerName,
+		Image:           image,
+		ImagePullPolicy: pullPolicy,
+		Command:         []string{DefaultModelRouterCommand},
+		Args:            args,
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          DefaultNamedPortAPI,
+				Protocol:      corev1.ProtocolTCP,
+				ContainerPort: n.GetServicePort(),
+			},
+		},
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: "/v1/health/ready",
+					Port: intstr.FromString(DefaultNamedPortAPI),
+				},
+			},
+			PeriodSeconds: 10,
+		},
+	}
+}
@@ -638,6 +638,11 @@ func (n *NemoCustomizer) IsIngressEnabled() bool {
 	return n.Spec.Expose.Ingress.Enabled != nil && *n.Spec.Expose.Ingress.Enabled
 }
 
+// IsRouterEnabled returns true if Gateway API routes are enabled for NemoCustomizer deployment.
+func (n *NemoCustomizer) IsRouterEnabled() bool {
+	return n.Spec.Expose.Router != nil
+}
+
 // GetIngressSpec returns the Ingress spec NemoCustomizer deployment.
 func (n *NemoCustomizer) GetIngressSpec() networkingv1.IngressSpec {
 	return n.Spec.Expose.Ingress.GenerateNetworkingV1IngressSpec(n.GetName())
@@ -839,6 +844,22 @@ func (n *NemoCustomizer) GetIngressParams() *rendertypes.IngressParams {
 	return params
 }
 
+// GetHTTPRouteParams returns params to render HTTPRoute from templates.
+func (n *NemoCustomizer) GetHTTPRouteParams() *rendertypes.HTTPRouteParams {
+	params := &rendertypes.HTTPRouteParams{}
+
+	params.Enabled = n.IsRouterEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetRouterAnnotations()
+	if params.Enabled {
+		params.Spec = n.Spec.Expose.Router.GenerateGatewayHTTPRouteSpec(n.GetName(), n.GetServicePort())
+	}
+	return params
+}
+
 // GetRoleParams returns params to render Role from templates.
 func (n *NemoCustomizer) GetRoleParams() *rendertypes.RoleParams {
 	params := &rendertypes.RoleParams{}
@@ -1024,6 +1045,15 @@ func (n *NemoCustomizer) GetIngressAnnotations() map[string]string {
 }
 
 // GetServiceAnnotations return standard and customized service annotations.
+func (n *NemoCustomizer) GetRouterAnnotations() map[string]string {
+	NemoCustomizerAnnotations := n.GetNemoCustomizerAnnotations()
+
+	if n.Spec.Expose.Router != nil && n.Spec.Expose.Router.Annotations != nil {
+		return utils.MergeMaps(NemoCustomizerAnnotations, n.Spec.Expose.Router.Annotations)
+	}
+	return NemoCustomizerAnnotations
+}
+
 func (n *NemoCustomizer) GetServiceAnnotations() map[string]string {
 	NemoCustomizerAnnotations := n.GetNemoCustomizerAnnotations()
 
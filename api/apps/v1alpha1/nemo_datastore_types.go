@@ -767,6 +767,11 @@ func (n *NemoDatastore) IsIngressEnabled() bool {
 	return n.Spec.Expose.Ingress.Enabled != nil && *n.Spec.Expose.Ingress.Enabled
 }
 
+// IsRouterEnabled returns true if Gateway API routes are enabled for NemoDatastore deployment.
+func (n *NemoDatastore) IsRouterEnabled() bool {
+	return n.Spec.Expose.Router != nil
+}
+
 // GetIngressSpec returns the Ingress spec NemoDatastore deployment.
 func (n *NemoDatastore) GetIngressSpec() networkingv1.IngressSpec {
 	return n.Spec.Expose.Ingress.GenerateNetworkingV1IngressSpec(n.GetName())
@@ -967,6 +972,22 @@ func (n *NemoDatastore) GetIngressParams() *rendertypes.IngressParams {
 	return params
 }
 
+// GetHTTPRouteParams returns params to render HTTPRoute from templates.
+func (n *NemoDatastore) GetHTTPRouteParams() *rendertypes.HTTPRouteParams {
+	params := &rendertypes.HTTPRouteParams{}
+
+	params.Enabled = n.IsRouterEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetRouterAnnotations()
+	if params.Enabled {
+		params.Spec = n.Spec.Expose.Router.GenerateGatewayHTTPRouteSpec(n.GetName(), n.GetServicePort())
+	}
+	return params
+}
+
 // GetRoleParams returns params to render Role from templates.
 func (n *NemoDatastore) GetRoleParams() *rendertypes.RoleParams {
 	params := &rendertypes.RoleParams{}
@@ -1077,6 +1098,15 @@ func (n *NemoDatastore) GetIngressAnnotations() map[string]string {
 	return NemoDatastoreAnnotations
 }
 
+func (n *NemoDatastore) GetRouterAnnotations() map[string]string {
+	NemoDatastoreAnnotations := n.GetNemoDatastoreAnnotations()
+
+	if n.Spec.Expose.Router != nil && n.Spec.Expose.Router.Annotations != nil {
+		return utils.MergeMaps(NemoDatastoreAnnotations, n.Spec.Expose.Router.Annotations)
+	}
+	return NemoDatastoreAnnotations
+}
+
 func (n *NemoDatastore) GetServiceAnnotations() map[string]string {
 	NemoDatastoreAnnotations := n.GetNemoDatastoreAnnotations()
 
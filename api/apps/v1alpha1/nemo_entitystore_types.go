@@ -394,6 +394,11 @@ func (n *NemoEntitystore) IsIngressEnabled() bool {
 	return n.Spec.Expose.Ingress.Enabled != nil && *n.Spec.Expose.Ingress.Enabled
 }
 
+// IsRouterEnabled returns true if Gateway API routes are enabled for NemoEntitystore deployment.
+func (n *NemoEntitystore) IsRouterEnabled() bool {
+	return n.Spec.Expose.Router != nil
+}
+
 // GetIngressSpec returns the Ingress spec NemoEntitystore deployment.
 func (n *NemoEntitystore) GetIngressSpec() networkingv1.IngressSpec {
 	return n.Spec.Expose.Ingress.GenerateNetworkingV1IngressSpec(n.GetName())
@@ -601,6 +606,22 @@ func (n *NemoEntitystore) GetIngressParams() *rendertypes.IngressParams {
 	return params
 }
 
+// GetHTTPRouteParams returns params to render HTTPRoute from templates.
+func (n *NemoEntitystore) GetHTTPRouteParams() *rendertypes.HTTPRouteParams {
+	params := &rendertypes.HTTPRouteParams{}
+
+	params.Enabled = n.IsRouterEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetRouterAnnotations()
+	if params.Enabled {
+		params.Spec = n.Spec.Expose.Router.GenerateGatewayHTTPRouteSpec(n.GetName(), n.GetServicePort())
+	}
+	return params
+}
+
 // GetRoleParams returns params to render Role from templates.
 func (n *NemoEntitystore) GetRoleParams() *rendertypes.RoleParams {
 	params := &rendertypes.RoleParams{}
@@ -711,6 +732,15 @@ func (n *NemoEntitystore) GetIngressAnnotations() map[string]string {
 	return NemoEntitystoreAnnotations
 }
 
+func (n *NemoEntitystore) GetRouterAnnotations() map[string]string {
+	NemoEntitystoreAnnotations := n.GetNemoEntitystoreAnnotations()
+
+	if n.Spec.Expose.Router != nil && n.Spec.Expose.Router.Annotations != nil {
+		return utils.MergeMaps(NemoEntitystoreAnnotations, n.Spec.Expose.Router.Annotations)
+	}
+	return NemoEntitystoreAnnotations
+}
+
 func (n *NemoEntitystore) GetServiceAnnotations() map[string]string {
 	NemoEntitystoreAnnotations := n.GetNemoEntitystoreAnnotations()
 
@@ -584,6 +584,11 @@ func (n *NemoEvaluator) IsIngressEnabled() bool {
 	return n.Spec.Expose.Ingress.Enabled != nil && *n.Spec.Expose.Ingress.Enabled
 }
 
+// IsRouterEnabled returns true if Gateway API routes are enabled for NemoEvaluator deployment.
+func (n *NemoEvaluator) IsRouterEnabled() bool {
+	return n.Spec.Expose.Router != nil
+}
+
 // GetIngressSpec returns the Ingress spec NemoEvaluator deployment.
 func (n *NemoEvaluator) GetIngressSpec() networkingv1.IngressSpec {
 	return n.Spec.Expose.Ingress.GenerateNetworkingV1IngressSpec(n.GetName())
@@ -784,6 +789,22 @@ func (n *NemoEvaluator) GetIngressParams() *rendertypes.IngressParams {
 	return params
 }
 
+// GetHTTPRouteParams returns params to render HTTPRoute from templates.
+func (n *NemoEvaluator) GetHTTPRouteParams() *rendertypes.HTTPRouteParams {
+	params := &rendertypes.HTTPRouteParams{}
+
+	params.Enabled = n.IsRouterEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetRouterAnnotations()
+	if params.Enabled {
+		params.Spec = n.Spec.Expose.Router.GenerateGatewayHTTPRouteSpec(n.GetName(), n.GetServicePort())
+	}
+	return params
+}
+
 // GetRoleParams returns params to render Role from templates.
 func (n *NemoEvaluator) GetRoleParams() *rendertypes.RoleParams {
 	params := &rendertypes.RoleParams{}
@@ -899,6 +920,15 @@ func (n *NemoEvaluator) GetIngressAnnotations() map[string]string {
 	return NemoEvaluatorAnnotations
 }
 
+func (n *NemoEvaluator) GetRouterAnnotations() map[string]string {
+	NemoEvaluatorAnnotations := n.GetNemoEvaluatorAnnotations()
+
+	if n.Spec.Expose.Router != nil && n.Spec.Expose.Router.Annotations != nil {
+		return utils.MergeMaps(NemoEvaluatorAnnotations, n.Spec.Expose.Router.Annotations)
+	}
+	return NemoEvaluatorAnnotations
+}
+
 func (n *NemoEvaluator) GetServiceAnnotations() map[string]string {
 	NemoEvaluatorAnnotations := n.GetNemoEvaluatorAnnotations()
 
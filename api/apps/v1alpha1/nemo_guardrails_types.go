@@ -541,6 +541,11 @@ func (n *NemoGuardrail) IsIngressEnabled() bool {
 	return n.Spec.Expose.Ingress.Enabled != nil && *n.Spec.Expose.Ingress.Enabled
 }
 
+// IsRouterEnabled returns true if Gateway API routes are enabled for NemoGuardrail deployment.
+func (n *NemoGuardrail) IsRouterEnabled() bool {
+	return n.Spec.Expose.Router != nil
+}
+
 // GetIngressSpec returns the Ingress spec NemoGuardrail deployment.
 func (n *NemoGuardrail) GetIngressSpec() networkingv1.IngressSpec {
 	return n.Spec.Expose.Ingress.GenerateNetworkingV1IngressSpec(n.GetName())
@@ -741,6 +746,22 @@ func (n *NemoGuardrail) GetIngressParams() *rendertypes.IngressParams {
 	return params
 }
 
+// GetHTTPRouteParams returns params to render HTTPRoute from templates.
+func (n *NemoGuardrail) GetHTTPRouteParams() *rendertypes.HTTPRouteParams {
+	params := &rendertypes.HTTPRouteParams{}
+
+	params.Enabled = n.IsRouterEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetRouterAnnotations()
+	if params.Enabled {
+		params.Spec = n.Spec.Expose.Router.GenerateGatewayHTTPRouteSpec(n.GetName(), n.GetServicePort())
+	}
+	return params
+}
+
 // GetRoleParams returns params to render Role from templates.
 func (n *NemoGuardrail) GetRoleParams() *rendertypes.RoleParams {
 	params := &rendertypes.RoleParams{}
@@ -851,6 +872,15 @@ func (n *NemoGuardrail) GetIngressAnnotations() map[string]string {
 	return NemoGuardrailAnnotations
 }
 
+func (n *NemoGuardrail) GetRouterAnnotations() map[string]string {
+	NemoGuardrailAnnotations := n.GetNemoGuardrailAnnotations()
+
+	if n.Spec.Expose.Router != nil && n.Spec.Expose.Router.Annotations != nil {
+		return utils.MergeMaps(NemoGuardrailAnnotations, n.Spec.Expose.Router.Annotations)
+	}
+	return NemoGuardrailAnnotations
+}
+
 func (n *NemoGuardrail) GetServiceAnnotations() map[string]string {
 	NemoGuardrailAnnotations := n.GetNemoGuardrailAnnotations()
 
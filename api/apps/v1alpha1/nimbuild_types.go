@@ -27,11 +27,17 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // NIMBuildSpec to build optimized trtllm engines with given model config and weights.
+// +kubebuilder:validation:XValidation:rule="!(has(self.buildMatrix) && has(self.buildMatrix.profiles) && has(self.nimCache.profile) && size(self.nimCache.profile) > 0)", message="spec.nimCache.profile and spec.buildMatrix.profiles are mutually exclusive"
 type NIMBuildSpec struct {
 	// NIMCache is Reference to the model weights from NIMCache
 	NIMCache NIMCacheReference `json:"nimCache"`
+	// BuildMatrix builds an engine for each combination of its profiles, tensor parallel sizes and GPU products
+	// instead of a single engine from the profile of the NIMCache.
+	BuildMatrix *NIMBuildMatrix `json:"buildMatrix,omitempty"`
 	// ModelName is the name given to the locally built engine.
 	ModelName string `json:"modelName,omitempty"`
+	// BuildPolicy configures the retries and the timeout of the jobs building the engines.
+	BuildPolicy *NIMBuildPolicy `json:"buildPolicy,omitempty"`
 	// Resources is the resource requirements for the NIMBuild pod.
 	Resources *ResourceRequirements `json:"resources,omitempty"`
 	// Tolerations for running the job to cache the NIM model
@@ -45,12 +51,84 @@ type NIMBuildSpec struct {
 	Image       Image             `json:"image"`
 }
 
+// NIMBuildMatrix defines the engines to build, one per combination of the profiles, tensor parallel sizes and GPU products.
+type NIMBuildMatrix struct {
+	// Profiles are the buildable profiles of the NIMCache to build the engines from.
+	// Defaults to the profile of the NIMCache reference if set, else to all the buildable profiles of the NIMCache.
+	Profiles []string `json:"profiles,omitempty"`
+	// TensorParallelism are the tensor parallel sizes to build the engines for.
+	// Defaults to the tensor parallel size of each profile.
+	// +kubebuilder:validation:items:Minimum=1
+	TensorParallelism []int32 `json:"tensorParallelism,omitempty"`
+	// GPUProducts are the GPU products to build the engines on, as labeled by the nvidia.com/gpu.product node label.
+	// Defaults to any GPU of the nodes selected for the NIMBuild.
+	GPUProducts []string `json:"gpuProducts,omitempty"`
+	// MaxConcurrentBuilds is the maximum number of engines built at once.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default:=1
+	MaxConcurrentBuilds int32 `json:"maxConcurrentBuilds,omitempty"`
+}
+
+// NIMBuildPolicy defines how the jobs building the engines are retried and timed out.
+type NIMBuildPolicy struct {
+	// BackoffLimit is the number of retries of a failed engine build before it is marked failed.
+	// Failures caused by disruptions, such as a node drain or a preemption, are retried without being counted.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default:=3
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds is the maximum duration of an engine build, its retries included.
+	// +kubebuilder:validation:Minimum=1
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+	// RetryOnOOM retries an engine build whose container is OOM killed, else fails it right away.
+	// +kubebuilder:default:=true
+	RetryOnOOM *bool `json:"retryOnOOM,omitempty"`
+	// NonRetryableExitCodes are the exit codes of the build container failing the engine build right away,
+	// such as the ones of configuration errors that a retry does not fix.
+	// +kubebuilder:validation:MaxItems=255
+	// +kubebuilder:validation:items:Minimum=1
+	// +kubebuilder:validation:items:Maximum=255
+	NonRetryableExitCodes []int32 `json:"nonRetryableExitCodes,omitempty"`
+}
+
+// NIMBuildRebuildAnnotation is set to "true" on a NIMBuild to build its engines again,
+// its jobs and status being reset.
+const NIMBuildRebuildAnnotation = "nvidia.com/nimbuild-rebuild"
+
 // NIMBuildStatus defines the observed state of NIMBuild.
 type NIMBuildStatus struct {
 	State         string             `json:"state,omitempty"`
 	InputProfile  NIMProfile         `json:"inputProfile,omitempty"`
 	OutputProfile NIMProfile         `json:"outputProfile,omitempty"`
 	Conditions    []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+	// Builds are the engine builds of the NIMBuild, one per cell of the build matrix.
+	// +listType=map
+	// +listMapKey=name
+	Builds []NIMBuildEngineStatus `json:"builds,omitempty"`
+	// OutputProfiles are the profiles of all the engines built by the NIMBuild.
+	OutputProfiles []NIMProfile `json:"outputProfiles,omitempty"`
+	// Retries is the number of failed attempts of the engine builds.
+	Retries int32 `json:"retries,omitempty"`
+	// LastFailureReason is the reason of the last failed attempt of an engine build.
+	LastFailureReason string `json:"lastFailureReason,omitempty"`
+}
+
+// NIMBuildEngineStatus defines the observed state of an engine build of a NIMBuild.
+type NIMBuildEngineStatus struct {
+	// Name is the name of the engine build, naming its build job.
+	Name string `json:"name"`
+	// InputProfile is the name of the buildable profile the engine is built from.
+	InputProfile string `json:"inputProfile"`
+	// TensorParallelism is the tensor parallel size of the engine.
+	TensorParallelism string `json:"tensorParallelism,omitempty"`
+	// GPUProduct is the GPU product the engine is built on.
+	GPUProduct string `json:"gpuProduct,omitempty"`
+	// ModelName is the name given to the built engine.
+	ModelName string `json:"modelName"`
+	State     string `json:"state,omitempty"`
+	// Retries is the number of failed attempts of the engine build.
+	Retries int32 `json:"retries,omitempty"`
+	// OutputProfile is the name of the profile of the built engine.
+	OutputProfile string `json:"outputProfile,omitempty"`
 }
 
 type NIMCacheReference struct {
@@ -94,7 +172,7 @@ const (
 	// NimBuildConditionNoBuildableProfilesFound indicates that no buildable profiles are found for the NIMCache object.
 	NimBuildConditionNoBuildableProfilesFound = "NIM_BUILD_NO_BUILDABLE_PROFILE_FOUND"
 
-	// NimBuildConditionEngineBuildPodCreated indicates that the engine build pod is created.
+	// NimBuildConditionEngineBuildPodCreated indicates that the engine build job is created.
 	NimBuildConditionEngineBuildPodCreated = "NIM_BUILD_ENGINE_BUILD_POD_CREATED"
 	// NimBuildConditionEngineBuildJobCompleted indicates that the engine build pod is completed.
 	NimBuildConditionEngineBuildPodCompleted = "NIM_BUILD_ENGINE_BUILD_POD_COMPLETED"
@@ -159,9 +237,64 @@ func (n *NIMBuild) GetImagePullSecrets() []string {
 	return n.Spec.Image.PullSecrets
 }
 
-// GetEngineBuildPodName returns the name of the pod that will be created to build the NIM engine.
-func (n *NIMBuild) GetEngineBuildPodName() string {
-	return fmt.Sprintf("%s-engine-build-pod", n.Name)
+// GetEngineBuildJobName returns the name of the job that will be created to build the NIM engine.
+func (n *NIMBuild) GetEngineBuildJobName() string {
+	return n.GetBuildJobName(n.Name)
+}
+
+// GetBuildJobName returns the name of the job that will be created for the given engine build.
+func (n *NIMBuild) GetBuildJobName(build string) string {
+	return fmt.Sprintf("%s-engine-build-job", build)
+}
+
+// GetBackoffLimit returns the number of retries of a failed engine build.
+func (n *NIMBuild) GetBackoffLimit() int32 {
+	if n.Spec.BuildPolicy == nil || n.Spec.BuildPolicy.BackoffLimit == nil {
+		return 3
+	}
+	return *n.Spec.BuildPolicy.BackoffLimit
+}
+
+// GetActiveDeadlineSeconds returns the maximum duration of an engine build, if any.
+func (n *NIMBuild) GetActiveDeadlineSeconds() *int64 {
+	if n.Spec.BuildPolicy == nil {
+		return nil
+	}
+	return n.Spec.BuildPolicy.ActiveDeadlineSeconds
+}
+
+// IsRetryOnOOMEnabled returns true if an engine build whose container is OOM killed is retried.
+func (n *NIMBuild) IsRetryOnOOMEnabled() bool {
+	if n.Spec.BuildPolicy == nil || n.Spec.BuildPolicy.RetryOnOOM == nil {
+		return true
+	}
+	return *n.Spec.BuildPolicy.RetryOnOOM
+}
+
+// GetNonRetryableExitCodes returns the exit codes of the build container failing an engine build right away.
+func (n *NIMBuild) GetNonRetryableExitCodes() []int32 {
+	if n.Spec.BuildPolicy == nil {
+		return nil
+	}
+	return n.Spec.BuildPolicy.NonRetryableExitCodes
+}
+
+// IsRebuildRequested returns true if the engines are requested to be built again.
+func (n *NIMBuild) IsRebuildRequested() bool {
+	return n.GetAnnotations()[NIMBuildRebuildAnnotation] == "true"
+}
+
+// IsBuildMatrixEnabled returns true if the NIMBuild builds an engine per cell of a build matrix.
+func (n *NIMBuild) IsBuildMatrixEnabled() bool {
+	return n.Spec.BuildMatrix != nil
+}
+
+// GetMaxConcurrentBuilds returns the maximum number of engines built at once.
+func (n *NIMBuild) GetMaxConcurrentBuilds() int {
+	if n.Spec.BuildMatrix == nil || n.Spec.BuildMatrix.MaxConcurrentBuilds < 1 {
+		return 1
+	}
+	return int(n.Spec.BuildMatrix.MaxConcurrentBuilds)
 }
 
 // GetLocalManifestReaderPodName returns the name of the pod that will be created to read the local manifest.
@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -56,6 +58,42 @@ type NIMCacheSpec struct {
 	// RuntimeClassName is the runtimeclass for the caching job
 	RuntimeClassName string     `json:"runtimeClassName,omitempty"`
 	Proxy            *ProxySpec `json:"proxy,omitempty"`
+	// Refresh configures periodic checks of the upstream model manifest for new profiles and releases.
+	// Only supported for optimized NIMs from NGC.
+	// +optional
+	Refresh *NIMCacheRefresh `json:"refresh,omitempty"`
+	// Retention configures the garbage collection of cached profiles from the PVC.
+	// Only supported for optimized NIMs from NGC cached in a PVC.
+	// +optional
+	Retention *NIMCacheRetention `json:"retention,omitempty"`
+}
+
+// NIMCacheRefresh defines when to check the upstream model manifest for updates of the cached profiles.
+// +kubebuilder:validation:XValidation:rule="(has(self.interval) ? 1 : 0) + (has(self.schedule) ? 1 : 0) == 1",message="Exactly one of interval or schedule must be defined"
+type NIMCacheRefresh struct {
+	// Interval is the time between checks for updates, e.g. "24h"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Schedule is the cron schedule of the checks for updates, e.g. "0 2 * * *"
+	Schedule *string `json:"schedule,omitempty"`
+	// AutoUpdate re-runs the caching job for the profiles added or changed upstream when an update is available.
+	// Otherwise updates are only reported in the NIMCache status.
+	// +kubebuilder:default:=false
+	AutoUpdate bool `json:"autoUpdate,omitempty"`
+}
+
+// NIMCacheRetention defines which cached profiles are deleted from the PVC.
+// Profiles referenced by a NIMService or a NIMBuild are never deleted. A NIMService referencing the
+// NIMCache without an explicit profile references all of its profiles.
+// +kubebuilder:validation:XValidation:rule="has(self.keepReleases) || has(self.unreferencedFor)",message="At least one of keepReleases or unreferencedFor must be defined"
+type NIMCacheRetention struct {
+	// KeepReleases is the number of most recent releases of each model to keep, older releases are deleted
+	// +kubebuilder:validation:Minimum=1
+	KeepReleases *int32 `json:"keepReleases,omitempty"`
+	// UnreferencedFor is the time after which profiles no longer referenced are deleted, e.g. "168h"
+	UnreferencedFor *metav1.Duration `json:"unreferencedFor,omitempty"`
+	// Interval is the time between garbage collections, e.g. "24h"
+	// +kubebuilder:default:="24h"
+	Interval *metav1.Duration `json:"interval,omitempty"`
 }
 
 // +kubebuilder:validation:XValidation:rule="(has(self.ngc) ? 1 : 0) + (has(self.dataStore) ? 1 : 0) + (has(self.hf) ? 1 : 0) == 1",message="Exactly one of ngc, dataStore, or hf must be defined"
@@ -162,14 +200,67 @@ type NIMCacheStorage struct {
 	//
 	// Deprecated: use PVC instead.
 	HostPath *string `json:"hostPath,omitempty"`
+	// ObjectStore is the S3 compatible object store bucket the NIM model is uploaded to.
+	// The caching job stages the model in the PVC, or an emptyDir when no PVC is set, before uploading it.
+	ObjectStore *NIMCacheObjectStore `json:"objectStore,omitempty"`
 }
 
+// NIMCacheObjectStore defines an S3 compatible object store bucket for caching NIM.
+type NIMCacheObjectStore struct {
+	// Endpoint is the fully qualified object store endpoint, e.g. https://s3.us-east-1.amazonaws.com or http://minio.minio:9000
+	// +kubebuilder:validation:Pattern=`^https?://.+$`
+	Endpoint string `json:"endpoint"`
+	// Bucket is the name of the bucket the model is stored in
+	// +kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+	// Prefix is the key prefix of the model within the bucket. Defaults to <namespace>/<name> of the NIMCache.
+	Prefix string `json:"prefix,omitempty"`
+	// Region is the region the bucket is hosted in
+	Region string `json:"region,omitempty"`
+	// Credentials is the secret containing the object store access keys
+	Credentials ObjectStoreAccessKeys `json:"credentials"`
+	// Image is the containerized aws-cli image used to upload and download the model
+	// +kubebuilder:default="docker.io/amazon/aws-cli:2.27.0"
+	Image string `json:"image,omitempty"`
+	// PullSecret is the name of the image pull secret for the image
+	PullSecret string `json:"pullSecret,omitempty"`
+}
+
+// ObjectStoreAccessKeys references the access keys of an object store stored in a secret.
+type ObjectStoreAccessKeys struct {
+	// SecretName is the name of the secret containing the access keys
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
+	// AccessKeyIDKey is the key of the access key ID in the secret
+	// +kubebuilder:default="AWS_ACCESS_KEY_ID"
+	AccessKeyIDKey string `json:"accessKeyIDKey,omitempty"`
+	// SecretAccessKeyKey is the key of the secret access key in the secret
+	// +kubebuilder:default="AWS_SECRET_ACCESS_KEY"
+	SecretAccessKeyKey string `json:"secretAccessKeyKey,omitempty"`
+}
+
+// NIMCacheVerifyAnnotation is set to "true" on a NIMCache to check the model files of the cached profiles
+// against the checksums recorded when they were cached.
+const NIMCacheVerifyAnnotation = "nvidia.com/nimcache-verify"
+
 // NIMCacheStatus defines the observed state of NIMCache.
 type NIMCacheStatus struct {
-	State      string             `json:"state,omitempty"`
-	PVC        string             `json:"pvc,omitempty"`
-	Profiles   []NIMProfile       `json:"profiles,omitempty"`
-	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+	State string `json:"state,omitempty"`
+	PVC   string `json:"pvc,omitempty"`
+	// ObjectStoreURI is the location of the cached model in the object store
+	ObjectStoreURI string       `json:"objectStoreURI,omitempty"`
+	Profiles       []NIMProfile `json:"profiles,omitempty"`
+	// LastRefreshTime is the last time the upstream model manifest was checked for updates
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+	// UpdatedProfiles are the profiles added or changed upstream since they were cached
+	UpdatedProfiles []string `json:"updatedProfiles,omitempty"`
+	// LastCleanupTime is the last time the cached profiles were garbage collected
+	LastCleanupTime *metav1.Time `json:"lastCleanupTime,omitempty"`
+	// StorageUsage is the disk space used by the cached profiles, measured on garbage collection
+	StorageUsage *resource.Quantity `json:"storageUsage,omitempty"`
+	// LastVerifiedTime is the last time the model files of the cached profiles were checked against their checksums
+	LastVerifiedTime *metav1.Time       `json:"lastVerifiedTime,omitempty"`
+	Conditions       []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
 }
 
 // NIMProfile defines the profiles that were cached.
@@ -178,6 +269,14 @@ type NIMProfile struct {
 	Model   string            `json:"model,omitempty"`
 	Release string            `json:"release,omitempty"`
 	Config  map[string]string `json:"config,omitempty"`
+	// LastReferencedTime is the last time a NIMService or a NIMBuild was found referencing the profile
+	LastReferencedTime *metav1.Time `json:"lastReferencedTime,omitempty"`
+	// Size is the disk space used by the model files of the profile
+	Size *resource.Quantity `json:"size,omitempty"`
+	// Files is the number of model files of the profile
+	Files int32 `json:"files,omitempty"`
+	// Checksum is the sha256 digest of the sorted sha256 checksums of the model files of the profile
+	Checksum string `json:"checksum,omitempty"`
 }
 
 // Resources defines the minimum resources required for caching NIM.
@@ -200,6 +299,10 @@ const (
 	NimCacheConditionPVCCreated = "NIM_CACHE_PVC_CREATED"
 	// NimCacheConditionReconcileFailed indicated that error occurred while reconciling NIMCache object.
 	NimCacheConditionReconcileFailed = "NIM_CACHE_RECONCILE_FAILED"
+	// NimCacheConditionUpdateAvailable indicates that cached profiles were added or changed in the upstream model manifest.
+	NimCacheConditionUpdateAvailable = "NIM_CACHE_UPDATE_AVAILABLE"
+	// NimCacheConditionIntegrityVerified indicates that the model files of the cached profiles match their checksums.
+	NimCacheConditionIntegrityVerified = "NIM_CACHE_INTEGRITY_VERIFIED"
 
 	// NimCacheStatusNotReady indicates that cache is not ready.
 	NimCacheStatusNotReady = "NotReady"
@@ -259,6 +362,7 @@ func (s *NIMSource) EnvFromSecrets() []corev1.EnvFromSource {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.state`,priority=0
 // +kubebuilder:printcolumn:name="PVC",type=string,JSONPath=`.status.pvc`,priority=0
+// +kubebuilder:printcolumn:name="Usage",type=string,JSONPath=`.status.storageUsage`,priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",format="date-time",JSONPath=".metadata.creationTimestamp",priority=0
 
 // NIMCache is the Schema for the nimcaches API.
@@ -280,6 +384,35 @@ func (n *NIMCache) GetPVCName(pvc PersistentVolumeClaim) string {
 	return pvcName
 }
 
+// IsObjectStoreEnabled returns true if the NIM model is cached in an object store.
+func (n *NIMCache) IsObjectStoreEnabled() bool {
+	return n.Spec.Storage.ObjectStore != nil
+}
+
+// IsPVCEnabled returns true if a PVC is configured for caching NIM.
+func (n *NIMCache) IsPVCEnabled() bool {
+	return n.Spec.Storage.PVC.Name != "" || ptr.Deref(n.Spec.Storage.PVC.Create, false)
+}
+
+// GetObjectStorePrefix returns the key prefix of the model within the object store bucket.
+func (n *NIMCache) GetObjectStorePrefix() string {
+	if n.Spec.Storage.ObjectStore == nil {
+		return ""
+	}
+	if n.Spec.Storage.ObjectStore.Prefix != "" {
+		return strings.Trim(n.Spec.Storage.ObjectStore.Prefix, "/")
+	}
+	return fmt.Sprintf("%s/%s", n.GetNamespace(), n.GetName())
+}
+
+// GetObjectStoreURI returns the S3 URI of the model within the object store bucket.
+func (n *NIMCache) GetObjectStoreURI() string {
+	if n.Spec.Storage.ObjectStore == nil {
+		return ""
+	}
+	return fmt.Sprintf("s3://%s/%s", n.Spec.Storage.ObjectStore.Bucket, n.GetObjectStorePrefix())
+}
+
 // GetUserID returns user ID. Returns default value if not set on NimCache object.
 func (n *NIMCache) GetUserID() *int64 {
 	if n.Spec.UserID == nil {
@@ -339,6 +472,29 @@ func (n *NIMCache) IsOptimizedNIM() bool {
 	return false
 }
 
+// IsRefreshEnabled returns true if the NIMCache periodically checks the upstream model manifest for updates.
+func (n *NIMCache) IsRefreshEnabled() bool {
+	return n.Spec.Refresh != nil && n.IsOptimizedNIM()
+}
+
+// IsRetentionEnabled returns true if the cached profiles are garbage collected from the PVC.
+func (n *NIMCache) IsRetentionEnabled() bool {
+	return n.Spec.Retention != nil && n.IsOptimizedNIM() && n.IsPVCEnabled()
+}
+
+// GetRetentionInterval returns the time between garbage collections of the cached profiles.
+func (n *NIMCache) GetRetentionInterval() time.Duration {
+	if n.Spec.Retention == nil || n.Spec.Retention.Interval == nil {
+		return 24 * time.Hour
+	}
+	return n.Spec.Retention.Interval.Duration
+}
+
+// IsVerifyRequested returns true if a check of the model files of the cached profiles is requested.
+func (n *NIMCache) IsVerifyRequested() bool {
+	return n.GetAnnotations()[NIMCacheVerifyAnnotation] == "true"
+}
+
 // GetModelSpec returns the model spec for the NIMCache.
 func (n *NIMCache) GetModelSpec() ModelSpec {
 	if n.Spec.Source.NGC != nil && n.Spec.Source.NGC.Model != nil {
@@ -0,0 +1,101 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NIMCacheGrantSpec defines the NIMServices allowed to reference the NIMCaches in the namespace of the grant.
+type NIMCacheGrantSpec struct {
+	// From is the list of namespaces of the NIMServices allowed to reference the NIMCaches.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=namespace
+	From []NIMCacheGrantFrom `json:"from"`
+	// To is the list of NIMCaches that can be referenced. All NIMCaches in the namespace of the grant can be
+	// referenced when empty.
+	// +listType=map
+	// +listMapKey=name
+	To []NIMCacheGrantTo `json:"to,omitempty"`
+}
+
+// NIMCacheGrantFrom identifies the namespace of the NIMServices allowed to reference the NIMCaches.
+type NIMCacheGrantFrom struct {
+	// Namespace is the namespace of the referencing NIMServices.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+}
+
+// NIMCacheGrantTo identifies a NIMCache that can be referenced.
+type NIMCacheGrantTo struct {
+	// Name is the name of the NIMCache.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=nimcg
+
+// NIMCacheGrant is the Schema for the nimcachegrants API.
+// It allows NIMServices in other namespaces to use the NIMCaches in the namespace of the grant,
+// similar to the Gateway API ReferenceGrant.
+type NIMCacheGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NIMCacheGrantSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NIMCacheGrantList contains a list of NIMCacheGrant.
+type NIMCacheGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NIMCacheGrant `json:"items"`
+}
+
+// Allows returns true if the grant allows NIMServices in the given namespace to reference the given NIMCache
+// in the namespace of the grant.
+func (g *NIMCacheGrant) Allows(fromNamespace, nimCacheName string) bool {
+	fromAllowed := false
+	for _, from := range g.Spec.From {
+		if from.Namespace == fromNamespace {
+			fromAllowed = true
+			break
+		}
+	}
+	if !fromAllowed {
+		return false
+	}
+
+	if len(g.Spec.To) == 0 {
+		return true
+	}
+	for _, to := range g.Spec.To {
+		if to.Name == nimCacheName {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	SchemeBuilder.Register(&NIMCacheGrant{}, &NIMCacheGrantList{})
+}
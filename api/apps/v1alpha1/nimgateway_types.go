@@ -0,0 +1,520 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
+	utils "github.com/NVIDIA/k8s-nim-operator/internal/utils"
+)
+
+const (
+	// NIMGatewayStatusNotReady indicates that the NIMGateway is not ready.
+	NIMGatewayStatusNotReady = "NotReady"
+	// NIMGatewayStatusReady indicates that the NIMGateway is ready.
+	NIMGatewayStatusReady = "Ready"
+	// NIMGatewayStatusFailed indicates that the NIMGateway has failed.
+	NIMGatewayStatusFailed = "Failed"
+
+	// DefaultNIMGatewayCommand is the gateway binary of the gateway image.
+	DefaultNIMGatewayCommand = "/nim-gateway"
+	// NIMGatewayConfigFile is the gateway config file rendered into the NIMGateway configmap.
+	NIMGatewayConfigFile = "gateway.json"
+	// NIMGatewayConfigPath is the mount path of the NIMGateway configmap.
+	NIMGatewayConfigPath = "/etc/nim-gateway/config"
+	// NIMGatewayAPIKeysPath is the mount path of the NIMGateway API keys secret.
+	NIMGatewayAPIKeysPath = "/etc/nim-gateway/api-keys"
+)
+
+// NIMGatewaySpec defines the desired state of NIMGateway.
+type NIMGatewaySpec struct {
+	// NIMServiceSelector selects the NIMServices, in the namespace of the NIMGateway, routed by the gateway.
+	// An empty selector selects all the NIMServices of the namespace.
+	NIMServiceSelector metav1.LabelSelector `json:"nimServiceSelector,omitempty"`
+	// Routes orders the NIMServices serving a model. The gateway sends the requests for the model
+	// to the first available NIMService, falling back to the next one when it is unreachable or overloaded.
+	// The listed NIMServices come first, in order, followed by the other NIMServices serving the model, by name.
+	// +listType=map
+	// +listMapKey=model
+	Routes []NIMGatewayRoute `json:"routes,omitempty"`
+	// Auth requires the clients to authenticate with an API key.
+	Auth *NIMGatewayAuth `json:"auth,omitempty"`
+	// RateLimit limits the rate of the requests of each client, identified by its API key or else by its address.
+	RateLimit *NIMGatewayRateLimit `json:"rateLimit,omitempty"`
+	// Image is the gateway image, running /nim-gateway.
+	// Defaults to the operator image, which ships the gateway.
+	Image        *Image                       `json:"image,omitempty"`
+	Labels       map[string]string            `json:"labels,omitempty"`
+	Annotations  map[string]string            `json:"annotations,omitempty"`
+	NodeSelector map[string]string            `json:"nodeSelector,omitempty"`
+	Tolerations  []corev1.Toleration          `json:"tolerations,omitempty"`
+	Resources    *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="!(has(self.service.grpcPort))", message="unsupported field: spec.expose.service.grpcPort"
+	// +kubebuilder:validation:XValidation:rule="!(has(self.service.metricsPort))", message="unsupported field: spec.expose.service.metricsPort"
+	Expose ExposeV1 `json:"expose,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default:=1
+	Replicas int `json:"replicas,omitempty"`
+}
+
+// NIMGatewayRoute defines the fallback order of the NIMServices serving a model.
+type NIMGatewayRoute struct {
+	// Model is the name of the model requested by the clients.
+	// +kubebuilder:validation:MinLength=1
+	Model string `json:"model"`
+	// NIMServices are the names of the NIMServices to route the model to first, in fallback order.
+	// +kubebuilder:validation:MinItems=1
+	NIMServices []string `json:"nimServices"`
+}
+
+// NIMGatewayAuth defines the API key authentication of the gateway.
+type NIMGatewayAuth struct {
+	// APIKeysSecret is the secret holding the API keys accepted by the gateway, one API key per secret key.
+	// The clients send the API key as a bearer token. The secret can be updated without restarting the gateway.
+	// +kubebuilder:validation:MinLength=1
+	APIKeysSecret string `json:"apiKeysSecret"`
+}
+
+// NIMGatewayRateLimit defines the request rate limit of each client of the gateway.
+type NIMGatewayRateLimit struct {
+	// RequestsPerMinute is the sustained number of requests per minute allowed for a client.
+	// +kubebuilder:validation:Minimum=1
+	RequestsPerMinute int32 `json:"requestsPerMinute"`
+	// Burst is the number of requests a client can send at once. Defaults to RequestsPerMinute.
+	// +kubebuilder:validation:Minimum=1
+	Burst *int32 `json:"burst,omitempty"`
+}
+
+// NIMGatewayStatus defines the observed state of NIMGateway.
+type NIMGatewayStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	State      string             `json:"state,omitempty"`
+	// Models are the models routed by the gateway.
+	// +listType=map
+	// +listMapKey=name
+	Models []NIMGatewayModelStatus `json:"models,omitempty"`
+}
+
+// NIMGatewayModelStatus defines the NIMServices serving a model routed by the gateway.
+type NIMGatewayModelStatus struct {
+	// Name is the name of the model.
+	Name string `json:"name"`
+	// NIMServices are the NIMServices serving the model, in fallback order.
+	NIMServices []string `json:"nimServices"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.state`,priority=0
+// +kubebuilder:printcolumn:name="Age",type="date",format="date-time",JSONPath=".metadata.creationTimestamp",priority=0
+
+// NIMGateway is the Schema for the nimgateways API.
+// A NIMGateway deploys an OpenAI compatible gateway routing the requests to the NIMServices serving the requested model.
+type NIMGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NIMGatewaySpec   `json:"spec,omitempty"`
+	Status NIMGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NIMGatewayList contains a list of NIMGateway.
+type NIMGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NIMGateway `json:"items"`
+}
+
+// GetStandardSelectorLabels returns the standard selector labels for the NIMGateway deployment.
+func (n *NIMGateway) GetStandardSelectorLabels() map[string]string {
+	return map[string]string{
+		"app":                        n.Name,
+		"app.kubernetes.io/name":     n.Name,
+		"app.kubernetes.io/instance": n.Name,
+	}
+}
+
+// GetStandardLabels returns the standard set of labels for NIMGateway resources.
+func (n *NIMGateway) GetStandardLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":             n.Name,
+		"app.kubernetes.io/instance":         n.Name,
+		"app.kubernetes.io/operator-version": os.Getenv("OPERATOR_VERSION"),
+		"app.kubernetes.io/part-of":          "nim-gateway",
+		"app.kubernetes.io/managed-by":       "k8s-nim-operator",
+	}
+}
+
+// GetStandardAnnotations returns default annotations to apply to the NIMGateway instance.
+func (n *NIMGateway) GetStandardAnnotations() map[string]string {
+	return map[string]string{
+		"openshift.io/required-scc":             "nonroot",
+		utils.NvidiaAnnotationParentSpecHashKey: utils.DeepHashObject(n.Spec),
+	}
+}
+
+// GetNIMGatewayAnnotations returns annotations to apply to the NIMGateway instance.
+func (n *NIMGateway) GetNIMGatewayAnnotations() map[string]string {
+	standardAnnotations := n.GetStandardAnnotations()
+
+	if n.Spec.Annotations != nil {
+		return utils.MergeMaps(standardAnnotations, n.Spec.Annotations)
+	}
+	return standardAnnotations
+}
+
+// GetServiceLabels returns merged labels to apply to the NIMGateway instance.
+func (n *NIMGateway) GetServiceLabels() map[string]string {
+	standardLabels := n.GetStandardLabels()
+
+	if n.Spec.Labels != nil {
+		return utils.MergeMaps(standardLabels, n.Spec.Labels)
+	}
+	return standardLabels
+}
+
+// GetSelectorLabels returns standard selector labels to apply to the NIMGateway instance.
+func (n *NIMGateway) GetSelectorLabels() map[string]string {
+	return n.GetStandardSelectorLabels()
+}
+
+// GetIngressAnnotations returns annotations to apply to the NIMGateway ingress.
+func (n *NIMGateway) GetIngressAnnotations() map[string]string {
+	annotations := n.GetNIMGatewayAnnotations()
+
+	if n.Spec.Expose.Ingress.Annotations != nil {
+		return utils.MergeMaps(annotations, n.Spec.Expose.Ingress.Annotations)
+	}
+	return annotations
+}
+
+// GetRouterAnnotations returns annotations to apply to the NIMGateway routes.
+func (n *NIMGateway) GetRouterAnnotations() map[string]string {
+	annotations := n.GetNIMGatewayAnnotations()
+
+	if n.Spec.Expose.Router != nil && n.Spec.Expose.Router.Annotations != nil {
+		return utils.MergeMaps(annotations, n.Spec.Expose.Router.Annotations)
+	}
+	return annotations
+}
+
+// GetServiceAnnotations returns annotations to apply to the NIMGateway service.
+func (n *NIMGateway) GetServiceAnnotations() map[string]string {
+	annotations := n.GetNIMGatewayAnnotations()
+
+	if n.Spec.Expose.Service.Annotations != nil {
+		return utils.MergeMaps(annotations, n.Spec.Expose.Service.Annotations)
+	}
+	return annotations
+}
+
+// GetContainerName returns name of the container for NIMGateway deployment.
+func (n *NIMGateway) GetContainerName() string {
+	return "nim-gateway"
+}
+
+// GetImage returns the gateway image and its pull policy.
+// An empty image is returned when neither the NIMGateway nor the operator configure one.
+func (n *NIMGateway) GetImage() (string, string) {
+	if n.Spec.Image != nil {
+		return fmt.Sprintf("%s:%s", n.Spec.Image.Repository, n.Spec.Image.Tag), n.Spec.Image.PullPolicy
+	}
+	return os.Getenv(ModelRouterImageEnv), ""
+}
+
+// GetImagePullSecrets returns the image pull secrets for the gateway container.
+func (n *NIMGateway) GetImagePullSecrets() []string {
+	if n.Spec.Image != nil {
+		return n.Spec.Image.PullSecrets
+	}
+	return nil
+}
+
+// GetConfigMapName returns the name of the configmap holding the gateway config.
+func (n *NIMGateway) GetConfigMapName() string {
+	return fmt.Sprintf("%s-config", n.GetName())
+}
+
+// IsAuthEnabled returns true if the gateway requires an API key.
+func (n *NIMGateway) IsAuthEnabled() bool {
+	return n.Spec.Auth != nil && n.Spec.Auth.APIKeysSecret != ""
+}
+
+// GetRateLimitBurst returns the burst of the client rate limit.
+func (n *NIMGateway) GetRateLimitBurst() int32 {
+	if n.Spec.RateLimit == nil {
+		return 0
+	}
+	if n.Spec.RateLimit.Burst != nil {
+		return *n.Spec.RateLimit.Burst
+	}
+	return n.Spec.RateLimit.RequestsPerMinute
+}
+
+// GetArgs returns the arguments of the gateway container.
+func (n *NIMGateway) GetArgs() []string {
+	args := []string{
+		fmt.Sprintf("--port=%d", DefaultAPIPort),
+		fmt.Sprintf("--config=%s/%s", NIMGatewayConfigPath, NIMGatewayConfigFile),
+	}
+	if n.IsAuthEnabled() {
+		args = append(args, fmt.Sprintf("--api-keys-dir=%s", NIMGatewayAPIKeysPath))
+	}
+	return args
+}
+
+// GetVolumes returns the volumes of the gateway config and API keys.
+func (n *NIMGateway) GetVolumes() []corev1.Volume {
+	volumes := []corev1.Volume{
+		{
+			Name: "config",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: n.GetConfigMapName()},
+				},
+			},
+		},
+	}
+	if n.IsAuthEnabled() {
+		volumes = append(volumes, corev1.Volume{
+			Name: "api-keys",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  n.Spec.Auth.APIKeysSecret,
+					DefaultMode: ptr.To[int32](420),
+				},
+			},
+		})
+	}
+	return volumes
+}
+
+// GetVolumeMounts returns the volume mounts of the gateway config and API keys.
+func (n *NIMGateway) GetVolumeMounts() []corev1.VolumeMount {
+	volumeMounts := []corev1.VolumeMount{
+		{Name: "config", MountPath: NIMGatewayConfigPath, ReadOnly: true},
+	}
+	if n.IsAuthEnabled() {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: "api-keys", MountPath: NIMGatewayAPIKeysPath, ReadOnly: true})
+	}
+	return volumeMounts
+}
+
+// GetReadinessProbe returns the readiness probe for the gateway container.
+func (n *NIMGateway) GetReadinessProbe() *corev1.Probe {
+	return &corev1.Probe{
+		PeriodSeconds:    10,
+		FailureThreshold: 3,
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: "/v1/health/ready",
+				Port: intstr.FromString(DefaultNamedPortAPI),
+			},
+		},
+	}
+}
+
+// GetLivenessProbe returns the liveness probe for the gateway container.
+func (n *NIMGateway) GetLivenessProbe() *corev1.Probe {
+	return &corev1.Probe{
+		PeriodSeconds:    10,
+		FailureThreshold: 3,
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: "/v1/health/live",
+				Port: intstr.FromString(DefaultNamedPortAPI),
+			},
+		},
+	}
+}
+
+// IsIngressEnabled returns true if ingress is enabled for the NIMGateway.
+func (n *NIMGateway) IsIngressEnabled() bool {
+	return n.Spec.Expose.Ingress.Enabled != nil && *n.Spec.Expose.Ingress.Enabled
+}
+
+// IsRouterEnabled returns true if Gateway API routes are enabled for the NIMGateway.
+func (n *NIMGateway) IsRouterEnabled() bool {
+	return n.Spec.Expose.Router != nil
+}
+
+// GetIngressSpec returns the Ingress spec of the NIMGateway.
+func (n *NIMGateway) GetIngressSpec() networkingv1.IngressSpec {
+	return n.Spec.Expose.Ingress.GenerateNetworkingV1IngressSpec(n.GetName())
+}
+
+// GetServicePort returns the service port for the NIMGateway or default port.
+func (n *NIMGateway) GetServicePort() int32 {
+	if n.Spec.Expose.Service.Port == nil {
+		return DefaultAPIPort
+	}
+	return *n.Spec.Expose.Service.Port
+}
+
+// GetServiceType returns the service type for the NIMGateway.
+func (n *NIMGateway) GetServiceType() string {
+	return string(n.Spec.Expose.Service.Type)
+}
+
+// GetServiceAccountParams return params to render ServiceAccount from templates.
+func (n *NIMGateway) GetServiceAccountParams() *rendertypes.ServiceAccountParams {
+	params := &rendertypes.ServiceAccountParams{}
+
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetNIMGatewayAnnotations()
+	return params
+}
+
+// GetConfigMapParams returns params to render the gateway config ConfigMap from templates.
+func (n *NIMGateway) GetConfigMapParams(config string) *rendertypes.ConfigMapParams {
+	params := &rendertypes.ConfigMapParams{}
+
+	// Set metadata
+	params.Name = n.GetConfigMapName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.ConfigMapData = map[string]string{NIMGatewayConfigFile: config}
+	return params
+}
+
+// GetDeploymentParams returns params to render Deployment from templates.
+func (n *NIMGateway) GetDeploymentParams() *rendertypes.DeploymentParams {
+	params := &rendertypes.DeploymentParams{}
+
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetNIMGatewayAnnotations()
+	params.PodAnnotations = n.GetNIMGatewayAnnotations()
+	delete(params.PodAnnotations, utils.NvidiaAnnotationParentSpecHashKey)
+
+	// Set template spec
+	params.Replicas = n.Spec.Replicas
+	params.NodeSelector = n.Spec.NodeSelector
+	params.Tolerations = n.Spec.Tolerations
+	params.ImagePullSecrets = n.GetImagePullSecrets()
+
+	// Set labels and selectors
+	params.SelectorLabels = n.GetSelectorLabels()
+
+	// Set container spec
+	params.ContainerName = n.GetContainerName()
+	params.Image, params.ImagePullPolicy = n.GetImage()
+	params.Command = []string{DefaultNIMGatewayCommand}
+	params.Args = n.GetArgs()
+	params.Resources = n.Spec.Resources
+	params.Volumes = n.GetVolumes()
+	params.VolumeMounts = n.GetVolumeMounts()
+
+	// Set container probes
+	params.LivenessProbe = n.GetLivenessProbe()
+	params.ReadinessProbe = n.GetReadinessProbe()
+
+	// Set security context
+	params.UserID = ptr.To[int64](1000)
+	params.GroupID = ptr.To[int64](2000)
+
+	// Set service account
+	params.ServiceAccountName = n.GetName()
+
+	// Setup container ports for the gateway
+	params.Ports = []corev1.ContainerPort{
+		{
+			Name:          DefaultNamedPortAPI,
+			Protocol:      corev1.ProtocolTCP,
+			ContainerPort: DefaultAPIPort,
+		},
+	}
+	return params
+}
+
+// GetServiceParams returns params to render Service from templates.
+func (n *NIMGateway) GetServiceParams() *rendertypes.ServiceParams {
+	params := &rendertypes.ServiceParams{}
+
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetServiceAnnotations()
+
+	// Set service selector labels
+	params.SelectorLabels = n.GetSelectorLabels()
+
+	// Set service type
+	params.Type = n.GetServiceType()
+
+	// Set service ports
+	params.Ports = []corev1.ServicePort{
+		{
+			Name:       DefaultNamedPortAPI,
+			Port:       n.GetServicePort(),
+			TargetPort: intstr.FromString(DefaultNamedPortAPI),
+			Protocol:   corev1.ProtocolTCP,
+		},
+	}
+	return params
+}
+
+// GetIngressParams returns params to render Ingress from templates.
+func (n *NIMGateway) GetIngressParams() *rendertypes.IngressParams {
+	params := &rendertypes.IngressParams{}
+
+	params.Enabled = n.IsIngressEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetIngressAnnotations()
+	params.Spec = n.GetIngressSpec()
+	return params
+}
+
+// GetHTTPRouteParams returns params to render HTTPRoute from templates.
+func (n *NIMGateway) GetHTTPRouteParams() *rendertypes.HTTPRouteParams {
+	params := &rendertypes.HTTPRouteParams{}
+
+	params.Enabled = n.IsRouterEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetRouterAnnotations()
+	if params.Enabled {
+		params.Spec = n.Spec.Expose.Router.GenerateGatewayHTTPRouteSpec(n.GetName(), n.GetServicePort())
+	}
+	return params
+}
+
+func init() {
+	SchemeBuilder.Register(&NIMGateway{}, &NIMGatewayList{})
+}
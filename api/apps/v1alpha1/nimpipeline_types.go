@@ -17,7 +17,14 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"time"
+
+	"dario.cat/mergo"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -35,15 +42,152 @@ const (
 	NIMPipelineStatusReady = "Ready"
 	// NIMPipelineStatusFailed indicates that one or more services in the NIM pipeline has failed.
 	NIMPipelineStatusFailed = "Failed"
+
+	// NIMPipelineReasonInvalidDependencies indicates that the service dependencies of the NIM pipeline are invalid.
+	NIMPipelineReasonInvalidDependencies = "InvalidDependencies"
+
+	// NIMPipelineConditionVerified indicates that the end-to-end verification of the NIM pipeline has passed.
+	NIMPipelineConditionVerified = "PipelineVerified"
+
+	// NIMPipelineReasonServicesNotReady indicates that the verification is waiting for all services of the NIM pipeline to be ready.
+	NIMPipelineReasonServicesNotReady = "ServicesNotReady"
+	// NIMPipelineReasonVerificationPassed indicates that all steps of the verification have passed.
+	NIMPipelineReasonVerificationPassed = "VerificationPassed"
+	// NIMPipelineReasonVerificationFailed indicates that a step of the verification has failed.
+	NIMPipelineReasonVerificationFailed = "VerificationFailed"
+)
+
+// Kinds of the services deployed by a NIMPipeline.
+const (
+	NIMServiceKind      = "NIMService"
+	NemoGuardrailKind   = "NemoGuardrail"
+	NemoEvaluatorKind   = "NemoEvaluator"
+	NemoCustomizerKind  = "NemoCustomizer"
+	NemoDatastoreKind   = "NemoDatastore"
+	NemoEntitystoreKind = "NemoEntitystore"
 )
 
+// DefaultNIMPipelineVerificationTimeout is the default timeout of each verification request.
+const DefaultNIMPipelineVerificationTimeout = 30 * time.Second
+
 // NIMPipelineSpec defines the desired state of NIMPipeline.
 type NIMPipelineSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
+	// Defaults configures NIMService attributes shared by all services of the pipeline.
+	// Fields set on a service take precedence over the defaults, while unset fields inherit them.
+	// Maps such as labels or nodeSelector are merged by key, env variables are merged by name,
+	// while other lists and optional fields such as resources or scale.enabled are replaced as a whole.
+	// Zero values are considered unset, so a service cannot override a default back to 0, false or an empty
+	// string on fields that are not optional, such as schedulerName.
+	Defaults *NIMServicePipelineDefaults `json:"defaults,omitempty"`
+
 	// NIMService configures attributes to deploy a NIM service as part of the pipeline
 	Services []NIMServicePipelineSpec `json:"services,omitempty"`
+
+	// Guardrails configures NemoGuardrails deployed as part of the pipeline
+	Guardrails []NemoGuardrailPipelineSpec `json:"guardrails,omitempty"`
+	// Evaluators configures NemoEvaluators deployed as part of the pipeline
+	Evaluators []NemoEvaluatorPipelineSpec `json:"evaluators,omitempty"`
+	// Customizers configures NemoCustomizers deployed as part of the pipeline
+	Customizers []NemoCustomizerPipelineSpec `json:"customizers,omitempty"`
+	// Datastores configures NemoDatastores deployed as part of the pipeline
+	Datastores []NemoDatastorePipelineSpec `json:"datastores,omitempty"`
+	// Entitystores configures NemoEntitystores deployed as part of the pipeline
+	Entitystores []NemoEntitystorePipelineSpec `json:"entitystores,omitempty"`
+
+	// Verification configures an end-to-end smoke test of the pipeline, run once all services are ready
+	Verification *NIMPipelineVerification `json:"verification,omitempty"`
+}
+
+// NIMPipelineVerification defines a chain of requests sent through the pipeline services to verify it end to end.
+// Steps are run in order and the chain stops at the first failed step.
+type NIMPipelineVerification struct {
+	// Steps is the ordered chain of requests, e.g. embedding, reranking and then the LLM
+	// +kubebuilder:validation:MinItems=1
+	Steps []NIMPipelineVerificationStep `json:"steps"`
+	// Interval is the period to run the verification again after it passed.
+	// When unset, the verification only runs again when the pipeline spec changes or its services become ready again.
+	// Failed verifications are retried regardless.
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Timeout is the timeout of each request, defaults to 30s
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// NIMPipelineVerificationStep defines a request sent to a service of the pipeline.
+type NIMPipelineVerificationStep struct {
+	// Name is the step name
+	Name string `json:"name"`
+	// Service is the name of the pipeline service receiving the request
+	Service string `json:"service"`
+	// Path is the request path, e.g. /v1/embeddings
+	// +kubebuilder:validation:Pattern=`^/.*`
+	Path string `json:"path"`
+	// Method is the HTTP method of the request, defaults to POST when a body is set and GET otherwise
+	// +kubebuilder:validation:Enum=GET;POST
+	Method string `json:"method,omitempty"`
+	// Body is the JSON request body
+	Body string `json:"body,omitempty"`
+	// ExpectedStatusCode is the response status code of a successful step, defaults to 200
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	ExpectedStatusCode int32 `json:"expectedStatusCode,omitempty"`
+}
+
+// NIMServicePipelineDefaults defines the NIMService attributes shared by all services of the NIMPipeline.
+// All fields are optional, the attributes required by a NIMService, such as its image or auth secret, being set on each service.
+// Attributes defaulted on the services, such as replicas or inferencePlatform, are not shared.
+type NIMServicePipelineDefaults struct {
+	// Image defines the pull policy and pull secrets of the service images.
+	Image            *NIMServicePipelineImageDefaults `json:"image,omitempty"`
+	Env              []corev1.EnvVar                  `json:"env,omitempty"`
+	Labels           map[string]string                `json:"labels,omitempty"`
+	Annotations      map[string]string                `json:"annotations,omitempty"`
+	NodeSelector     map[string]string                `json:"nodeSelector,omitempty"`
+	Tolerations      []corev1.Toleration              `json:"tolerations,omitempty"`
+	PodAffinity      *corev1.PodAffinity              `json:"podAffinity,omitempty"`
+	Resources        *corev1.ResourceRequirements     `json:"resources,omitempty"`
+	Expose           Expose                           `json:"expose,omitempty"`
+	Scale            Autoscaling                      `json:"scale,omitempty"`
+	Metrics          Metrics                          `json:"metrics,omitempty"`
+	SchedulerName    string                           `json:"schedulerName,omitempty"`
+	UserID           *int64                           `json:"userID,omitempty"`
+	GroupID          *int64                           `json:"groupID,omitempty"`
+	RuntimeClassName string                           `json:"runtimeClassName,omitempty"`
+	Proxy            *ProxySpec                       `json:"proxy,omitempty"`
+}
+
+// NIMServicePipelineImageDefaults defines the image attributes shared by all services of the NIMPipeline.
+type NIMServicePipelineImageDefaults struct {
+	PullPolicy  string   `json:"pullPolicy,omitempty"`
+	PullSecrets []string `json:"pullSecrets,omitempty"`
+}
+
+// getNIMServiceSpec returns the pipeline defaults as a NIMService spec to merge the services into.
+func (d *NIMServicePipelineDefaults) getNIMServiceSpec() *NIMServiceSpec {
+	spec := &NIMServiceSpec{
+		Env:              d.Env,
+		Labels:           d.Labels,
+		Annotations:      d.Annotations,
+		NodeSelector:     d.NodeSelector,
+		Tolerations:      d.Tolerations,
+		PodAffinity:      d.PodAffinity,
+		Resources:        d.Resources,
+		Expose:           d.Expose,
+		Scale:            d.Scale,
+		Metrics:          d.Metrics,
+		SchedulerName:    d.SchedulerName,
+		UserID:           d.UserID,
+		GroupID:          d.GroupID,
+		RuntimeClassName: d.RuntimeClassName,
+		Proxy:            d.Proxy,
+	}
+	if d.Image != nil {
+		spec.Image.PullPolicy = d.Image.PullPolicy
+		spec.Image.PullSecrets = d.Image.PullSecrets
+	}
+	return spec
 }
 
 // NIMServicePipelineSpec defines the desired state of NIMService as part of the NIMPipeline.
@@ -54,15 +198,81 @@ type NIMServicePipelineSpec struct {
 	Dependencies []ServiceDependency `json:"dependencies,omitempty"`
 }
 
+// NeMo microservices of the pipeline share the service names and dependencies of NIMServices, so that they can
+// depend on each other. Their specs are validated by their own CRDs when deployed by the pipeline, and endpoints
+// of upstream services of the pipeline are wired into the specs when left unset.
+
+// NemoGuardrailPipelineSpec defines the desired state of NemoGuardrail as part of the NIMPipeline.
+// The NIM endpoint is generated from the first NIMService dependency of the pipeline when unset.
+type NemoGuardrailPipelineSpec struct {
+	Name    string `json:"name,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Spec         NemoGuardrailSpec   `json:"spec,omitempty"`
+	Dependencies []ServiceDependency `json:"dependencies,omitempty"`
+}
+
+// NemoEvaluatorPipelineSpec defines the desired state of NemoEvaluator as part of the NIMPipeline.
+// The datastore and entitystore endpoints are generated from the NemoDatastore and NemoEntitystore dependencies of the pipeline when unset.
+type NemoEvaluatorPipelineSpec struct {
+	Name    string `json:"name,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Spec         NemoEvaluatorSpec   `json:"spec,omitempty"`
+	Dependencies []ServiceDependency `json:"dependencies,omitempty"`
+}
+
+// NemoCustomizerPipelineSpec defines the desired state of NemoCustomizer as part of the NIMPipeline.
+// The datastore and entitystore endpoints are generated from the NemoDatastore and NemoEntitystore dependencies of the pipeline when unset.
+type NemoCustomizerPipelineSpec struct {
+	Name    string `json:"name,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Spec         NemoCustomizerSpec  `json:"spec,omitempty"`
+	Dependencies []ServiceDependency `json:"dependencies,omitempty"`
+}
+
+// NemoDatastorePipelineSpec defines the desired state of NemoDatastore as part of the NIMPipeline.
+type NemoDatastorePipelineSpec struct {
+	Name    string `json:"name,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Spec         NemoDatastoreSpec   `json:"spec,omitempty"`
+	Dependencies []ServiceDependency `json:"dependencies,omitempty"`
+}
+
+// NemoEntitystorePipelineSpec defines the desired state of NemoEntitystore as part of the NIMPipeline.
+// The datastore endpoint is generated from the NemoDatastore dependency of the pipeline when unset.
+type NemoEntitystorePipelineSpec struct {
+	Name    string `json:"name,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Spec         NemoEntitystoreSpec `json:"spec,omitempty"`
+	Dependencies []ServiceDependency `json:"dependencies,omitempty"`
+}
+
 // ServiceDependency defines service dependencies.
+// A dependency on another service of the pipeline holds back the service until the upstream service is ready.
 type ServiceDependency struct {
 	// Name is the dependent service name
 	Name string `json:"name"`
-	// Port is the dependent service port
-	Port int32 `json:"port"`
-	// EnvName is the dependent service endpoint environment variable name
+	// Port is the dependent service port, defaults to the service port of the upstream service in the pipeline
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+	// EnvName is the dependent service endpoint environment variable name, no variable is injected when unset
 	EnvName string `json:"envName,omitempty"`
-	// EnvValue is the dependent service endpoint environment variable value
+	// EnvValue is the dependent service endpoint environment variable value, generated from the upstream service when unset
 	EnvValue string `json:"envValue,omitempty"`
 }
 
@@ -75,6 +285,34 @@ type NIMPipelineStatus struct {
 	States map[string]string `json:"states,omitempty"`
 	// State indicates the overall state of the pipeline
 	State string `json:"state,omitempty"`
+	// Verification reports the result of the last end-to-end verification of the pipeline
+	Verification *NIMPipelineVerificationStatus `json:"verification,omitempty"`
+}
+
+// NIMPipelineVerificationStatus defines the result of an end-to-end verification of the pipeline.
+type NIMPipelineVerificationStatus struct {
+	// ObservedGeneration is the pipeline generation the verification ran against
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastVerificationTime is the time the verification last ran
+	LastVerificationTime *metav1.Time `json:"lastVerificationTime,omitempty"`
+	// Steps reports the result of each step of the verification
+	Steps []NIMPipelineVerificationStepStatus `json:"steps,omitempty"`
+}
+
+// NIMPipelineVerificationStepStatus defines the result of a verification step.
+type NIMPipelineVerificationStepStatus struct {
+	// Name is the step name
+	Name string `json:"name"`
+	// Service is the name of the pipeline service receiving the request
+	Service string `json:"service"`
+	// Passed indicates whether the step passed
+	Passed bool `json:"passed"`
+	// StatusCode is the response status code of the request
+	StatusCode int32 `json:"statusCode,omitempty"`
+	// LatencyMilliseconds is the latency of the request in milliseconds
+	LatencyMilliseconds int64 `json:"latencyMilliseconds,omitempty"`
+	// Message describes the failure of the step
+	Message string `json:"message,omitempty"`
 }
 
 // +genclient
@@ -101,6 +339,93 @@ type NIMPipelineList struct {
 	Items           []NIMPipeline `json:"items"`
 }
 
+// GetServiceSpec returns the NIMService spec of a pipeline service merged over the pipeline defaults.
+func (p *NIMPipeline) GetServiceSpec(service *NIMServicePipelineSpec) (*NIMServiceSpec, error) {
+	spec := service.Spec.DeepCopy()
+	if p.Spec.Defaults == nil {
+		return spec, nil
+	}
+
+	defaults := p.Spec.Defaults.DeepCopy().getNIMServiceSpec()
+	// Optional fields set on the service are kept as is, even when set to their zero value
+	if err := mergo.Merge(spec, defaults, mergo.WithoutDereference); err != nil {
+		return nil, fmt.Errorf("failed to merge pipeline defaults into service %s: %w", service.Name, err)
+	}
+	// Env variables of the service override the defaults with the same name
+	spec.Env = utils.MergeEnvVars(defaults.Env, service.Spec.DeepCopy().Env)
+	return spec, nil
+}
+
+// NIMPipelineMember describes a service deployed by the pipeline, either a NIMService or a NeMo microservice.
+// +kubebuilder:object:generate=false
+type NIMPipelineMember struct {
+	// Kind is the kind of the resource deployed for the service
+	Kind string
+	// Name is the service name, unique across all services of the pipeline
+	Name         string
+	Enabled      bool
+	Dependencies []ServiceDependency
+}
+
+// GetMembers returns all services of the pipeline, NIMServices first, followed by the NeMo microservices.
+func (p *NIMPipeline) GetMembers() []NIMPipelineMember {
+	var members []NIMPipelineMember
+	add := func(kind string, name string, enabled *bool, dependencies []ServiceDependency) {
+		members = append(members, NIMPipelineMember{
+			Kind:         kind,
+			Name:         name,
+			Enabled:      enabled != nil && *enabled,
+			Dependencies: dependencies,
+		})
+	}
+	for _, service := range p.Spec.Services {
+		add(NIMServiceKind, service.Name, service.Enabled, service.Dependencies)
+	}
+	for _, service := range p.Spec.Guardrails {
+		add(NemoGuardrailKind, service.Name, service.Enabled, service.Dependencies)
+	}
+	for _, service := range p.Spec.Evaluators {
+		add(NemoEvaluatorKind, service.Name, service.Enabled, service.Dependencies)
+	}
+	for _, service := range p.Spec.Customizers {
+		add(NemoCustomizerKind, service.Name, service.Enabled, service.Dependencies)
+	}
+	for _, service := range p.Spec.Datastores {
+		add(NemoDatastoreKind, service.Name, service.Enabled, service.Dependencies)
+	}
+	for _, service := range p.Spec.Entitystores {
+		add(NemoEntitystoreKind, service.Name, service.Enabled, service.Dependencies)
+	}
+	return members
+}
+
+// GetMethod returns the HTTP method of a verification step.
+func (s *NIMPipelineVerificationStep) GetMethod() string {
+	if s.Method != "" {
+		return s.Method
+	}
+	if s.Body != "" {
+		return "POST"
+	}
+	return "GET"
+}
+
+// GetExpectedStatusCode returns the response status code of a successful verification step.
+func (s *NIMPipelineVerificationStep) GetExpectedStatusCode() int32 {
+	if s.ExpectedStatusCode != 0 {
+		return s.ExpectedStatusCode
+	}
+	return 200
+}
+
+// GetTimeout returns the timeout of each verification request.
+func (v *NIMPipelineVerification) GetTimeout() time.Duration {
+	if v.Timeout != nil && v.Timeout.Duration > 0 {
+		return v.Timeout.Duration
+	}
+	return DefaultNIMPipelineVerificationTimeout
+}
+
 func init() {
 	SchemeBuilder.Register(&NIMPipeline{}, &NIMPipelineList{})
 }
@@ -0,0 +1,106 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+// TestGetServiceSpec tests the GetServiceSpec function.
+func TestGetServiceSpec(t *testing.T) {
+	defaults := &NIMServicePipelineDefaults{
+		Image:         &NIMServicePipelineImageDefaults{PullPolicy: "IfNotPresent", PullSecrets: []string{"ngc-secret"}},
+		SchedulerName: "gpu-scheduler",
+		UserID:        ptr.To[int64](1000),
+		Scale:         Autoscaling{Enabled: ptr.To(true)},
+		Resources: &corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+		},
+	}
+	image := Image{Repository: "nvcr.io/nim/meta/llama-3.1-8b-instruct", Tag: "1.3.3"}
+	inherited := NIMServiceSpec{
+		Image:         Image{Repository: image.Repository, Tag: image.Tag, PullPolicy: "IfNotPresent", PullSecrets: []string{"ngc-secret"}},
+		AuthSecret:    "ngc-api-secret",
+		Replicas:      1,
+		SchedulerName: "gpu-scheduler",
+		UserID:        ptr.To[int64](1000),
+		Scale:         Autoscaling{Enabled: ptr.To(true)},
+		Resources: &corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		service NIMServiceSpec
+		desired NIMServiceSpec
+	}{
+		{
+			name:    "Unset fields inherit the defaults",
+			service: NIMServiceSpec{Image: image, AuthSecret: "ngc-api-secret", Replicas: 1},
+			desired: inherited,
+		},
+		{
+			name: "Optional fields set to their zero value override the defaults",
+			service: NIMServiceSpec{
+				Image:      Image{Repository: image.Repository, Tag: image.Tag, PullPolicy: "Always"},
+				AuthSecret: "ngc-api-secret",
+				Replicas:   1,
+				UserID:     ptr.To[int64](0),
+				Scale:      Autoscaling{Enabled: ptr.To(false)},
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				},
+			},
+			desired: NIMServiceSpec{
+				Image:         Image{Repository: image.Repository, Tag: image.Tag, PullPolicy: "Always", PullSecrets: []string{"ngc-secret"}},
+				AuthSecret:    "ngc-api-secret",
+				Replicas:      1,
+				SchedulerName: "gpu-scheduler",
+				UserID:        ptr.To[int64](0),
+				Scale:         Autoscaling{Enabled: ptr.To(false)},
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				},
+			},
+		},
+		{
+			// Zero values of fields that are not optional cannot be told apart from unset fields
+			name:    "Zero values of other fields inherit the defaults",
+			service: NIMServiceSpec{Image: image, AuthSecret: "ngc-api-secret", Replicas: 1, SchedulerName: ""},
+			desired: inherited,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nimPipeline := &NIMPipeline{Spec: NIMPipelineSpec{Defaults: defaults}}
+			spec, err := nimPipeline.GetServiceSpec(&NIMServicePipelineSpec{Name: "llm", Spec: tt.service})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(*spec, tt.desired) {
+				t.Errorf("GetServiceSpec() = %+v, want %+v", *spec, tt.desired)
+			}
+		})
+	}
+}
@@ -25,6 +25,8 @@ import (
 	"fmt"
 	"maps"
 	"os"
+	"regexp"
+	"strings"
 
 	kserveconstants "github.com/kserve/kserve/pkg/constants"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -35,6 +37,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/utils/ptr"
 
 	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
@@ -42,6 +45,9 @@ import (
 	utils "github.com/NVIDIA/k8s-nim-operator/internal/utils"
 )
 
+// invalidResourceNameChars matches the characters of a model name that are not valid in a resource name.
+var invalidResourceNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
@@ -68,6 +74,27 @@ else
   /opt/nim/llm/.venv/bin/python3 -m nim_llm_sdk.entrypoints.openai.api_server
 fi`
 
+	// DefaultKServeMPIHostfileScript waits for the workers of a multi-node KServe predictor to be resolved by the
+	// MPI headless service and writes the MPI hostfile of the leader.
+	DefaultKServeMPIHostfileScript = `set -e
+self=$(hostname -i)
+deadline=$((SECONDS + CLUSTER_START_TIMEOUT))
+while true; do
+  workers=$(getent ahostsv4 "${MPI_SERVICE}" | awk '{print $1}' | sort -u | grep -vx "${self}" || true)
+  if [ "$(echo -n "${workers}" | grep -c .)" -ge "$((CLUSTER_SIZE - 1))" ]; then
+    break
+  fi
+  if [ "${SECONDS}" -ge "${deadline}" ]; then
+    echo "timed out waiting for $((CLUSTER_SIZE - 1)) MPI workers"
+    exit 1
+  fi
+  sleep 5
+done
+echo "localhost slots=${GPUS_PER_NODE}" > /etc/mpi/hostfile-0
+for worker in ${workers}; do
+  echo "${worker} slots=${GPUS_PER_NODE}" >> /etc/mpi/hostfile-0
+done`
+
 	DefaultMPITimeout = 300
 )
 
@@ -85,6 +112,8 @@ const (
 	PlatformTypeStandalone PlatformType = "standalone"
 	// PlatformTypeKServe represents KServe deployment platform.
 	PlatformTypeKServe PlatformType = "kserve"
+	// PlatformTypeLLMD represents llm-d disaggregated prefill/decode deployment platform.
+	PlatformTypeLLMD PlatformType = "llm-d"
 )
 
 // NIMServiceSpec defines the desired state of NIMService.
@@ -93,7 +122,7 @@ type NIMServiceSpec struct {
 	Image   Image           `json:"image"`
 	Command []string        `json:"command,omitempty"`
 	Args    []string        `json:"args,omitempty"`
-	Env     []corev1.EnvVar `json:"env,omitempty"`
+	Env     []corev1.EnvVar `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 	// The name of an existing pull secret containing the NGC_API_KEY
 	AuthSecret string `json:"authSecret"`
 	// Storage is the target storage for caching NIM model if NIMCache is not provided
@@ -126,10 +155,177 @@ type NIMServiceSpec struct {
 	Proxy            *ProxySpec                 `json:"proxy,omitempty"`
 	MultiNode        *NimServiceMultiNodeConfig `json:"multiNode,omitempty"`
 	// InferencePlatform specifies the inference platform to use for this NIMService.
-	// Valid values are "standalone" (default) and "kserve".
-	// +kubebuilder:validation:Enum=standalone;kserve
+	// Valid values are "standalone" (default), "kserve" and "llm-d".
+	// +kubebuilder:validation:Enum=standalone;kserve;llm-d
 	// +kubebuilder:default:="standalone"
 	InferencePlatform PlatformType `json:"inferencePlatform,omitempty"`
+	// Rollout defines how changes to the NIM image or the NIMCache profile are rolled out.
+	// Only applicable to single-node NIMServices on the standalone platform.
+	Rollout *NIMServiceRolloutSpec `json:"rollout,omitempty"`
+	// LoRA defines the PEFT (LoRA) adapters to serve on top of the base model.
+	LoRA *LoRASpec `json:"lora,omitempty"`
+	// KServe defines the KServe specific options of the InferenceService.
+	// Only applicable when the inference platform is kserve.
+	KServe *KServeSpec `json:"kserve,omitempty"`
+	// LLMD defines the llm-d specific options of the disaggregated prefill/decode deployment.
+	// Only applicable when the inference platform is llm-d.
+	LLMD *LLMDSpec `json:"llmd,omitempty"`
+	// MultiModel defines additional models served by the NIMService next to the model of its storage,
+	// behind a single endpoint. Only applicable when the inference platform is standalone.
+	MultiModel *MultiModelSpec `json:"multiModel,omitempty"`
+}
+
+// KServeDeploymentMode is the deployment mode of a KServe InferenceService.
+type KServeDeploymentMode string
+
+const (
+	// KServeDeploymentModeServerless deploys the InferenceService as a Knative service.
+	KServeDeploymentModeServerless KServeDeploymentMode = "Serverless"
+	// KServeDeploymentModeRawDeployment deploys the InferenceService as a plain Kubernetes deployment.
+	KServeDeploymentModeRawDeployment KServeDeploymentMode = "RawDeployment"
+)
+
+// KnativeScaleToZeroPodRetentionPeriodAnnotationKey is the Knative revision annotation keeping the last replica
+// for a minimum period before scaling to zero.
+const KnativeScaleToZeroPodRetentionPeriodAnnotationKey = "autoscaling.knative.dev/scale-to-zero-pod-retention-period"
+
+// KServeScaleMetric is the metric used by Knative to autoscale the InferenceService.
+type KServeScaleMetric string
+
+const (
+	// KServeScaleMetricConcurrency scales on the number of in-flight requests per replica.
+	KServeScaleMetricConcurrency KServeScaleMetric = "concurrency"
+	// KServeScaleMetricRPS scales on the number of requests per second per replica.
+	KServeScaleMetricRPS KServeScaleMetric = "rps"
+)
+
+// KServeSpec defines the KServe specific options of a NIMService.
+// +kubebuilder:validation:XValidation:rule="!(has(self.serverless) && has(self.deploymentMode) && self.deploymentMode == 'RawDeployment')", message="serverless can only be set when deploymentMode is Serverless"
+type KServeSpec struct {
+	// DeploymentMode is the KServe deployment mode of the InferenceService.
+	// Defaults to the default deployment mode of the KServe installation, unless set through the
+	// serving.kserve.io/deploymentMode annotation.
+	// +kubebuilder:validation:Enum=Serverless;RawDeployment
+	DeploymentMode KServeDeploymentMode `json:"deploymentMode,omitempty"`
+	// Serverless configures the Knative autoscaling of the InferenceService in Serverless mode.
+	Serverless *KServeServerlessSpec `json:"serverless,omitempty"`
+}
+
+// KServeServerlessSpec defines the Knative autoscaling options of an InferenceService in Serverless mode.
+// +kubebuilder:validation:XValidation:rule="!(has(self.minScale) && has(self.maxScale)) || self.minScale <= self.maxScale", message="minScale must be less than or equal to maxScale"
+type KServeServerlessSpec struct {
+	// MinScale is the minimum number of replicas. Setting it to 0 enables scale to zero.
+	// Defaults to the replicas of the NIMService.
+	// +kubebuilder:validation:Minimum=0
+	MinScale *int32 `json:"minScale,omitempty"`
+	// MaxScale is the maximum number of replicas. Defaults to no limit.
+	// +kubebuilder:validation:Minimum=1
+	MaxScale *int32 `json:"maxScale,omitempty"`
+	// Metric is the Knative autoscaling metric. Defaults to concurrency.
+	// +kubebuilder:validation:Enum=concurrency;rps
+	Metric KServeScaleMetric `json:"metric,omitempty"`
+	// Target is the soft target of the autoscaling metric per replica, e.g. the target concurrency.
+	// +kubebuilder:validation:Minimum=1
+	Target *int32 `json:"target,omitempty"`
+	// ContainerConcurrency is the hard limit of concurrent requests processed by a replica. 0 means no limit.
+	// +kubebuilder:validation:Minimum=0
+	ContainerConcurrency *int64 `json:"containerConcurrency,omitempty"`
+	// ScaleToZeroGracePeriod is the minimum time the last replica is kept after the traffic stopped,
+	// before the InferenceService is scaled to zero. Only applicable when minScale is 0.
+	ScaleToZeroGracePeriod *metav1.Duration `json:"scaleToZeroGracePeriod,omitempty"`
+}
+
+// RolloutStrategyType defines the strategy used to roll out a new NIMService revision.
+type RolloutStrategyType string
+
+const (
+	// RolloutStrategyRollingUpdate updates the NIMService deployment in place.
+	RolloutStrategyRollingUpdate RolloutStrategyType = "RollingUpdate"
+	// RolloutStrategyCanary runs the new revision in a candidate deployment next to the stable one
+	// and routes a weighted share of the traffic to it.
+	RolloutStrategyCanary RolloutStrategyType = "Canary"
+	// RolloutStrategyBlueGreen runs the new revision in a full-size candidate deployment that only
+	// receives traffic once it is promoted.
+	RolloutStrategyBlueGreen RolloutStrategyType = "BlueGreen"
+)
+
+// RolloutPhase is the phase of a NIMService rollout.
+type RolloutPhase string
+
+const (
+	// RolloutPhaseStable indicates that only the stable revision is deployed.
+	RolloutPhaseStable RolloutPhase = "Stable"
+	// RolloutPhaseProgressing indicates that the candidate revision is being deployed and verified.
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhasePaused indicates that the candidate revision is verified and waiting to be promoted.
+	RolloutPhasePaused RolloutPhase = "Paused"
+	// RolloutPhasePromoting indicates that the stable deployment is being updated to the candidate revision.
+	RolloutPhasePromoting RolloutPhase = "Promoting"
+	// RolloutPhaseFailed indicates that the candidate revision failed to become ready.
+	RolloutPhaseFailed RolloutPhase = "Failed"
+)
+
+// NIMServiceRolloutPromoteAnnotation is set to "true" on a NIMService to promote a paused candidate revision.
+const NIMServiceRolloutPromoteAnnotation = "nvidia.com/rollout-promote"
+
+// NIMServiceRolloutSpec defines the rollout strategy for a NIMService.
+type NIMServiceRolloutSpec struct {
+	// Strategy is the rollout strategy. Defaults to RollingUpdate.
+	// +kubebuilder:validation:Enum=RollingUpdate;Canary;BlueGreen
+	// +kubebuilder:default:="RollingUpdate"
+	Strategy RolloutStrategyType `json:"strategy,omitempty"`
+	// Canary configures the canary strategy.
+	Canary *CanaryRolloutSpec `json:"canary,omitempty"`
+	// AutoPromote promotes the candidate revision as soon as it is ready and serving its model.
+	// When unset, the candidate must be promoted by annotating the NIMService with nvidia.com/rollout-promote=true.
+	AutoPromote *bool `json:"autoPromote,omitempty"`
+}
+
+// CanaryRolloutSpec defines the parameters of a canary rollout.
+type CanaryRolloutSpec struct {
+	// Weight is the percentage of replicas, and thereby traffic, served by the candidate revision.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +kubebuilder:default:=10
+	Weight int32 `json:"weight,omitempty"`
+}
+
+// NIMServiceRevision identifies a deployable revision of a NIMService.
+type NIMServiceRevision struct {
+	// Image is the NIM container image of the revision.
+	Image string `json:"image"`
+	// Profile is the NIMCache profile of the revision.
+	Profile string `json:"profile,omitempty"`
+}
+
+// NIMServiceRolloutStatus defines the observed state of a NIMService rollout.
+type NIMServiceRolloutStatus struct {
+	Strategy RolloutStrategyType `json:"strategy,omitempty"`
+	Phase    RolloutPhase        `json:"phase,omitempty"`
+	// StableRevision is the revision served by the primary deployment.
+	StableRevision *NIMServiceRevision `json:"stableRevision,omitempty"`
+	// CandidateRevision is the revision served by the candidate deployment, if any.
+	CandidateRevision *NIMServiceRevision `json:"candidateRevision,omitempty"`
+	// CandidateReadyReplicas is the number of ready replicas of the candidate deployment.
+	CandidateReadyReplicas int32 `json:"candidateReadyReplicas,omitempty"`
+	// CandidateModel is the model name reported by the candidate revision.
+	CandidateModel string `json:"candidateModel,omitempty"`
+	Message        string `json:"message,omitempty"`
+}
+
+// NIMServiceBuildStatus defines the observed state of the engines of a NIMBuild served by a NIMService.
+type NIMServiceBuildStatus struct {
+	// NIMBuild is the name of the tracked NIMBuild.
+	NIMBuild string `json:"nimBuild"`
+	// InputProfile is the profile the engine of the NIMBuild is built from.
+	InputProfile string `json:"inputProfile,omitempty"`
+	// OutputProfile is the profile of the latest engine built by the NIMBuild.
+	OutputProfile string `json:"outputProfile,omitempty"`
+	// Profile is the profile served by the NIMService.
+	Profile string `json:"profile,omitempty"`
+	// RolledBackProfile is the output profile that failed to become ready, the input profile being served instead
+	// until the NIMBuild builds another engine.
+	RolledBackProfile string `json:"rolledBackProfile,omitempty"`
 }
 
 // NimServiceMultiNodeConfig defines the configuration for multi-node NIMService.
@@ -163,9 +359,19 @@ type MultiNodeMPIConfig struct {
 }
 
 // NIMCacheVolSpec defines the spec to use NIMCache volume.
+// +kubebuilder:validation:XValidation:rule="!(has(self.profile) && size(self.profile) > 0 && has(self.nimBuild) && size(self.nimBuild) > 0)", message="profile and nimBuild are mutually exclusive"
 type NIMCacheVolSpec struct {
 	Name    string `json:"name,omitempty"`
 	Profile string `json:"profile,omitempty"`
+	// NIMBuild is the name of a NIMBuild of the NIMCache whose latest built engine is served, in the namespace of the NIMCache.
+	// The input profile of the NIMBuild is served until its engine is built, the NIMService then rolling onto its output profile,
+	// and rolling back to the input profile if the built engine fails to become ready.
+	// Only supported on the standalone platform.
+	NIMBuild string `json:"nimBuild,omitempty"`
+	// Namespace is the namespace of the NIMCache. Defaults to the namespace of the NIMService.
+	// A NIMCache in another namespace can only be used when allowed by a NIMCacheGrant in that namespace.
+	// A NIMCache cached in an object store cannot be used from another namespace, its credentials not being shared.
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // NIMServiceStatus defines the observed state of NIMService.
@@ -178,6 +384,38 @@ type NIMServiceStatus struct {
 	// +listType=map
 	// +listMapKey=name
 	DRAResourceStatuses []DRAResourceStatus `json:"draResourceStatuses,omitempty"`
+	// Rollout is the status of the current rollout, if a rollout strategy is configured.
+	Rollout *NIMServiceRolloutStatus `json:"rollout,omitempty"`
+	// Build is the status of the engines served from the NIMBuild, if one is tracked.
+	Build *NIMServiceBuildStatus `json:"build,omitempty"`
+	// KServe is the observed state of the InferenceService, when the inference platform is kserve.
+	KServe *KServeStatus `json:"kserve,omitempty"`
+	// LLMD is the observed state of the prefill and decode workers, when the inference platform is llm-d.
+	LLMD *LLMDStatus `json:"llmd,omitempty"`
+}
+
+// KServeStatus defines the observed state of a KServe InferenceService.
+type KServeStatus struct {
+	// DeploymentMode is the observed deployment mode of the InferenceService.
+	DeploymentMode KServeDeploymentMode `json:"deploymentMode,omitempty"`
+	// LatestReadyRevision is the latest ready Knative revision of the predictor, in Serverless mode.
+	LatestReadyRevision string `json:"latestReadyRevision,omitempty"`
+	// ReadyReplicas is the number of ready predictor replicas. 0 in Serverless mode means scaled to zero.
+	ReadyReplicas int32 `json:"readyReplicas"`
+	// LastColdStart is the latest observed predictor replica start.
+	LastColdStart *KServeColdStartStatus `json:"lastColdStart,omitempty"`
+}
+
+// KServeColdStartStatus defines the timings of a predictor replica start.
+type KServeColdStartStatus struct {
+	// PodName is the name of the predictor pod.
+	PodName string `json:"podName"`
+	// StartTime is the creation time of the predictor pod.
+	StartTime metav1.Time `json:"startTime"`
+	// ReadyTime is the time the predictor pod became ready to serve.
+	ReadyTime metav1.Time `json:"readyTime"`
+	// DurationSeconds is the time it took the predictor pod to become ready to serve.
+	DurationSeconds int64 `json:"durationSeconds"`
 }
 
 // ModelStatus defines the configuration of the NIMService model.
@@ -185,6 +423,10 @@ type ModelStatus struct {
 	Name             string `json:"name"`
 	ClusterEndpoint  string `json:"clusterEndpoint"`
 	ExternalEndpoint string `json:"externalEndpoint"`
+	// LoRAAdapters is the list of LoRA adapters loaded by the NIMService.
+	LoRAAdapters []string `json:"loraAdapters,omitempty"`
+	// Models is the list of models served by the NIMService, as listed by its /v1/models endpoint.
+	Models []ServedModelStatus `json:"models,omitempty"`
 }
 
 // +genclient
@@ -317,10 +559,42 @@ func (n *NIMService) GetStandardEnv() []corev1.EnvVar {
 			Value: fmt.Sprintf("%d", *n.Spec.Expose.Service.MetricsPort),
 		})
 	}
+	if n.IsLoRAEnabled() {
+		envVars = append(envVars, n.getLoRAEnv()...)
+	}
 
 	return envVars
 }
 
+// getLoRAEnv returns the NIM PEFT env variables to serve the LoRA adapters.
+func (n *NIMService) getLoRAEnv() []corev1.EnvVar {
+	envVars := []corev1.EnvVar{
+		{
+			Name:  "NIM_PEFT_SOURCE",
+			Value: DefaultLoRAStorePath,
+		},
+	}
+	if n.Spec.LoRA.RefreshInterval != nil {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "NIM_PEFT_REFRESH_INTERVAL",
+			Value: fmt.Sprintf("%d", *n.Spec.LoRA.RefreshInterval),
+		})
+	}
+	if n.Spec.LoRA.MaxGPULoRAs != nil {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "NIM_MAX_GPU_LORAS",
+			Value: fmt.Sprintf("%d", *n.Spec.LoRA.MaxGPULoRAs),
+		})
+	}
+	if n.Spec.LoRA.MaxCPULoRAs != nil {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "NIM_MAX_CPU_LORAS",
+			Value: fmt.Sprintf("%d", *n.Spec.LoRA.MaxCPULoRAs),
+		})
+	}
+	return envVars
+}
+
 func (n *NIMService) getLWSCommonEnv() []corev1.EnvVar {
 	env := n.GetEnv()
 
@@ -357,13 +631,15 @@ func (n *NIMService) getLWSCommonEnv() []corev1.EnvVar {
 	return env
 }
 
-func (n *NIMService) GetLWSLeaderEnv() []corev1.EnvVar {
-	env := n.getLWSCommonEnv()
-
-	mpiTimeout := DefaultMPITimeout
+func (n *NIMService) getMPIStartTimeout() int {
 	if n.Spec.MultiNode.MPI != nil && n.Spec.MultiNode.MPI.MPIStartTimeout != 0 {
-		mpiTimeout = n.Spec.MultiNode.MPI.MPIStartTimeout
+		return n.Spec.MultiNode.MPI.MPIStartTimeout
 	}
+	return DefaultMPITimeout
+}
+
+func (n *NIMService) GetLWSLeaderEnv() []corev1.EnvVar {
+	env := n.getLWSCommonEnv()
 
 	env = utils.MergeEnvVars([]corev1.EnvVar{
 		{
@@ -384,7 +660,7 @@ func (n *NIMService) GetLWSLeaderEnv() []corev1.EnvVar {
 		},
 		{
 			Name:  "CLUSTER_START_TIMEOUT",
-			Value: fmt.Sprintf("%d", mpiTimeout),
+			Value: fmt.Sprintf("%d", n.getMPIStartTimeout()),
 		},
 		{
 			Name: "CLUSTER_SIZE",
@@ -442,6 +718,113 @@ func (n *NIMService) GetLWSWorkerEnv() []corev1.EnvVar {
 	return env
 }
 
+// GetKServeMPIServiceName returns the name of the headless service resolving the pods of a multi-node
+// NIMService on the kserve platform.
+func (n *NIMService) GetKServeMPIServiceName() string {
+	return fmt.Sprintf("%s-mpi", n.GetName())
+}
+
+// GetKServeMPILeaderHostname returns the hostname of the leader pod of a multi-node NIMService on the kserve platform.
+func (n *NIMService) GetKServeMPILeaderHostname() string {
+	return fmt.Sprintf("%s-leader", n.GetName())
+}
+
+// GetKServeMultiNodeLeaderEnv returns the leader environment of a multi-node NIMService on the kserve platform.
+// KServe runs a single group of workers per predictor, so the group topology read from the LeaderWorkerSet
+// metadata is set statically.
+func (n *NIMService) GetKServeMultiNodeLeaderEnv() []corev1.EnvVar {
+	return utils.MergeEnvVars(n.GetLWSLeaderEnv(), []corev1.EnvVar{
+		{
+			Name:  "CLUSTER_SIZE",
+			Value: fmt.Sprintf("%d", n.GetMultiNodePipelineParallelism()),
+		},
+		{
+			Name:  "GROUP_INDEX",
+			Value: "0",
+		},
+		{
+			Name:  "NIM_NODE_RANK",
+			Value: "0",
+		},
+	})
+}
+
+// GetKServeMultiNodeWorkerEnv returns the worker environment of a multi-node NIMService on the kserve platform.
+// Workers of a KServe predictor are interchangeable replicas, they reach the leader through the MPI headless service.
+func (n *NIMService) GetKServeMultiNodeWorkerEnv() []corev1.EnvVar {
+	return utils.MergeEnvVars(n.GetLWSWorkerEnv(), []corev1.EnvVar{
+		{
+			Name:  "NIM_NODE_RANK",
+			Value: "1",
+		},
+		{
+			Name:  "LEADER_NAME",
+			Value: n.GetKServeMPILeaderHostname(),
+		},
+		{
+			Name:  "LWS_NAME",
+			Value: n.GetKServeMPIServiceName(),
+		},
+	})
+}
+
+// GetKServeMPIHostfileInitContainer returns the leader init container of a multi-node NIMService on the kserve
+// platform, waiting for the workers to be scheduled and writing their addresses to the MPI hostfile.
+func (n *NIMService) GetKServeMPIHostfileInitContainer() corev1.Container {
+	return corev1.Container{
+		Name:            "mpi-hostfile",
+		Image:           n.GetImage(),
+		ImagePullPolicy: corev1.PullPolicy(n.GetImagePullPolicy()),
+		Command:         []string{"/bin/bash", "-c", DefaultKServeMPIHostfileScript},
+		Env: []corev1.EnvVar{
+			{
+				Name:  "MPI_SERVICE",
+				Value: fmt.Sprintf("%s.%s.svc", n.GetKServeMPIServiceName(), n.GetNamespace()),
+			},
+			{
+				Name:  "CLUSTER_SIZE",
+				Value: fmt.Sprintf("%d", n.GetMultiNodePipelineParallelism()),
+			},
+			{
+				Name:  "GPUS_PER_NODE",
+				Value: fmt.Sprintf("%d", n.GetMultiNodeTensorParallelism()),
+			},
+			{
+				Name:  "CLUSTER_START_TIMEOUT",
+				Value: fmt.Sprintf("%d", n.getMPIStartTimeout()),
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "mpi-config",
+				MountPath: "/etc/mpi",
+			},
+		},
+	}
+}
+
+// GetKServeMPIServiceParams returns params to render the MPI headless service of a multi-node NIMService
+// on the kserve platform.
+func (n *NIMService) GetKServeMPIServiceParams() *rendertypes.ServiceParams {
+	return &rendertypes.ServiceParams{
+		Name:                     n.GetKServeMPIServiceName(),
+		Namespace:                n.GetNamespace(),
+		Labels:                   n.GetServiceLabels(),
+		Annotations:              n.GetNIMServiceAnnotations(),
+		ClusterIP:                corev1.ClusterIPNone,
+		PublishNotReadyAddresses: true,
+		SelectorLabels:           map[string]string{kserveconstants.InferenceServicePodLabelKey: n.GetName()},
+		Ports: []corev1.ServicePort{
+			{
+				Name:       "ssh",
+				Port:       22,
+				TargetPort: intstr.FromInt32(22),
+				Protocol:   corev1.ProtocolTCP,
+			},
+		},
+	}
+}
+
 // GetProxySpec returns the proxy spec for the NIMService deployment.
 func (n *NIMService) GetProxyEnv() []corev1.EnvVar {
 
@@ -505,6 +888,10 @@ func (n *NIMService) GetNIMServiceAnnotations() map[string]string {
 // GetServiceLabels returns merged labels to apply to the NIMService instance.
 func (n *NIMService) GetServiceLabels() map[string]string {
 	standardLabels := n.GetStandardLabels()
+	if n.IsInferencePoolEnabled() {
+		// Join the pods to the inference pool
+		standardLabels[InferencePoolLabelKey] = n.Spec.Expose.Router.InferencePool.Name
+	}
 
 	if n.Spec.Labels != nil {
 		return utils.MergeMaps(standardLabels, n.Spec.Labels)
@@ -707,6 +1094,13 @@ func (n *NIMService) GetVolumes(modelPVC PersistentVolumeClaim) []corev1.Volume
 		},
 	}
 
+	// Without a PVC, the model store is hydrated into an emptyDir (e.g. from an object store NIMCache)
+	if modelPVC.Name == "" {
+		volumes[1].VolumeSource = corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		}
+	}
+
 	if n.GetProxySpec() != nil {
 		volumes = append(volumes, k8sutil.GetVolumesForUpdatingCaCert(n.Spec.Proxy.CertConfigMap)...)
 	}
@@ -881,11 +1275,39 @@ func (n *NIMService) GetNIMCacheName() string {
 	return n.Spec.Storage.NIMCache.Name
 }
 
+// GetNIMCacheNamespace returns the namespace of the NIMCache to use for the NIMService deployment.
+func (n *NIMService) GetNIMCacheNamespace() string {
+	if n.Spec.Storage.NIMCache.Namespace != "" {
+		return n.Spec.Storage.NIMCache.Namespace
+	}
+	return n.GetNamespace()
+}
+
+// IsCrossNamespaceNIMCache returns true if the NIMService uses a NIMCache in another namespace.
+func (n *NIMService) IsCrossNamespaceNIMCache() bool {
+	return n.GetNIMCacheName() != "" && n.GetNIMCacheNamespace() != n.GetNamespace()
+}
+
+// GetSharedNIMCachePVCName returns the name of the PVC mounting the given volume of a NIMCache from another namespace.
+func (n *NIMService) GetSharedNIMCachePVCName(volumeName string) string {
+	return fmt.Sprintf("%s-nimcache-%s", n.GetName(), utils.DeepHashObject(volumeName))
+}
+
+// GetSharedNIMCachePVName returns the name of the PV mirroring the given volume of a NIMCache from another namespace.
+func (n *NIMService) GetSharedNIMCachePVName(volumeName string) string {
+	return fmt.Sprintf("%s-%s-nimcache-%s", n.GetNamespace(), n.GetName(), utils.DeepHashObject(volumeName))
+}
+
 // GetNIMCacheProfile returns the explicit profile to use for the NIMService deployment.
 func (n *NIMService) GetNIMCacheProfile() string {
 	return n.Spec.Storage.NIMCache.Profile
 }
 
+// GetNIMBuildName returns the name of the NIMBuild whose latest built engine is served, if any.
+func (n *NIMService) GetNIMBuildName() string {
+	return n.Spec.Storage.NIMCache.NIMBuild
+}
+
 // GetHPA returns the HPA spec for the NIMService deployment.
 func (n *NIMService) GetHPA() HorizontalPodAutoscalerSpec {
 	return n.Spec.Scale.HPA
@@ -938,6 +1360,16 @@ func (n *NIMService) IsAutoScalingEnabled() bool {
 	return n.Spec.Scale.Enabled != nil && *n.Spec.Scale.Enabled
 }
 
+// IsKEDAAutoScalingEnabled returns true if autoscaling through a KEDA ScaledObject is enabled for NIMService deployment.
+func (n *NIMService) IsKEDAAutoScalingEnabled() bool {
+	return n.IsAutoScalingEnabled() && n.Spec.Scale.GetBackend() == AutoscalingBackendKEDA
+}
+
+// IsHPAEnabled returns true if autoscaling through a HorizontalPodAutoscaler is enabled for NIMService deployment.
+func (n *NIMService) IsHPAEnabled() bool {
+	return n.IsAutoScalingEnabled() && n.Spec.Scale.GetBackend() == AutoscalingBackendHPA
+}
+
 // IsIngressEnabled returns true if ingress is enabled for NIMService deployment.
 func (n *NIMService) IsIngressEnabled() bool {
 	return n.Spec.Expose.Ingress.Enabled != nil && *n.Spec.Expose.Ingress.Enabled
@@ -948,6 +1380,21 @@ func (n *NIMService) GetIngressSpec() networkingv1.IngressSpec {
 	return n.Spec.Expose.Ingress.Spec
 }
 
+// IsRouterEnabled returns true if Gateway API routes are enabled for NIMService deployment.
+func (n *NIMService) IsRouterEnabled() bool {
+	return n.Spec.Expose.Router != nil
+}
+
+// IsInferencePoolEnabled returns true if model-aware routing through an inference pool is enabled for NIMService deployment.
+func (n *NIMService) IsInferencePoolEnabled() bool {
+	return n.IsRouterEnabled() && n.Spec.Expose.Router.InferencePool != nil
+}
+
+// IsGRPCRouteEnabled returns true if a Gateway API GRPCRoute is enabled for NIMService deployment.
+func (n *NIMService) IsGRPCRouteEnabled() bool {
+	return n.IsRouterEnabled() && n.Spec.Expose.Service.GRPCPort != nil
+}
+
 // IsServiceMonitorEnabled returns true if servicemonitor is enabled for NIMService deployment.
 func (n *NIMService) IsServiceMonitorEnabled() bool {
 	return n.Spec.Metrics.Enabled != nil && *n.Spec.Metrics.Enabled
@@ -1274,6 +1721,11 @@ func (n *NIMService) GetServiceParams() *rendertypes.ServiceParams {
 
 	// Set service selector labels
 	params.SelectorLabels = n.GetSelectorLabels()
+	if selector := n.GetRolloutSelectorLabels(); selector != nil {
+		// Re-sync the service whenever the rollout moves traffic between deployments.
+		params.SelectorLabels = selector
+		params.Annotations[utils.NvidiaAnnotationParentSpecHashKey] = utils.DeepHashObject([]any{n.Spec, selector})
+	}
 
 	// Set service type
 	params.Type = n.GetServiceType()
@@ -1321,6 +1773,108 @@ func (n *NIMService) GetIngressParams() *rendertypes.IngressParams {
 	return params
 }
 
+// GetHTTPRouteParams returns params to render HTTPRoute from templates.
+func (n *NIMService) GetHTTPRouteParams() *rendertypes.HTTPRouteParams {
+	params := &rendertypes.HTTPRouteParams{}
+
+	params.Enabled = n.IsRouterEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetRouterAnnotations()
+	if n.IsInferencePoolEnabled() {
+		params.Spec = n.Spec.Expose.Router.GenerateGatewayInferencePoolHTTPRouteSpec()
+	} else if params.Enabled {
+		params.Spec = n.Spec.Expose.Router.GenerateGatewayHTTPRouteSpec(n.GetName(), n.GetServicePort())
+	}
+	return params
+}
+
+// GetInferencePoolParams returns params to render the InferencePool shared by the NIMServices routing through it.
+func (n *NIMService) GetInferencePoolParams() *rendertypes.InferencePoolParams {
+	params := &rendertypes.InferencePoolParams{}
+
+	params.Enabled = n.IsInferencePoolEnabled()
+	if !params.Enabled {
+		return params
+	}
+	pool := n.Spec.Expose.Router.InferencePool
+
+	// Set metadata
+	params.Name = pool.Name
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetInferencePoolLabels()
+
+	// Select the pods of all NIMServices in the pool
+	params.SelectorLabels = map[string]string{InferencePoolLabelKey: pool.Name}
+	params.TargetPort = n.GetServicePort()
+	params.EndpointPickerName = pool.EndpointPicker.Name
+	params.EndpointPickerPort = pool.EndpointPicker.GetPort()
+	params.EndpointPickerFailureMode = pool.EndpointPicker.GetFailureMode()
+	return params
+}
+
+// GetInferenceModelParams returns params to render the InferenceModel routing a served model to the inference pool.
+func (n *NIMService) GetInferenceModelParams(modelName string) *rendertypes.InferenceModelParams {
+	params := &rendertypes.InferenceModelParams{}
+
+	params.Enabled = n.IsInferencePoolEnabled()
+	if !params.Enabled {
+		return params
+	}
+	pool := n.Spec.Expose.Router.InferencePool
+
+	// Set metadata
+	params.Name = n.GetInferenceModelName(modelName)
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetInferencePoolLabels()
+
+	params.ModelName = modelName
+	params.Criticality = pool.Criticality
+	if params.Criticality == "" {
+		params.Criticality = "Standard"
+	}
+	params.PoolName = pool.Name
+	return params
+}
+
+// GetInferencePoolLabels returns the labels of the inference resources shared by the NIMServices in a pool.
+func (n *NIMService) GetInferencePoolLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/part-of":    "nim-service",
+		"app.kubernetes.io/managed-by": "k8s-nim-operator",
+		InferencePoolLabelKey:          n.Spec.Expose.Router.InferencePool.Name,
+	}
+}
+
+// GetInferenceModelName returns the name of the InferenceModel for a model served through the inference pool.
+// Models served by several NIMServices of the same pool map to the same InferenceModel.
+func (n *NIMService) GetInferenceModelName(modelName string) string {
+	poolName := n.Spec.Expose.Router.InferencePool.Name
+	name := strings.Trim(invalidResourceNameChars.ReplaceAllString(strings.ToLower(modelName), "-"), "-")
+	if name == "" || len(poolName)+len(name)+1 > validation.DNS1123LabelMaxLength {
+		name = utils.GetTruncatedStringHash(modelName, 12)
+	}
+	return fmt.Sprintf("%s-%s", poolName, name)
+}
+
+// GetGRPCRouteParams returns params to render GRPCRoute from templates.
+func (n *NIMService) GetGRPCRouteParams() *rendertypes.GRPCRouteParams {
+	params := &rendertypes.GRPCRouteParams{}
+
+	params.Enabled = n.IsGRPCRouteEnabled()
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetRouterAnnotations()
+	if params.Enabled {
+		params.Spec = n.Spec.Expose.Router.GenerateGatewayGRPCRouteSpec(n.GetName(), *n.Spec.Expose.Service.GRPCPort)
+	}
+	return params
+}
+
 // GetRoleParams returns params to render Role from templates.
 func (n *NIMService) GetRoleParams() *rendertypes.RoleParams {
 	params := &rendertypes.RoleParams{}
@@ -1370,7 +1924,7 @@ func (n *NIMService) GetRoleBindingParams() *rendertypes.RoleBindingParams {
 func (n *NIMService) GetHPAParams() *rendertypes.HPAParams {
 	params := &rendertypes.HPAParams{}
 
-	params.Enabled = n.IsAutoScalingEnabled()
+	params.Enabled = n.IsHPAEnabled()
 
 	// Set metadata
 	params.Name = n.GetName()
@@ -1395,6 +1949,63 @@ func (n *NIMService) GetHPAParams() *rendertypes.HPAParams {
 	return params
 }
 
+// GetScaledObjectParams returns params to render a KEDA ScaledObject from templates.
+func (n *NIMService) GetScaledObjectParams() *rendertypes.ScaledObjectParams {
+	params := &rendertypes.ScaledObjectParams{}
+
+	params.Enabled = n.IsKEDAAutoScalingEnabled() && n.Spec.Scale.KEDA != nil
+	if !params.Enabled {
+		return params
+	}
+
+	// Set metadata
+	params.Name = n.GetName()
+	params.Namespace = n.GetNamespace()
+	params.Labels = n.GetServiceLabels()
+	params.Annotations = n.GetHPAAnnotations()
+
+	// Set ScaledObject spec
+	keda := n.Spec.Scale.KEDA
+	params.ScaleTargetAPIVersion = "apps/v1"
+	params.ScaleTargetKind = n.GetDeploymentKind()
+	params.ScaleTargetName = n.GetName()
+	params.MinReplicas = keda.GetMinReplicas()
+	params.MaxReplicas = keda.MaxReplicas
+	params.PollingInterval = keda.PollingInterval
+	params.CooldownPeriod = keda.CooldownPeriod
+	params.Behavior = keda.Behavior
+	for i, trigger := range keda.Triggers {
+		query, metricType := trigger.Query, "AverageValue"
+		if query == "" {
+			query, metricType = n.getNIMMetricQuery(trigger.Metric)
+		}
+		params.Triggers = append(params.Triggers, rendertypes.ScaledObjectTrigger{
+			Name:                fmt.Sprintf("trigger-%d", i),
+			MetricType:          metricType,
+			ServerAddress:       keda.PrometheusServerAddress,
+			Query:               query,
+			Threshold:           trigger.Threshold,
+			ActivationThreshold: trigger.ActivationThreshold,
+		})
+	}
+	return params
+}
+
+// getNIMMetricQuery returns the PromQL query and KEDA metric type of a NIM inference metric.
+// Request counts are summed across replicas and averaged per replica by KEDA, while the KV-cache
+// utilization ratio is averaged across replicas and compared as is.
+func (n *NIMService) getNIMMetricQuery(metric NIMMetric) (string, string) {
+	selector := fmt.Sprintf(`{namespace=%q,service=%q}`, n.GetNamespace(), n.GetName())
+	switch metric {
+	case NIMMetricQueueDepth:
+		return fmt.Sprintf("sum(num_requests_running%s) + sum(num_requests_waiting%s)", selector, selector), "AverageValue"
+	case NIMMetricKVCacheUtilization:
+		return fmt.Sprintf("avg(gpu_cache_usage_perc%s)", selector), "Value"
+	default:
+		return fmt.Sprintf("sum(num_requests_waiting%s)", selector), "AverageValue"
+	}
+}
+
 // GetSCCParams return params to render SCC from templates.
 func (n *NIMService) GetSCCParams() *rendertypes.SCCParams {
 	params := &rendertypes.SCCParams{}
@@ -1451,6 +2062,15 @@ func (n *NIMService) GetIngressAnnotations() map[string]string {
 	return nimServiceAnnotations
 }
 
+func (n *NIMService) GetRouterAnnotations() map[string]string {
+	nimServiceAnnotations := n.GetNIMServiceAnnotations()
+
+	if n.Spec.Expose.Router != nil && n.Spec.Expose.Router.Annotations != nil {
+		return utils.MergeMaps(nimServiceAnnotations, n.Spec.Expose.Router.Annotations)
+	}
+	return nimServiceAnnotations
+}
+
 func (n *NIMService) GetServiceAnnotations() map[string]string {
 	nimServiceAnnotations := n.GetNIMServiceAnnotations()
 
@@ -1483,6 +2103,38 @@ func (n *NIMService) GetProxySpec() *ProxySpec {
 	return n.Spec.Proxy
 }
 
+// GetKServeDeploymentMode returns the KServe deployment mode requested for the NIMService, either explicitly
+// or through the serving.kserve.io/deploymentMode annotation, or empty to use the KServe default.
+func (n *NIMService) GetKServeDeploymentMode() KServeDeploymentMode {
+	if n.Spec.KServe != nil && n.Spec.KServe.DeploymentMode != "" {
+		return n.Spec.KServe.DeploymentMode
+	}
+	return KServeDeploymentMode(n.Spec.Annotations[kserveconstants.DeploymentMode])
+}
+
+// GetKServeServerlessSpec returns the Knative autoscaling options of the NIMService, if any.
+func (n *NIMService) GetKServeServerlessSpec() *KServeServerlessSpec {
+	if n.Spec.KServe == nil {
+		return nil
+	}
+	return n.Spec.KServe.Serverless
+}
+
+// IsKServeScaleToZeroEnabled returns true if the InferenceService can be scaled to zero by Knative.
+func (n *NIMService) IsKServeScaleToZeroEnabled() bool {
+	serverless := n.GetKServeServerlessSpec()
+	return serverless != nil && serverless.MinScale != nil && *serverless.MinScale == 0
+}
+
+// GetKServeAnnotations returns the InferenceService annotations derived from the KServe options of the NIMService.
+func (n *NIMService) GetKServeAnnotations() map[string]string {
+	annotations := map[string]string{}
+	if mode := n.GetKServeDeploymentMode(); mode != "" {
+		annotations[kserveconstants.DeploymentMode] = string(mode)
+	}
+	return annotations
+}
+
 // GetInferenceServiceParams returns params to render InferenceService from templates.
 func (n *NIMService) GetInferenceServiceParams(
 	deploymentMode kserveconstants.DeploymentModeType) *rendertypes.InferenceServiceParams {
@@ -1493,12 +2145,27 @@ func (n *NIMService) GetInferenceServiceParams(
 	params.Name = n.GetName()
 	params.Namespace = n.GetNamespace()
 	params.Labels = n.GetServiceLabels()
-	params.Annotations = n.GetNIMServiceAnnotations()
+	params.Annotations = utils.MergeMaps(n.GetKServeAnnotations(), n.GetNIMServiceAnnotations())
 	params.PodAnnotations = n.GetNIMServiceAnnotations()
 	delete(params.PodAnnotations, utils.NvidiaAnnotationParentSpecHashKey)
 
 	// Set template spec
-	if !n.IsAutoScalingEnabled() || deploymentMode != kserveconstants.RawDeployment {
+	if serverless := n.GetKServeServerlessSpec(); serverless != nil && deploymentMode == kserveconstants.Serverless {
+		// Knative autoscaling, with scale to zero when minScale is 0
+		params.MinReplicas = ptr.To[int32](int32(n.GetReplicas()))
+		if serverless.MinScale != nil {
+			params.MinReplicas = ptr.To(*serverless.MinScale)
+		}
+		params.MaxReplicas = serverless.MaxScale
+		if serverless.Metric != "" {
+			params.ScaleMetric = string(serverless.Metric)
+		}
+		params.ScaleTarget = serverless.Target
+		params.ContainerConcurrency = serverless.ContainerConcurrency
+		if serverless.ScaleToZeroGracePeriod != nil {
+			params.PodAnnotations[KnativeScaleToZeroPodRetentionPeriodAnnotationKey] = serverless.ScaleToZeroGracePeriod.Duration.String()
+		}
+	} else if !n.IsAutoScalingEnabled() || deploymentMode != kserveconstants.RawDeployment {
 		params.MinReplicas = ptr.To[int32](int32(n.GetReplicas()))
 	} else {
 		params.Annotations[kserveconstants.AutoscalerClass] = string(kserveconstants.AutoscalerClassHPA)
@@ -1772,6 +2439,70 @@ func (n *NIMService) GetMultiNodePipelineParallelism() uint32 {
 	return 0
 }
 
+// GetRolloutStrategy returns the rollout strategy for the NIMService.
+func (n *NIMService) GetRolloutStrategy() RolloutStrategyType {
+	if n.Spec.Rollout == nil || n.Spec.Rollout.Strategy == "" {
+		return RolloutStrategyRollingUpdate
+	}
+	return n.Spec.Rollout.Strategy
+}
+
+// IsProgressiveRolloutEnabled returns true if new revisions are rolled out through a candidate deployment.
+func (n *NIMService) IsProgressiveRolloutEnabled() bool {
+	if n.Spec.MultiNode != nil {
+		return false
+	}
+	strategy := n.GetRolloutStrategy()
+	return strategy == RolloutStrategyCanary || strategy == RolloutStrategyBlueGreen
+}
+
+// IsRolloutAutoPromoteEnabled returns true if a verified candidate revision is promoted without manual approval.
+func (n *NIMService) IsRolloutAutoPromoteEnabled() bool {
+	return n.Spec.Rollout != nil && n.Spec.Rollout.AutoPromote != nil && *n.Spec.Rollout.AutoPromote
+}
+
+// IsRolloutPromoteRequested returns true if the NIMService is annotated to promote the candidate revision.
+func (n *NIMService) IsRolloutPromoteRequested() bool {
+	return n.GetAnnotations()[NIMServiceRolloutPromoteAnnotation] == "true"
+}
+
+// GetCanaryWeight returns the percentage of replicas served by the candidate revision in a canary rollout.
+func (n *NIMService) GetCanaryWeight() int32 {
+	if n.Spec.Rollout == nil || n.Spec.Rollout.Canary == nil || n.Spec.Rollout.Canary.Weight == 0 {
+		return 10
+	}
+	return n.Spec.Rollout.Canary.Weight
+}
+
+// GetCandidateName returns the name of the candidate deployment and service used during a rollout.
+func (n *NIMService) GetCandidateName() string {
+	return fmt.Sprintf("%s-candidate", n.GetName())
+}
+
+// GetRolloutSelectorLabels returns the service selector labels for the current rollout phase.
+// It returns nil when traffic should only be served by the primary deployment.
+func (n *NIMService) GetRolloutSelectorLabels() map[string]string {
+	if !n.IsProgressiveRolloutEnabled() || n.Status.Rollout == nil || n.Status.Rollout.CandidateRevision == nil {
+		return nil
+	}
+
+	strategy := n.GetRolloutStrategy()
+	switch n.Status.Rollout.Phase {
+	case RolloutPhaseProgressing, RolloutPhasePaused:
+		if strategy == RolloutStrategyCanary {
+			// Select pods of both deployments, traffic is split by replica count.
+			return map[string]string{"app.kubernetes.io/instance": n.GetName()}
+		}
+	case RolloutPhasePromoting:
+		if strategy == RolloutStrategyCanary {
+			return map[string]string{"app.kubernetes.io/instance": n.GetName()}
+		}
+		// Switch all traffic to the candidate until the primary deployment has caught up.
+		return map[string]string{"app": n.GetCandidateName()}
+	}
+	return nil
+}
+
 func init() {
 	SchemeBuilder.Register(&NIMService{}, &NIMServiceList{})
 }
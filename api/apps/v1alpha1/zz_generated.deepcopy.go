@@ -52,6 +52,11 @@ func (in *Autoscaling) DeepCopyInto(out *Autoscaling) {
 		**out = **in
 	}
 	in.HPA.DeepCopyInto(&out.HPA)
+	if in.KEDA != nil {
+		in, out := &in.KEDA, &out.KEDA
+		*out = new(KEDAScaledObjectSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Annotations != nil {
 		in, out := &in.Annotations, &out.Annotations
 		*out = make(map[string]string, len(*in))
@@ -71,6 +76,21 @@ func (in *Autoscaling) DeepCopy() *Autoscaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryRolloutSpec) DeepCopyInto(out *CanaryRolloutSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryRolloutSpec.
+func (in *CanaryRolloutSpec) DeepCopy() *CanaryRolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryRolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertConfig) DeepCopyInto(out *CertConfig) {
 	*out = *in
@@ -101,6 +121,21 @@ func (in *ConfigMapRef) DeepCopy() *ConfigMapRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRAAutoClaimSpec) DeepCopyInto(out *DRAAutoClaimSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRAAutoClaimSpec.
+func (in *DRAAutoClaimSpec) DeepCopy() *DRAAutoClaimSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DRAAutoClaimSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DRAClaimCreationSpec) DeepCopyInto(out *DRAClaimCreationSpec) {
 	*out = *in
@@ -178,6 +213,46 @@ func (in *DRADeviceAttributeSelectorValue) DeepCopy() *DRADeviceAttributeSelecto
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRADeviceRequestStatus) DeepCopyInto(out *DRADeviceRequestStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRADeviceRequestStatus.
+func (in *DRADeviceRequestStatus) DeepCopy() *DRADeviceRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DRADeviceRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRADeviceSharingSpec) DeepCopyInto(out *DRADeviceSharingSpec) {
+	*out = *in
+	if in.MPSActiveThreadPercentage != nil {
+		in, out := &in.MPSActiveThreadPercentage, &out.MPSActiveThreadPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MPSPinnedMemoryLimit != nil {
+		in, out := &in.MPSPinnedMemoryLimit, &out.MPSPinnedMemoryLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRADeviceSharingSpec.
+func (in *DRADeviceSharingSpec) DeepCopy() *DRADeviceSharingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DRADeviceSharingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DRADeviceSpec) DeepCopyInto(out *DRADeviceSpec) {
 	*out = *in
@@ -200,6 +275,16 @@ func (in *DRADeviceSpec) DeepCopyInto(out *DRADeviceSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MIG != nil {
+		in, out := &in.MIG, &out.MIG
+		*out = new(DRAMIGSpec)
+		**out = **in
+	}
+	if in.Sharing != nil {
+		in, out := &in.Sharing, &out.Sharing
+		*out = new(DRADeviceSharingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRADeviceSpec.
@@ -212,6 +297,21 @@ func (in *DRADeviceSpec) DeepCopy() *DRADeviceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DRAMIGSpec) DeepCopyInto(out *DRAMIGSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRAMIGSpec.
+func (in *DRAMIGSpec) DeepCopy() *DRAMIGSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DRAMIGSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DRAResource) DeepCopyInto(out *DRAResource) {
 	*out = *in
@@ -230,6 +330,11 @@ func (in *DRAResource) DeepCopyInto(out *DRAResource) {
 		*out = new(DRAClaimCreationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Auto != nil {
+		in, out := &in.Auto, &out.Auto
+		*out = new(DRAAutoClaimSpec)
+		**out = **in
+	}
 	if in.Requests != nil {
 		in, out := &in.Requests, &out.Requests
 		*out = make([]string, len(*in))
@@ -270,6 +375,11 @@ func (in *DRAResourceClaimTemplateStatusInfo) DeepCopyInto(out *DRAResourceClaim
 		*out = make([]DRAResourceClaimStatusInfo, len(*in))
 		copy(*out, *in)
 	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = make([]DRADeviceRequestStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRAResourceClaimTemplateStatusInfo.
@@ -403,6 +513,26 @@ func (in *Datastore) DeepCopy() *Datastore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointPickerReference) DeepCopyInto(out *EndpointPickerReference) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointPickerReference.
+func (in *EndpointPickerReference) DeepCopy() *EndpointPickerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointPickerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Entitystore) DeepCopyInto(out *Entitystore) {
 	*out = *in
@@ -453,6 +583,11 @@ func (in *Expose) DeepCopyInto(out *Expose) {
 	*out = *in
 	in.Service.DeepCopyInto(&out.Service)
 	in.Ingress.DeepCopyInto(&out.Ingress)
+	if in.Router != nil {
+		in, out := &in.Router, &out.Router
+		*out = new(Router)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Expose.
@@ -470,6 +605,11 @@ func (in *ExposeV1) DeepCopyInto(out *ExposeV1) {
 	*out = *in
 	in.Service.DeepCopyInto(&out.Service)
 	in.Ingress.DeepCopyInto(&out.Ingress)
+	if in.Router != nil {
+		in, out := &in.Router, &out.Router
+		*out = new(Router)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposeV1.
@@ -502,6 +642,21 @@ func (in *GPUSpec) DeepCopy() *GPUSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayReference) DeepCopyInto(out *GatewayReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayReference.
+func (in *GatewayReference) DeepCopy() *GatewayReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuardrailConfig) DeepCopyInto(out *GuardrailConfig) {
 	*out = *in
@@ -610,6 +765,22 @@ func (in *Image) DeepCopy() *Image {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InferencePoolRouting) DeepCopyInto(out *InferencePoolRouting) {
+	*out = *in
+	in.EndpointPicker.DeepCopyInto(&out.EndpointPicker)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferencePoolRouting.
+func (in *InferencePoolRouting) DeepCopy() *InferencePoolRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(InferencePoolRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ingress) DeepCopyInto(out *Ingress) {
 	*out = *in
@@ -713,199 +884,1352 @@ func (in *IngressV1) DeepCopy() *IngressV1 {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MLFlow) DeepCopyInto(out *MLFlow) {
+func (in *KEDAPrometheusTrigger) DeepCopyInto(out *KEDAPrometheusTrigger) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLFlow.
-func (in *MLFlow) DeepCopy() *MLFlow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDAPrometheusTrigger.
+func (in *KEDAPrometheusTrigger) DeepCopy() *KEDAPrometheusTrigger {
 	if in == nil {
 		return nil
 	}
-	out := new(MLFlow)
+	out := new(KEDAPrometheusTrigger)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Metrics) DeepCopyInto(out *Metrics) {
+func (in *KEDAScaledObjectSpec) DeepCopyInto(out *KEDAScaledObjectSpec) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
 		**out = **in
 	}
-	in.ServiceMonitor.DeepCopyInto(&out.ServiceMonitor)
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CooldownPeriod != nil {
+		in, out := &in.CooldownPeriod, &out.CooldownPeriod
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]KEDAPrometheusTrigger, len(*in))
+		copy(*out, *in)
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(v2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metrics.
-func (in *Metrics) DeepCopy() *Metrics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDAScaledObjectSpec.
+func (in *KEDAScaledObjectSpec) DeepCopy() *KEDAScaledObjectSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Metrics)
+	out := new(KEDAScaledObjectSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelDownloadJobsConfig) DeepCopyInto(out *ModelDownloadJobsConfig) {
+func (in *KServeColdStartStatus) DeepCopyInto(out *KServeColdStartStatus) {
 	*out = *in
-	if in.NGCSecret != nil {
-		in, out := &in.NGCSecret, &out.NGCSecret
-		*out = new(NGCSecret)
-		**out = **in
-	}
-	if in.HFSecret != nil {
-		in, out := &in.HFSecret, &out.HFSecret
-		*out = new(HFSecret)
-		**out = **in
-	}
-	if in.SecurityContext != nil {
-		in, out := &in.SecurityContext, &out.SecurityContext
-		*out = new(v1.PodSecurityContext)
-		(*in).DeepCopyInto(*out)
-	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.ReadyTime.DeepCopyInto(&out.ReadyTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelDownloadJobsConfig.
-func (in *ModelDownloadJobsConfig) DeepCopy() *ModelDownloadJobsConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KServeColdStartStatus.
+func (in *KServeColdStartStatus) DeepCopy() *KServeColdStartStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelDownloadJobsConfig)
+	out := new(KServeColdStartStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
+func (in *KServeServerlessSpec) DeepCopyInto(out *KServeServerlessSpec) {
 	*out = *in
-	if in.Profiles != nil {
-		in, out := &in.Profiles, &out.Profiles
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.MinScale != nil {
+		in, out := &in.MinScale, &out.MinScale
+		*out = new(int32)
+		**out = **in
 	}
-	if in.GPUs != nil {
-		in, out := &in.GPUs, &out.GPUs
-		*out = make([]GPUSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.MaxScale != nil {
+		in, out := &in.MaxScale, &out.MaxScale
+		*out = new(int32)
+		**out = **in
 	}
-	if in.Lora != nil {
-		in, out := &in.Lora, &out.Lora
-		*out = new(bool)
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Buildable != nil {
-		in, out := &in.Buildable, &out.Buildable
-		*out = new(bool)
+	if in.ContainerConcurrency != nil {
+		in, out := &in.ContainerConcurrency, &out.ContainerConcurrency
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScaleToZeroGracePeriod != nil {
+		in, out := &in.ScaleToZeroGracePeriod, &out.ScaleToZeroGracePeriod
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
-func (in *ModelSpec) DeepCopy() *ModelSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KServeServerlessSpec.
+func (in *KServeServerlessSpec) DeepCopy() *KServeServerlessSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelSpec)
+	out := new(KServeServerlessSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
+func (in *KServeSpec) DeepCopyInto(out *KServeSpec) {
 	*out = *in
+	if in.Serverless != nil {
+		in, out := &in.Serverless, &out.Serverless
+		*out = new(KServeServerlessSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelStatus.
-func (in *ModelStatus) DeepCopy() *ModelStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KServeSpec.
+func (in *KServeSpec) DeepCopy() *KServeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelStatus)
+	out := new(KServeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MultiNodeMPIConfig) DeepCopyInto(out *MultiNodeMPIConfig) {
+func (in *KServeStatus) DeepCopyInto(out *KServeStatus) {
 	*out = *in
+	if in.LastColdStart != nil {
+		in, out := &in.LastColdStart, &out.LastColdStart
+		*out = new(KServeColdStartStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiNodeMPIConfig.
-func (in *MultiNodeMPIConfig) DeepCopy() *MultiNodeMPIConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KServeStatus.
+func (in *KServeStatus) DeepCopy() *KServeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MultiNodeMPIConfig)
+	out := new(KServeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NGCSecret) DeepCopyInto(out *NGCSecret) {
+func (in *LLMDRoleSpec) DeepCopyInto(out *LLMDRoleSpec) {
 	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DRAResources != nil {
+		in, out := &in.DRAResources, &out.DRAResources
+		*out = make([]DRAResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NGCSecret.
-func (in *NGCSecret) DeepCopy() *NGCSecret {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLMDRoleSpec.
+func (in *LLMDRoleSpec) DeepCopy() *LLMDRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NGCSecret)
+	out := new(LLMDRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NGCSource) DeepCopyInto(out *NGCSource) {
+func (in *LLMDRoleStatus) DeepCopyInto(out *LLMDRoleStatus) {
 	*out = *in
-	if in.Model != nil {
-		in, out := &in.Model, &out.Model
-		*out = new(ModelSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ModelEndpoint != nil {
-		in, out := &in.ModelEndpoint, &out.ModelEndpoint
-		*out = new(string)
-		**out = **in
+	if in.DRAResourceStatuses != nil {
+		in, out := &in.DRAResourceStatuses, &out.DRAResourceStatuses
+		*out = make([]DRAResourceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NGCSource.
-func (in *NGCSource) DeepCopy() *NGCSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLMDRoleStatus.
+func (in *LLMDRoleStatus) DeepCopy() *LLMDRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NGCSource)
+	out := new(LLMDRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMBuild) DeepCopyInto(out *NIMBuild) {
+func (in *LLMDRoutingSidecarSpec) DeepCopyInto(out *LLMDRoutingSidecarSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(Image)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetPort != nil {
+		in, out := &in.TargetPort, &out.TargetPort
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLMDRoutingSidecarSpec.
+func (in *LLMDRoutingSidecarSpec) DeepCopy() *LLMDRoutingSidecarSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LLMDRoutingSidecarSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LLMDSpec) DeepCopyInto(out *LLMDSpec) {
+	*out = *in
+	in.Prefill.DeepCopyInto(&out.Prefill)
+	in.Decode.DeepCopyInto(&out.Decode)
+	if in.KVConnectorExtraConfig != nil {
+		in, out := &in.KVConnectorExtraConfig, &out.KVConnectorExtraConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NIXLSideChannelPort != nil {
+		in, out := &in.NIXLSideChannelPort, &out.NIXLSideChannelPort
+		*out = new(int32)
+		**out = **in
+	}
+	in.RoutingSidecar.DeepCopyInto(&out.RoutingSidecar)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLMDSpec.
+func (in *LLMDSpec) DeepCopy() *LLMDSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LLMDSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LLMDStatus) DeepCopyInto(out *LLMDStatus) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]LLMDRoleStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLMDStatus.
+func (in *LLMDStatus) DeepCopy() *LLMDStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LLMDStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoRAAdapter) DeepCopyInto(out *LoRAAdapter) {
+	*out = *in
+	if in.PVC != nil {
+		in, out := &in.PVC, &out.PVC
+		*out = new(LoRAPVCSource)
+		**out = **in
+	}
+	if in.NIMCache != nil {
+		in, out := &in.NIMCache, &out.NIMCache
+		*out = new(LoRANIMCacheSource)
+		**out = **in
+	}
+	if in.HF != nil {
+		in, out := &in.HF, &out.HF
+		*out = new(HuggingFaceHubSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataStore != nil {
+		in, out := &in.DataStore, &out.DataStore
+		*out = new(NemoDataStoreSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NemoCustomizer != nil {
+		in, out := &in.NemoCustomizer, &out.NemoCustomizer
+		*out = new(LoRANemoCustomizerSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoRAAdapter.
+func (in *LoRAAdapter) DeepCopy() *LoRAAdapter {
+	if in == nil {
+		return nil
+	}
+	out := new(LoRAAdapter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoRANIMCacheSource) DeepCopyInto(out *LoRANIMCacheSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoRANIMCacheSource.
+func (in *LoRANIMCacheSource) DeepCopy() *LoRANIMCacheSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LoRANIMCacheSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoRANemoCustomizerSource) DeepCopyInto(out *LoRANemoCustomizerSource) {
+	*out = *in
+	if in.Revision != nil {
+		in, out := &in.Revision, &out.Revision
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoRANemoCustomizerSource.
+func (in *LoRANemoCustomizerSource) DeepCopy() *LoRANemoCustomizerSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LoRANemoCustomizerSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoRAPVCSource) DeepCopyInto(out *LoRAPVCSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoRAPVCSource.
+func (in *LoRAPVCSource) DeepCopy() *LoRAPVCSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LoRAPVCSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoRASpec) DeepCopyInto(out *LoRASpec) {
+	*out = *in
+	if in.Adapters != nil {
+		in, out := &in.Adapters, &out.Adapters
+		*out = make([]LoRAAdapter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxGPULoRAs != nil {
+		in, out := &in.MaxGPULoRAs, &out.MaxGPULoRAs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxCPULoRAs != nil {
+		in, out := &in.MaxCPULoRAs, &out.MaxCPULoRAs
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoRASpec.
+func (in *LoRASpec) DeepCopy() *LoRASpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoRASpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLFlow) DeepCopyInto(out *MLFlow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLFlow.
+func (in *MLFlow) DeepCopy() *MLFlow {
+	if in == nil {
+		return nil
+	}
+	out := new(MLFlow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Metrics) DeepCopyInto(out *Metrics) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.ServiceMonitor.DeepCopyInto(&out.ServiceMonitor)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metrics.
+func (in *Metrics) DeepCopy() *Metrics {
+	if in == nil {
+		return nil
+	}
+	out := new(Metrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelDownloadJobsConfig) DeepCopyInto(out *ModelDownloadJobsConfig) {
+	*out = *in
+	if in.NGCSecret != nil {
+		in, out := &in.NGCSecret, &out.NGCSecret
+		*out = new(NGCSecret)
+		**out = **in
+	}
+	if in.HFSecret != nil {
+		in, out := &in.HFSecret, &out.HFSecret
+		*out = new(HFSecret)
+		**out = **in
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelDownloadJobsConfig.
+func (in *ModelDownloadJobsConfig) DeepCopy() *ModelDownloadJobsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelDownloadJobsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelRouterSpec) DeepCopyInto(out *ModelRouterSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(Image)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRouterSpec.
+func (in *ModelRouterSpec) DeepCopy() *ModelRouterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelRouterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
+	*out = *in
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GPUs != nil {
+		in, out := &in.GPUs, &out.GPUs
+		*out = make([]GPUSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Lora != nil {
+		in, out := &in.Lora, &out.Lora
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Buildable != nil {
+		in, out := &in.Buildable, &out.Buildable
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
+func (in *ModelSpec) DeepCopy() *ModelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
+	*out = *in
+	if in.LoRAAdapters != nil {
+		in, out := &in.LoRAAdapters, &out.LoRAAdapters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]ServedModelStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelStatus.
+func (in *ModelStatus) DeepCopy() *ModelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiModelSpec) DeepCopyInto(out *MultiModelSpec) {
+	*out = *in
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]NIMServiceModelSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Router.DeepCopyInto(&out.Router)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiModelSpec.
+func (in *MultiModelSpec) DeepCopy() *MultiModelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiModelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiNodeMPIConfig) DeepCopyInto(out *MultiNodeMPIConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiNodeMPIConfig.
+func (in *MultiNodeMPIConfig) DeepCopy() *MultiNodeMPIConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiNodeMPIConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NGCSecret) DeepCopyInto(out *NGCSecret) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NGCSecret.
+func (in *NGCSecret) DeepCopy() *NGCSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(NGCSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NGCSource) DeepCopyInto(out *NGCSource) {
+	*out = *in
+	if in.Model != nil {
+		in, out := &in.Model, &out.Model
+		*out = new(ModelSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ModelEndpoint != nil {
+		in, out := &in.ModelEndpoint, &out.ModelEndpoint
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NGCSource.
+func (in *NGCSource) DeepCopy() *NGCSource {
+	if in == nil {
+		return nil
+	}
+	out := new(NGCSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMBuild) DeepCopyInto(out *NIMBuild) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMBuild.
+func (in *NIMBuild) DeepCopy() *NIMBuild {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMBuild)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMBuild) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMBuildEngineStatus) DeepCopyInto(out *NIMBuildEngineStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMBuildEngineStatus.
+func (in *NIMBuildEngineStatus) DeepCopy() *NIMBuildEngineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMBuildEngineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMBuildList) DeepCopyInto(out *NIMBuildList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NIMBuild, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMBuildList.
+func (in *NIMBuildList) DeepCopy() *NIMBuildList {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMBuildList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMBuildList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMBuildMatrix) DeepCopyInto(out *NIMBuildMatrix) {
+	*out = *in
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TensorParallelism != nil {
+		in, out := &in.TensorParallelism, &out.TensorParallelism
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.GPUProducts != nil {
+		in, out := &in.GPUProducts, &out.GPUProducts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMBuildMatrix.
+func (in *NIMBuildMatrix) DeepCopy() *NIMBuildMatrix {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMBuildMatrix)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMBuildPolicy) DeepCopyInto(out *NIMBuildPolicy) {
+	*out = *in
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RetryOnOOM != nil {
+		in, out := &in.RetryOnOOM, &out.RetryOnOOM
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NonRetryableExitCodes != nil {
+		in, out := &in.NonRetryableExitCodes, &out.NonRetryableExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMBuildPolicy.
+func (in *NIMBuildPolicy) DeepCopy() *NIMBuildPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMBuildPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMBuildSpec) DeepCopyInto(out *NIMBuildSpec) {
+	*out = *in
+	out.NIMCache = in.NIMCache
+	if in.BuildMatrix != nil {
+		in, out := &in.BuildMatrix, &out.BuildMatrix
+		*out = new(NIMBuildMatrix)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BuildPolicy != nil {
+		in, out := &in.BuildPolicy, &out.BuildPolicy
+		*out = new(NIMBuildPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Image.DeepCopyInto(&out.Image)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMBuildSpec.
+func (in *NIMBuildSpec) DeepCopy() *NIMBuildSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMBuildSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMBuildStatus) DeepCopyInto(out *NIMBuildStatus) {
+	*out = *in
+	in.InputProfile.DeepCopyInto(&out.InputProfile)
+	in.OutputProfile.DeepCopyInto(&out.OutputProfile)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Builds != nil {
+		in, out := &in.Builds, &out.Builds
+		*out = make([]NIMBuildEngineStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutputProfiles != nil {
+		in, out := &in.OutputProfiles, &out.OutputProfiles
+		*out = make([]NIMProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMBuildStatus.
+func (in *NIMBuildStatus) DeepCopy() *NIMBuildStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMBuildStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCache) DeepCopyInto(out *NIMCache) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCache.
+func (in *NIMCache) DeepCopy() *NIMCache {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMCache) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheGrant) DeepCopyInto(out *NIMCacheGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheGrant.
+func (in *NIMCacheGrant) DeepCopy() *NIMCacheGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMCacheGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheGrantFrom) DeepCopyInto(out *NIMCacheGrantFrom) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheGrantFrom.
+func (in *NIMCacheGrantFrom) DeepCopy() *NIMCacheGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheGrantList) DeepCopyInto(out *NIMCacheGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NIMCacheGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheGrantList.
+func (in *NIMCacheGrantList) DeepCopy() *NIMCacheGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMCacheGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheGrantSpec) DeepCopyInto(out *NIMCacheGrantSpec) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]NIMCacheGrantFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]NIMCacheGrantTo, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheGrantSpec.
+func (in *NIMCacheGrantSpec) DeepCopy() *NIMCacheGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheGrantTo) DeepCopyInto(out *NIMCacheGrantTo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheGrantTo.
+func (in *NIMCacheGrantTo) DeepCopy() *NIMCacheGrantTo {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheGrantTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheList) DeepCopyInto(out *NIMCacheList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NIMCache, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheList.
+func (in *NIMCacheList) DeepCopy() *NIMCacheList {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NIMCacheList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheObjectStore) DeepCopyInto(out *NIMCacheObjectStore) {
+	*out = *in
+	out.Credentials = in.Credentials
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheObjectStore.
+func (in *NIMCacheObjectStore) DeepCopy() *NIMCacheObjectStore {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheObjectStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheReference) DeepCopyInto(out *NIMCacheReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheReference.
+func (in *NIMCacheReference) DeepCopy() *NIMCacheReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheRefresh) DeepCopyInto(out *NIMCacheRefresh) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheRefresh.
+func (in *NIMCacheRefresh) DeepCopy() *NIMCacheRefresh {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheRefresh)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheRetention) DeepCopyInto(out *NIMCacheRetention) {
+	*out = *in
+	if in.KeepReleases != nil {
+		in, out := &in.KeepReleases, &out.KeepReleases
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnreferencedFor != nil {
+		in, out := &in.UnreferencedFor, &out.UnreferencedFor
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheRetention.
+func (in *NIMCacheRetention) DeepCopy() *NIMCacheRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheSpec) DeepCopyInto(out *NIMCacheSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	in.Storage.DeepCopyInto(&out.Storage)
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CertConfig != nil {
+		in, out := &in.CertConfig, &out.CertConfig
+		*out = new(CertConfig)
+		**out = **in
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxySpec)
+		**out = **in
+	}
+	if in.Refresh != nil {
+		in, out := &in.Refresh, &out.Refresh
+		*out = new(NIMCacheRefresh)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(NIMCacheRetention)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheSpec.
+func (in *NIMCacheSpec) DeepCopy() *NIMCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheStatus) DeepCopyInto(out *NIMCacheStatus) {
+	*out = *in
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]NIMProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastRefreshTime != nil {
+		in, out := &in.LastRefreshTime, &out.LastRefreshTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedProfiles != nil {
+		in, out := &in.UpdatedProfiles, &out.UpdatedProfiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastCleanupTime != nil {
+		in, out := &in.LastCleanupTime, &out.LastCleanupTime
+		*out = (*in).DeepCopy()
+	}
+	if in.StorageUsage != nil {
+		in, out := &in.StorageUsage, &out.StorageUsage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LastVerifiedTime != nil {
+		in, out := &in.LastVerifiedTime, &out.LastVerifiedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheStatus.
+func (in *NIMCacheStatus) DeepCopy() *NIMCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheStorage) DeepCopyInto(out *NIMCacheStorage) {
+	*out = *in
+	in.PVC.DeepCopyInto(&out.PVC)
+	if in.HostPath != nil {
+		in, out := &in.HostPath, &out.HostPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.ObjectStore != nil {
+		in, out := &in.ObjectStore, &out.ObjectStore
+		*out = new(NIMCacheObjectStore)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheStorage.
+func (in *NIMCacheStorage) DeepCopy() *NIMCacheStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMCacheVolSpec) DeepCopyInto(out *NIMCacheVolSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheVolSpec.
+func (in *NIMCacheVolSpec) DeepCopy() *NIMCacheVolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMCacheVolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMEndpoint) DeepCopyInto(out *NIMEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMEndpoint.
+func (in *NIMEndpoint) DeepCopy() *NIMEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMGateway) DeepCopyInto(out *NIMGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMBuild.
-func (in *NIMBuild) DeepCopy() *NIMBuild {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMGateway.
+func (in *NIMGateway) DeepCopy() *NIMGateway {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMBuild)
+	out := new(NIMGateway)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMBuild) DeepCopyObject() runtime.Object {
+func (in *NIMGateway) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -913,31 +2237,46 @@ func (in *NIMBuild) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMBuildList) DeepCopyInto(out *NIMBuildList) {
+func (in *NIMGatewayAuth) DeepCopyInto(out *NIMGatewayAuth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMGatewayAuth.
+func (in *NIMGatewayAuth) DeepCopy() *NIMGatewayAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMGatewayAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMGatewayList) DeepCopyInto(out *NIMGatewayList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NIMBuild, len(*in))
+		*out = make([]NIMGateway, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMBuildList.
-func (in *NIMBuildList) DeepCopy() *NIMBuildList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMGatewayList.
+func (in *NIMGatewayList) DeepCopy() *NIMGatewayList {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMBuildList)
+	out := new(NIMGatewayList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMBuildList) DeepCopyObject() runtime.Object {
+func (in *NIMGatewayList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -945,35 +2284,91 @@ func (in *NIMBuildList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMBuildSpec) DeepCopyInto(out *NIMBuildSpec) {
+func (in *NIMGatewayModelStatus) DeepCopyInto(out *NIMGatewayModelStatus) {
 	*out = *in
-	out.NIMCache = in.NIMCache
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.NIMServices != nil {
+		in, out := &in.NIMServices, &out.NIMServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMGatewayModelStatus.
+func (in *NIMGatewayModelStatus) DeepCopy() *NIMGatewayModelStatus {
+	if in == nil {
+		return nil
 	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	out := new(NIMGatewayModelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMGatewayRateLimit) DeepCopyInto(out *NIMGatewayRateLimit) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
 	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMGatewayRateLimit.
+func (in *NIMGatewayRateLimit) DeepCopy() *NIMGatewayRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMGatewayRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMGatewayRoute) DeepCopyInto(out *NIMGatewayRoute) {
+	*out = *in
+	if in.NIMServices != nil {
+		in, out := &in.NIMServices, &out.NIMServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMGatewayRoute.
+func (in *NIMGatewayRoute) DeepCopy() *NIMGatewayRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMGatewayRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMGatewaySpec) DeepCopyInto(out *NIMGatewaySpec) {
+	*out = *in
+	in.NIMServiceSelector.DeepCopyInto(&out.NIMServiceSelector)
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]NIMGatewayRoute, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(NIMGatewayAuth)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(NIMGatewayRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(Image)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
@@ -988,24 +2383,41 @@ func (in *NIMBuildSpec) DeepCopyInto(out *NIMBuildSpec) {
 			(*out)[key] = val
 		}
 	}
-	in.Image.DeepCopyInto(&out.Image)
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Expose.DeepCopyInto(&out.Expose)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMBuildSpec.
-func (in *NIMBuildSpec) DeepCopy() *NIMBuildSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMGatewaySpec.
+func (in *NIMGatewaySpec) DeepCopy() *NIMGatewaySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMBuildSpec)
+	out := new(NIMGatewaySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMBuildStatus) DeepCopyInto(out *NIMBuildStatus) {
+func (in *NIMGatewayStatus) DeepCopyInto(out *NIMGatewayStatus) {
 	*out = *in
-	in.InputProfile.DeepCopyInto(&out.InputProfile)
-	in.OutputProfile.DeepCopyInto(&out.OutputProfile)
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -1013,20 +2425,27 @@ func (in *NIMBuildStatus) DeepCopyInto(out *NIMBuildStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]NIMGatewayModelStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMBuildStatus.
-func (in *NIMBuildStatus) DeepCopy() *NIMBuildStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMGatewayStatus.
+func (in *NIMGatewayStatus) DeepCopy() *NIMGatewayStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMBuildStatus)
+	out := new(NIMGatewayStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMCache) DeepCopyInto(out *NIMCache) {
+func (in *NIMPipeline) DeepCopyInto(out *NIMPipeline) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1034,18 +2453,18 @@ func (in *NIMCache) DeepCopyInto(out *NIMCache) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCache.
-func (in *NIMCache) DeepCopy() *NIMCache {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipeline.
+func (in *NIMPipeline) DeepCopy() *NIMPipeline {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMCache)
+	out := new(NIMPipeline)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMCache) DeepCopyObject() runtime.Object {
+func (in *NIMPipeline) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1053,193 +2472,255 @@ func (in *NIMCache) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMCacheList) DeepCopyInto(out *NIMCacheList) {
+func (in *NIMPipelineList) DeepCopyInto(out *NIMPipelineList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NIMCache, len(*in))
+		*out = make([]NIMPipeline, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheList.
-func (in *NIMCacheList) DeepCopy() *NIMCacheList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineList.
+func (in *NIMPipelineList) DeepCopy() *NIMPipelineList {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMCacheList)
+	out := new(NIMPipelineList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMCacheList) DeepCopyObject() runtime.Object {
+func (in *NIMPipelineList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMCacheReference) DeepCopyInto(out *NIMCacheReference) {
-	*out = *in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMPipelineSpec) DeepCopyInto(out *NIMPipelineSpec) {
+	*out = *in
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(NIMServicePipelineDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]NIMServicePipelineSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Guardrails != nil {
+		in, out := &in.Guardrails, &out.Guardrails
+		*out = make([]NemoGuardrailPipelineSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Evaluators != nil {
+		in, out := &in.Evaluators, &out.Evaluators
+		*out = make([]NemoEvaluatorPipelineSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Customizers != nil {
+		in, out := &in.Customizers, &out.Customizers
+		*out = make([]NemoCustomizerPipelineSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Datastores != nil {
+		in, out := &in.Datastores, &out.Datastores
+		*out = make([]NemoDatastorePipelineSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Entitystores != nil {
+		in, out := &in.Entitystores, &out.Entitystores
+		*out = make([]NemoEntitystorePipelineSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(NIMPipelineVerification)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheReference.
-func (in *NIMCacheReference) DeepCopy() *NIMCacheReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineSpec.
+func (in *NIMPipelineSpec) DeepCopy() *NIMPipelineSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMCacheReference)
+	out := new(NIMPipelineSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMCacheSpec) DeepCopyInto(out *NIMCacheSpec) {
+func (in *NIMPipelineStatus) DeepCopyInto(out *NIMPipelineStatus) {
 	*out = *in
-	in.Source.DeepCopyInto(&out.Source)
-	in.Storage.DeepCopyInto(&out.Storage)
-	in.Resources.DeepCopyInto(&out.Resources)
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
+	if in.States != nil {
+		in, out := &in.States, &out.States
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.UserID != nil {
-		in, out := &in.UserID, &out.UserID
-		*out = new(int64)
-		**out = **in
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(NIMPipelineVerificationStatus)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.GroupID != nil {
-		in, out := &in.GroupID, &out.GroupID
-		*out = new(int64)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineStatus.
+func (in *NIMPipelineStatus) DeepCopy() *NIMPipelineStatus {
+	if in == nil {
+		return nil
 	}
-	if in.CertConfig != nil {
-		in, out := &in.CertConfig, &out.CertConfig
-		*out = new(CertConfig)
-		**out = **in
+	out := new(NIMPipelineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMPipelineVerification) DeepCopyInto(out *NIMPipelineVerification) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]NIMPipelineVerificationStep, len(*in))
+		copy(*out, *in)
 	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
 	}
-	if in.Proxy != nil {
-		in, out := &in.Proxy, &out.Proxy
-		*out = new(ProxySpec)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheSpec.
-func (in *NIMCacheSpec) DeepCopy() *NIMCacheSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineVerification.
+func (in *NIMPipelineVerification) DeepCopy() *NIMPipelineVerification {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMCacheSpec)
+	out := new(NIMPipelineVerification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMCacheStatus) DeepCopyInto(out *NIMCacheStatus) {
+func (in *NIMPipelineVerificationStatus) DeepCopyInto(out *NIMPipelineVerificationStatus) {
 	*out = *in
-	if in.Profiles != nil {
-		in, out := &in.Profiles, &out.Profiles
-		*out = make([]NIMProfile, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.LastVerificationTime != nil {
+		in, out := &in.LastVerificationTime, &out.LastVerificationTime
+		*out = (*in).DeepCopy()
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]NIMPipelineVerificationStepStatus, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheStatus.
-func (in *NIMCacheStatus) DeepCopy() *NIMCacheStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineVerificationStatus.
+func (in *NIMPipelineVerificationStatus) DeepCopy() *NIMPipelineVerificationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMCacheStatus)
+	out := new(NIMPipelineVerificationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMCacheStorage) DeepCopyInto(out *NIMCacheStorage) {
+func (in *NIMPipelineVerificationStep) DeepCopyInto(out *NIMPipelineVerificationStep) {
 	*out = *in
-	in.PVC.DeepCopyInto(&out.PVC)
-	if in.HostPath != nil {
-		in, out := &in.HostPath, &out.HostPath
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheStorage.
-func (in *NIMCacheStorage) DeepCopy() *NIMCacheStorage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineVerificationStep.
+func (in *NIMPipelineVerificationStep) DeepCopy() *NIMPipelineVerificationStep {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMCacheStorage)
+	out := new(NIMPipelineVerificationStep)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMCacheVolSpec) DeepCopyInto(out *NIMCacheVolSpec) {
+func (in *NIMPipelineVerificationStepStatus) DeepCopyInto(out *NIMPipelineVerificationStepStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMCacheVolSpec.
-func (in *NIMCacheVolSpec) DeepCopy() *NIMCacheVolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineVerificationStepStatus.
+func (in *NIMPipelineVerificationStepStatus) DeepCopy() *NIMPipelineVerificationStepStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMCacheVolSpec)
+	out := new(NIMPipelineVerificationStepStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMEndpoint) DeepCopyInto(out *NIMEndpoint) {
+func (in *NIMProfile) DeepCopyInto(out *NIMProfile) {
 	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastReferencedTime != nil {
+		in, out := &in.LastReferencedTime, &out.LastReferencedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMEndpoint.
-func (in *NIMEndpoint) DeepCopy() *NIMEndpoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMProfile.
+func (in *NIMProfile) DeepCopy() *NIMProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMEndpoint)
+	out := new(NIMProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMPipeline) DeepCopyInto(out *NIMPipeline) {
+func (in *NIMService) DeepCopyInto(out *NIMService) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1247,18 +2728,18 @@ func (in *NIMPipeline) DeepCopyInto(out *NIMPipeline) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipeline.
-func (in *NIMPipeline) DeepCopy() *NIMPipeline {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMService.
+func (in *NIMService) DeepCopy() *NIMService {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMPipeline)
+	out := new(NIMService)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMPipeline) DeepCopyObject() runtime.Object {
+func (in *NIMService) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1266,31 +2747,46 @@ func (in *NIMPipeline) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMPipelineList) DeepCopyInto(out *NIMPipelineList) {
+func (in *NIMServiceBuildStatus) DeepCopyInto(out *NIMServiceBuildStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceBuildStatus.
+func (in *NIMServiceBuildStatus) DeepCopy() *NIMServiceBuildStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMServiceBuildStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMServiceList) DeepCopyInto(out *NIMServiceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NIMPipeline, len(*in))
+		*out = make([]NIMService, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineList.
-func (in *NIMPipelineList) DeepCopy() *NIMPipelineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceList.
+func (in *NIMServiceList) DeepCopy() *NIMServiceList {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMPipelineList)
+	out := new(NIMServiceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMPipelineList) DeepCopyObject() runtime.Object {
+func (in *NIMServiceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1298,159 +2794,228 @@ func (in *NIMPipelineList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMPipelineSpec) DeepCopyInto(out *NIMPipelineSpec) {
+func (in *NIMServiceModelSpec) DeepCopyInto(out *NIMServiceModelSpec) {
 	*out = *in
-	if in.Services != nil {
-		in, out := &in.Services, &out.Services
-		*out = make([]NIMServicePipelineSpec, len(*in))
+	out.NIMCache = in.NIMCache
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(Image)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineSpec.
-func (in *NIMPipelineSpec) DeepCopy() *NIMPipelineSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceModelSpec.
+func (in *NIMServiceModelSpec) DeepCopy() *NIMServiceModelSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMPipelineSpec)
+	out := new(NIMServiceModelSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMPipelineStatus) DeepCopyInto(out *NIMPipelineStatus) {
+func (in *NIMServicePipelineDefaults) DeepCopyInto(out *NIMServicePipelineDefaults) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(NIMServicePipelineImageDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.States != nil {
-		in, out := &in.States, &out.States
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodAffinity != nil {
+		in, out := &in.PodAffinity, &out.PodAffinity
+		*out = new(v1.PodAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Expose.DeepCopyInto(&out.Expose)
+	in.Scale.DeepCopyInto(&out.Scale)
+	in.Metrics.DeepCopyInto(&out.Metrics)
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxySpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServicePipelineDefaults.
+func (in *NIMServicePipelineDefaults) DeepCopy() *NIMServicePipelineDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(NIMServicePipelineDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NIMServicePipelineImageDefaults) DeepCopyInto(out *NIMServicePipelineImageDefaults) {
+	*out = *in
+	if in.PullSecrets != nil {
+		in, out := &in.PullSecrets, &out.PullSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMPipelineStatus.
-func (in *NIMPipelineStatus) DeepCopy() *NIMPipelineStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServicePipelineImageDefaults.
+func (in *NIMServicePipelineImageDefaults) DeepCopy() *NIMServicePipelineImageDefaults {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMPipelineStatus)
+	out := new(NIMServicePipelineImageDefaults)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMProfile) DeepCopyInto(out *NIMProfile) {
+func (in *NIMServicePipelineSpec) DeepCopyInto(out *NIMServicePipelineSpec) {
 	*out = *in
-	if in.Config != nil {
-		in, out := &in.Config, &out.Config
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]ServiceDependency, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMProfile.
-func (in *NIMProfile) DeepCopy() *NIMProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServicePipelineSpec.
+func (in *NIMServicePipelineSpec) DeepCopy() *NIMServicePipelineSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMProfile)
+	out := new(NIMServicePipelineSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMService) DeepCopyInto(out *NIMService) {
+func (in *NIMServiceRevision) DeepCopyInto(out *NIMServiceRevision) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMService.
-func (in *NIMService) DeepCopy() *NIMService {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceRevision.
+func (in *NIMServiceRevision) DeepCopy() *NIMServiceRevision {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMService)
+	out := new(NIMServiceRevision)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMService) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMServiceList) DeepCopyInto(out *NIMServiceList) {
+func (in *NIMServiceRolloutSpec) DeepCopyInto(out *NIMServiceRolloutSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]NIMService, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryRolloutSpec)
+		**out = **in
+	}
+	if in.AutoPromote != nil {
+		in, out := &in.AutoPromote, &out.AutoPromote
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceList.
-func (in *NIMServiceList) DeepCopy() *NIMServiceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceRolloutSpec.
+func (in *NIMServiceRolloutSpec) DeepCopy() *NIMServiceRolloutSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMServiceList)
+	out := new(NIMServiceRolloutSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NIMServiceList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NIMServicePipelineSpec) DeepCopyInto(out *NIMServicePipelineSpec) {
+func (in *NIMServiceRolloutStatus) DeepCopyInto(out *NIMServiceRolloutStatus) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
+	if in.StableRevision != nil {
+		in, out := &in.StableRevision, &out.StableRevision
+		*out = new(NIMServiceRevision)
 		**out = **in
 	}
-	in.Spec.DeepCopyInto(&out.Spec)
-	if in.Dependencies != nil {
-		in, out := &in.Dependencies, &out.Dependencies
-		*out = make([]ServiceDependency, len(*in))
-		copy(*out, *in)
+	if in.CandidateRevision != nil {
+		in, out := &in.CandidateRevision, &out.CandidateRevision
+		*out = new(NIMServiceRevision)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServicePipelineSpec.
-func (in *NIMServicePipelineSpec) DeepCopy() *NIMServicePipelineSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceRolloutStatus.
+func (in *NIMServiceRolloutStatus) DeepCopy() *NIMServiceRolloutStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NIMServicePipelineSpec)
+	out := new(NIMServiceRolloutStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1548,6 +3113,31 @@ func (in *NIMServiceSpec) DeepCopyInto(out *NIMServiceSpec) {
 		*out = new(NimServiceMultiNodeConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(NIMServiceRolloutSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoRA != nil {
+		in, out := &in.LoRA, &out.LoRA
+		*out = new(LoRASpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KServe != nil {
+		in, out := &in.KServe, &out.KServe
+		*out = new(KServeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LLMD != nil {
+		in, out := &in.LLMD, &out.LLMD
+		*out = new(LLMDSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MultiModel != nil {
+		in, out := &in.MultiModel, &out.MultiModel
+		*out = new(MultiModelSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceSpec.
@@ -1573,7 +3163,7 @@ func (in *NIMServiceStatus) DeepCopyInto(out *NIMServiceStatus) {
 	if in.Model != nil {
 		in, out := &in.Model, &out.Model
 		*out = new(ModelStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.DRAResourceStatuses != nil {
 		in, out := &in.DRAResourceStatuses, &out.DRAResourceStatuses
@@ -1582,6 +3172,26 @@ func (in *NIMServiceStatus) DeepCopyInto(out *NIMServiceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(NIMServiceRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Build != nil {
+		in, out := &in.Build, &out.Build
+		*out = new(NIMServiceBuildStatus)
+		**out = **in
+	}
+	if in.KServe != nil {
+		in, out := &in.KServe, &out.KServe
+		*out = new(KServeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LLMD != nil {
+		in, out := &in.LLMD, &out.LLMD
+		*out = new(LLMDStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NIMServiceStatus.
@@ -1715,6 +3325,32 @@ func (in *NemoCustomizerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NemoCustomizerPipelineSpec) DeepCopyInto(out *NemoCustomizerPipelineSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]ServiceDependency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoCustomizerPipelineSpec.
+func (in *NemoCustomizerPipelineSpec) DeepCopy() *NemoCustomizerPipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NemoCustomizerPipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NemoCustomizerSpec) DeepCopyInto(out *NemoCustomizerSpec) {
 	*out = *in
@@ -1923,6 +3559,32 @@ func (in *NemoDatastoreList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NemoDatastorePipelineSpec) DeepCopyInto(out *NemoDatastorePipelineSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]ServiceDependency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoDatastorePipelineSpec.
+func (in *NemoDatastorePipelineSpec) DeepCopy() *NemoDatastorePipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NemoDatastorePipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NemoDatastoreSpec) DeepCopyInto(out *NemoDatastoreSpec) {
 	*out = *in
@@ -2115,6 +3777,32 @@ func (in *NemoEntitystoreList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NemoEntitystorePipelineSpec) DeepCopyInto(out *NemoEntitystorePipelineSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]ServiceDependency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoEntitystorePipelineSpec.
+func (in *NemoEntitystorePipelineSpec) DeepCopy() *NemoEntitystorePipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NemoEntitystorePipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NemoEntitystoreSpec) DeepCopyInto(out *NemoEntitystoreSpec) {
 	*out = *in
@@ -2286,6 +3974,32 @@ func (in *NemoEvaluatorList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NemoEvaluatorPipelineSpec) DeepCopyInto(out *NemoEvaluatorPipelineSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]ServiceDependency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoEvaluatorPipelineSpec.
+func (in *NemoEvaluatorPipelineSpec) DeepCopy() *NemoEvaluatorPipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NemoEvaluatorPipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NemoEvaluatorSpec) DeepCopyInto(out *NemoEvaluatorSpec) {
 	*out = *in
@@ -2467,6 +4181,32 @@ func (in *NemoGuardrailList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NemoGuardrailPipelineSpec) DeepCopyInto(out *NemoGuardrailPipelineSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]ServiceDependency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NemoGuardrailPipelineSpec.
+func (in *NemoGuardrailPipelineSpec) DeepCopy() *NemoGuardrailPipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NemoGuardrailPipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NemoGuardrailSpec) DeepCopyInto(out *NemoGuardrailSpec) {
 	*out = *in
@@ -2640,6 +4380,21 @@ func (in *OTelSpec) DeepCopy() *OTelSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStoreAccessKeys) DeepCopyInto(out *ObjectStoreAccessKeys) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStoreAccessKeys.
+func (in *ObjectStoreAccessKeys) DeepCopy() *ObjectStoreAccessKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStoreAccessKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectStoreConfig) DeepCopyInto(out *ObjectStoreConfig) {
 	*out = *in
@@ -2809,6 +4564,39 @@ func (in *Resources) DeepCopy() *Resources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Router) DeepCopyInto(out *Router) {
+	*out = *in
+	out.Gateway = in.Gateway
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.InferencePool != nil {
+		in, out := &in.InferencePool, &out.InferencePool
+		*out = new(InferencePoolRouting)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Router.
+func (in *Router) DeepCopy() *Router {
+	if in == nil {
+		return nil
+	}
+	out := new(Router)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Scheduler) DeepCopyInto(out *Scheduler) {
 	*out = *in
@@ -2839,6 +4627,21 @@ func (in *Secrets) DeepCopy() *Secrets {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServedModelStatus) DeepCopyInto(out *ServedModelStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServedModelStatus.
+func (in *ServedModelStatus) DeepCopy() *ServedModelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServedModelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Service) DeepCopyInto(out *Service) {
 	*out = *in
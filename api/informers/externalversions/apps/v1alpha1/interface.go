@@ -27,6 +27,10 @@ type Interface interface {
 	NIMBuilds() NIMBuildInformer
 	// NIMCaches returns a NIMCacheInformer.
 	NIMCaches() NIMCacheInformer
+	// NIMCacheGrants returns a NIMCacheGrantInformer.
+	NIMCacheGrants() NIMCacheGrantInformer
+	// NIMGateways returns a NIMGatewayInformer.
+	NIMGateways() NIMGatewayInformer
 	// NIMPipelines returns a NIMPipelineInformer.
 	NIMPipelines() NIMPipelineInformer
 	// NIMServices returns a NIMServiceInformer.
@@ -64,6 +68,16 @@ func (v *version) NIMCaches() NIMCacheInformer {
 	return &nIMCacheInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// NIMCacheGrants returns a NIMCacheGrantInformer.
+func (v *version) NIMCacheGrants() NIMCacheGrantInformer {
+	return &nIMCacheGrantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// NIMGateways returns a NIMGatewayInformer.
+func (v *version) NIMGateways() NIMGatewayInformer {
+	return &nIMGatewayInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // NIMPipelines returns a NIMPipelineInformer.
 func (v *version) NIMPipelines() NIMPipelineInformer {
 	return &nIMPipelineInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
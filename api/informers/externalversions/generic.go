@@ -56,6 +56,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Apps().V1alpha1().NIMBuilds().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("nimcaches"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Apps().V1alpha1().NIMCaches().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("nimcachegrants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Apps().V1alpha1().NIMCacheGrants().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("nimgateways"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Apps().V1alpha1().NIMGateways().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("nimpipelines"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Apps().V1alpha1().NIMPipelines().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("nimservices"):
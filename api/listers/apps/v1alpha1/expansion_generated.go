@@ -33,6 +33,22 @@ type NIMCacheListerExpansion interface{}
 // NIMCacheNamespaceLister.
 type NIMCacheNamespaceListerExpansion interface{}
 
+// NIMCacheGrantListerExpansion allows custom methods to be added to
+// NIMCacheGrantLister.
+type NIMCacheGrantListerExpansion interface{}
+
+// NIMCacheGrantNamespaceListerExpansion allows custom methods to be added to
+// NIMCacheGrantNamespaceLister.
+type NIMCacheGrantNamespaceListerExpansion interface{}
+
+// NIMGatewayListerExpansion allows custom methods to be added to
+// NIMGatewayLister.
+type NIMGatewayListerExpansion interface{}
+
+// NIMGatewayNamespaceListerExpansion allows custom methods to be added to
+// NIMGatewayNamespaceLister.
+type NIMGatewayNamespaceListerExpansion interface{}
+
 // NIMPipelineListerExpansion allows custom methods to be added to
 // NIMPipelineLister.
 type NIMPipelineListerExpansion interface{}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// NIMGatewayLister helps list NIMGateways.
+// All objects returned here must be treated as read-only.
+type NIMGatewayLister interface {
+	// List lists all NIMGateways in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.NIMGateway, err error)
+	// NIMGateways returns an object that can list and get NIMGateways.
+	NIMGateways(namespace string) NIMGatewayNamespaceLister
+	NIMGatewayListerExpansion
+}
+
+// nIMGatewayLister implements the NIMGatewayLister interface.
+type nIMGatewayLister struct {
+	listers.ResourceIndexer[*v1alpha1.NIMGateway]
+}
+
+// NewNIMGatewayLister returns a new NIMGatewayLister.
+func NewNIMGatewayLister(indexer cache.Indexer) NIMGatewayLister {
+	return &nIMGatewayLister{listers.New[*v1alpha1.NIMGateway](indexer, v1alpha1.Resource("nimgateway"))}
+}
+
+// NIMGateways returns an object that can list and get NIMGateways.
+func (s *nIMGatewayLister) NIMGateways(namespace string) NIMGatewayNamespaceLister {
+	return nIMGatewayNamespaceLister{listers.NewNamespaced[*v1alpha1.NIMGateway](s.ResourceIndexer, namespace)}
+}
+
+// NIMGatewayNamespaceLister helps list and get NIMGateways.
+// All objects returned here must be treated as read-only.
+type NIMGatewayNamespaceLister interface {
+	// List lists all NIMGateways in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.NIMGateway, err error)
+	// Get retrieves the NIMGateway from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.NIMGateway, error)
+	NIMGatewayNamespaceListerExpansion
+}
+
+// nIMGatewayNamespaceLister implements the NIMGatewayNamespaceLister
+// interface.
+type nIMGatewayNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1.NIMGateway]
+}
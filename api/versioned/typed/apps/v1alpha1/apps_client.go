@@ -29,6 +29,8 @@ type AppsV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	NIMBuildsGetter
 	NIMCachesGetter
+	NIMCacheGrantsGetter
+	NIMGatewaysGetter
 	NIMPipelinesGetter
 	NIMServicesGetter
 	NemoCustomizersGetter
@@ -51,6 +53,14 @@ func (c *AppsV1alpha1Client) NIMCaches(namespace string) NIMCacheInterface {
 	return newNIMCaches(c, namespace)
 }
 
+func (c *AppsV1alpha1Client) NIMCacheGrants(namespace string) NIMCacheGrantInterface {
+	return newNIMCacheGrants(c, namespace)
+}
+
+func (c *AppsV1alpha1Client) NIMGateways(namespace string) NIMGatewayInterface {
+	return newNIMGateways(c, namespace)
+}
+
 func (c *AppsV1alpha1Client) NIMPipelines(namespace string) NIMPipelineInterface {
 	return newNIMPipelines(c, namespace)
 }
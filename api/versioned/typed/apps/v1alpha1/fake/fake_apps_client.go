@@ -35,6 +35,14 @@ func (c *FakeAppsV1alpha1) NIMCaches(namespace string) v1alpha1.NIMCacheInterfac
 	return &FakeNIMCaches{c, namespace}
 }
 
+func (c *FakeAppsV1alpha1) NIMCacheGrants(namespace string) v1alpha1.NIMCacheGrantInterface {
+	return &FakeNIMCacheGrants{c, namespace}
+}
+
+func (c *FakeAppsV1alpha1) NIMGateways(namespace string) v1alpha1.NIMGatewayInterface {
+	return &FakeNIMGateways{c, namespace}
+}
+
 func (c *FakeAppsV1alpha1) NIMPipelines(namespace string) v1alpha1.NIMPipelineInterface {
 	return &FakeNIMPipelines{c, namespace}
 }
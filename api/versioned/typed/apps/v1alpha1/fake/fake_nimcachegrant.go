@@ -0,0 +1,133 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeNIMCacheGrants implements NIMCacheGrantInterface
+type FakeNIMCacheGrants struct {
+	Fake *FakeAppsV1alpha1
+	ns   string
+}
+
+var nimcachegrantsResource = v1alpha1.SchemeGroupVersion.WithResource("nimcachegrants")
+
+var nimcachegrantsKind = v1alpha1.SchemeGroupVersion.WithKind("NIMCacheGrant")
+
+// Get takes name of the nIMCacheGrant, and returns the corresponding nIMCacheGrant object, and an error if there is any.
+func (c *FakeNIMCacheGrants) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.NIMCacheGrant, err error) {
+	emptyResult := &v1alpha1.NIMCacheGrant{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetActionWithOptions(nimcachegrantsResource, c.ns, name, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.NIMCacheGrant), err
+}
+
+// List takes label and field selectors, and returns the list of NIMCacheGrants that match those selectors.
+func (c *FakeNIMCacheGrants) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.NIMCacheGrantList, err error) {
+	emptyResult := &v1alpha1.NIMCacheGrantList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewListActionWithOptions(nimcachegrantsResource, nimcachegrantsKind, c.ns, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.NIMCacheGrantList{ListMeta: obj.(*v1alpha1.NIMCacheGrantList).ListMeta}
+	for _, item := range obj.(*v1alpha1.NIMCacheGrantList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested nIMCacheGrants.
+func (c *FakeNIMCacheGrants) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchActionWithOptions(nimcachegrantsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a nIMCacheGrant and creates it.  Returns the server's representation of the nIMCacheGrant, and an error, if there is any.
+func (c *FakeNIMCacheGrants) Create(ctx context.Context, nIMCacheGrant *v1alpha1.NIMCacheGrant, opts v1.CreateOptions) (result *v1alpha1.NIMCacheGrant, err error) {
+	emptyResult := &v1alpha1.NIMCacheGrant{}
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateActionWithOptions(nimcachegrantsResource, c.ns, nIMCacheGrant, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.NIMCacheGrant), err
+}
+
+// Update takes the representation of a nIMCacheGrant and updates it. Returns the server's representation of the nIMCacheGrant, and an error, if there is any.
+func (c *FakeNIMCacheGrants) Update(ctx context.Context, nIMCacheGrant *v1alpha1.NIMCacheGrant, opts v1.UpdateOptions) (result *v1alpha1.NIMCacheGrant, err error) {
+	emptyResult := &v1alpha1.NIMCacheGrant{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateActionWithOptions(nimcachegrantsResource, c.ns, nIMCacheGrant, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.NIMCacheGrant), err
+}
+
+// Delete takes name of the nIMCacheGrant and deletes it. Returns an error if one occurs.
+func (c *FakeNIMCacheGrants) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(nimcachegrantsResource, c.ns, name, opts), &v1alpha1.NIMCacheGrant{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeNIMCacheGrants) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionActionWithOptions(nimcachegrantsResource, c.ns, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.NIMCacheGrantList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched nIMCacheGrant.
+func (c *FakeNIMCacheGrants) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.NIMCacheGrant, err error) {
+	emptyResult := &v1alpha1.NIMCacheGrant{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(nimcachegrantsResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.NIMCacheGrant), err
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeNIMGateways implements NIMGatewayInterface
+type FakeNIMGateways struct {
+	Fake *FakeAppsV1alpha1
+	ns   string
+}
+
+var nimgatewaysResource = v1alpha1.SchemeGroupVersion.WithResource("nimgateways")
+
+var nimgatewaysKind = v1alpha1.SchemeGroupVersion.WithKind("NIMGateway")
+
+// Get takes name of the nIMGateway, and returns the corresponding nIMGateway object, and an error if there is any.
+func (c *FakeNIMGateways) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.NIMGateway, err error) {
+	emptyResult := &v1alpha1.NIMGateway{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetActionWithOptions(nimgatewaysResource, c.ns, name, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.NIMGateway), err
+}
+
+// List takes label and field selectors, and returns the list of NIMGateways that match those selectors.
+func (c *FakeNIMGateways) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.NIMGatewayList, err error) {
+	emptyResult := &v1alpha1.NIMGatewayList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewListActionWithOptions(nimgatewaysResource, nimgatewaysKind, c.ns, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.NIMGatewayList{ListMeta: obj.(*v1alpha1.NIMGatewayList).ListMeta}
+	for _, item := range obj.(*v1alpha1.NIMGatewayList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested nIMGateways.
+func (c *FakeNIMGateways) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchActionWithOptions(nimgatewaysResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a nIMGateway and creates it.  Returns the server's representation of the nIMGateway, and an error, if there is any.
+func (c *FakeNIMGateways) Create(ctx context.Context, nIMGateway *v1alpha1.NIMGateway, opts v1.CreateOptions) (result *v1alpha1.NIMGateway, err error) {
+	emptyResult := &v1alpha1.NIMGateway{}
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateActionWithOptions(nimgatewaysResource, c.ns, nIMGateway, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.NIMGateway), err
+}
+
+// Update takes the representation of a nIMGateway and updates it. Returns the server's representation of the nIMGateway, and an error, if there is any.
+func (c *FakeNIMGateways) Update(ctx context.Context, nIMGateway *v1alpha1.NIMGateway, opts v1.UpdateOptions) (result *v1alpha1.NIMGateway, err error) {
+	emptyResult := &v1alpha1.NIMGateway{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateActionWithOptions(nimgatewaysResource, c.ns, nIMGateway, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.NIMGateway), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeNIMGateways) UpdateStatus(ctx context.Context, nIMGateway *v1alpha1.NIMGateway, opts v1.UpdateOptions) (result *v1alpha1.NIMGateway, err error) {
+	emptyResult := &v1alpha1.NIMGateway{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(nimgatewaysResource, "status", c.ns, nIMGateway, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.NIMGateway), err
+}
+
+// Delete takes name of the nIMGateway and deletes it. Returns an error if one occurs.
+func (c *FakeNIMGateways) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(nimgatewaysResource, c.ns, name, opts), &v1alpha1.NIMGateway{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeNIMGateways) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionActionWithOptions(nimgatewaysResource, c.ns, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.NIMGatewayList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched nIMGateway.
+func (c *FakeNIMGateways) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.NIMGateway, err error) {
+	emptyResult := &v1alpha1.NIMGateway{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(nimgatewaysResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.NIMGateway), err
+}
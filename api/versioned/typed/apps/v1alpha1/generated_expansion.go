@@ -21,6 +21,10 @@ type NIMBuildExpansion interface{}
 
 type NIMCacheExpansion interface{}
 
+type NIMCacheGrantExpansion interface{}
+
+type NIMGatewayExpansion interface{}
+
 type NIMPipelineExpansion interface{}
 
 type NIMServiceExpansion interface{}
@@ -0,0 +1,68 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+
+	v1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	scheme "github.com/NVIDIA/k8s-nim-operator/api/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// NIMGatewaysGetter has a method to return a NIMGatewayInterface.
+// A group's client should implement this interface.
+type NIMGatewaysGetter interface {
+	NIMGateways(namespace string) NIMGatewayInterface
+}
+
+// NIMGatewayInterface has methods to work with NIMGateway resources.
+type NIMGatewayInterface interface {
+	Create(ctx context.Context, nIMGateway *v1alpha1.NIMGateway, opts v1.CreateOptions) (*v1alpha1.NIMGateway, error)
+	Update(ctx context.Context, nIMGateway *v1alpha1.NIMGateway, opts v1.UpdateOptions) (*v1alpha1.NIMGateway, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, nIMGateway *v1alpha1.NIMGateway, opts v1.UpdateOptions) (*v1alpha1.NIMGateway, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.NIMGateway, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.NIMGatewayList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.NIMGateway, err error)
+	NIMGatewayExpansion
+}
+
+// nIMGateways implements NIMGatewayInterface
+type nIMGateways struct {
+	*gentype.ClientWithList[*v1alpha1.NIMGateway, *v1alpha1.NIMGatewayList]
+}
+
+// newNIMGateways returns a NIMGateways
+func newNIMGateways(c *AppsV1alpha1Client, namespace string) *nIMGateways {
+	return &nIMGateways{
+		gentype.NewClientWithList[*v1alpha1.NIMGateway, *v1alpha1.NIMGatewayList](
+			"nimgateways",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1alpha1.NIMGateway { return &v1alpha1.NIMGateway{} },
+			func() *v1alpha1.NIMGatewayList { return &v1alpha1.NIMGatewayList{} }),
+	}
+}
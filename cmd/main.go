@@ -39,6 +39,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	lws "sigs.k8s.io/lws/api/leaderworkerset/v1"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
@@ -60,6 +61,7 @@ func init() {
 	utilruntime.Must(monitoring.AddToScheme(scheme))
 	utilruntime.Must(lws.AddToScheme(scheme))
 	utilruntime.Must(kservev1beta1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -199,10 +201,23 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = controller.NewNIMGatewayReconciler(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		updater,
+		discoveryClient,
+		render.NewRenderer("/manifests"),
+		ctrl.Log.WithName("controllers").WithName("NIMGateway"),
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NIMGateway")
+		os.Exit(1)
+	}
+
 	if err = controller.NewNemoGuardrailReconciler(
 		mgr.GetClient(),
 		mgr.GetScheme(),
 		updater,
+		discoveryClient,
 		render.NewRenderer("/manifests"),
 		ctrl.Log.WithName("controllers").WithName("NemoGuardrail"),
 	).SetupWithManager(mgr); err != nil {
@@ -214,6 +229,7 @@ func main() {
 		mgr.GetClient(),
 		mgr.GetScheme(),
 		updater,
+		discoveryClient,
 		render.NewRenderer("/manifests"),
 		ctrl.Log.WithName("controllers").WithName("NemoEvaluator"),
 	).SetupWithManager(mgr); err != nil {
@@ -225,6 +241,7 @@ func main() {
 		mgr.GetClient(),
 		mgr.GetScheme(),
 		updater,
+		discoveryClient,
 		render.NewRenderer("/manifests"),
 		ctrl.Log.WithName("controllers").WithName("NemoEntitystore"),
 	).SetupWithManager(mgr); err != nil {
@@ -236,6 +253,7 @@ func main() {
 		mgr.GetClient(),
 		mgr.GetScheme(),
 		updater,
+		discoveryClient,
 		render.NewRenderer("/manifests"),
 		ctrl.Log.WithName("controllers").WithName("NemoDatastore"),
 	).SetupWithManager(mgr); err != nil {
@@ -247,6 +265,7 @@ func main() {
 		mgr.GetClient(),
 		mgr.GetScheme(),
 		updater,
+		discoveryClient,
 		render.NewRenderer("/manifests"),
 		ctrl.Log.WithName("controllers").WithName("NemoCustomizer"),
 	).SetupWithManager(mgr); err != nil {
@@ -276,6 +295,11 @@ func main() {
 			setupLog.Error(err, "unable to create webhook", "webhook", "NIMService")
 			os.Exit(1)
 		}
+
+		if err := webhookappsv1alpha1.SetupNIMPipelineWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "NIMPipeline")
+			os.Exit(1)
+		}
 	}
 	// +kubebuilder:scaffold:builder
 
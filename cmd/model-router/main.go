@@ -0,0 +1,91 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// model-router routes the OpenAI compatible requests of a multi-model NIMService pod
+// to the NIM container serving the requested model.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/NVIDIA/k8s-nim-operator/internal/modelrouter"
+)
+
+// backendsFlag collects the repeated --backend flags.
+type backendsFlag []string
+
+func (b *backendsFlag) String() string {
+	return strings.Join(*b, ",")
+}
+
+func (b *backendsFlag) Set(value string) error {
+	*b = append(*b, value)
+	return nil
+}
+
+func main() {
+	var port int
+	var refreshInterval time.Duration
+	var backends backendsFlag
+	flag.IntVar(&port, "port", 8000, "The port the router listens on.")
+	flag.Var(&backends, "backend", "The URL of a NIM backend, e.g. http://127.0.0.1:8100. Can be repeated, "+
+		"requests without a model being routed to the first backend.")
+	flag.DurationVar(&refreshInterval, "refresh-interval", 10*time.Second, "The interval to discover the models of the backends.")
+	opts := zap.Options{}
+	opts.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	logger := ctrl.Log.WithName("model-router")
+
+	router, err := modelrouter.NewRouter(backends)
+	if err != nil {
+		logger.Error(err, "invalid backends")
+		os.Exit(1)
+	}
+
+	ctx := log.IntoContext(ctrl.SetupSignalHandler(), logger)
+	go router.Run(ctx, refreshInterval)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           router,
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	logger.Info("starting model router", "port", port, "backends", backends.String())
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Error(err, "model router failed")
+		os.Exit(1)
+	}
+}
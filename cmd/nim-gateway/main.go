@@ -0,0 +1,79 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// nim-gateway routes the OpenAI compatible requests of the clients of a NIMGateway
+// to the NIMServices serving the requested model.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/NVIDIA/k8s-nim-operator/internal/modelrouter"
+)
+
+func main() {
+	var port int
+	var configFile, apiKeysDir string
+	var reloadInterval time.Duration
+	flag.IntVar(&port, "port", 8000, "The port the gateway listens on.")
+	flag.StringVar(&configFile, "config", "/etc/nim-gateway/config/gateway.json", "The routing config of the gateway.")
+	flag.StringVar(&apiKeysDir, "api-keys-dir", "", "The directory of the API keys accepted by the gateway, one API key per file. "+
+		"Authentication is disabled when not set.")
+	flag.DurationVar(&reloadInterval, "reload-interval", 10*time.Second, "The interval to reload the config and the API keys.")
+	opts := zap.Options{}
+	opts.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	logger := ctrl.Log.WithName("nim-gateway")
+
+	gateway := modelrouter.NewGateway(configFile, apiKeysDir)
+	if err := gateway.Load(); err != nil {
+		// Not ready until the config is loaded
+		logger.Info("WARN: Failed to load config, will retry", "error", err.Error())
+	}
+
+	ctx := log.IntoContext(ctrl.SetupSignalHandler(), logger)
+	go gateway.Run(ctx, reloadInterval)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           gateway,
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	logger.Info("starting gateway", "port", port, "config", configFile)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Error(err, "gateway failed")
+		os.Exit(1)
+	}
+}
@@ -119,8 +119,10 @@ mkdir -p "$ARTIFACT_DIR/nim"
 
 echo "Gathering NIMPipeline, NIMService and NIMCache CRs from $NIM_NAMESPACE"
 $K get nimcaches.apps.nvidia.com -n "$NIM_NAMESPACE" -oyaml > "$ARTIFACT_DIR/nim/nimcaches.yaml" || true
+$K get nimcachegrants.apps.nvidia.com -n "$NIM_NAMESPACE" -oyaml > "$ARTIFACT_DIR/nim/nimcachegrants.yaml" || true
 $K get nimpipelines.apps.nvidia.com -n "$NIM_NAMESPACE" -oyaml > "$ARTIFACT_DIR/nim/nimpipelines.yaml" || true
 $K get nimservices.apps.nvidia.com -n "$NIM_NAMESPACE" -oyaml > "$ARTIFACT_DIR/nim/nimservices.yaml" || true
+$K get nimgateways.apps.nvidia.com -n "$NIM_NAMESPACE" -oyaml > "$ARTIFACT_DIR/nim/nimgateways.yaml" || true
 
 echo "Gathering ConfigMaps in $NIM_NAMESPACE owned by NIMCache"
 mkdir -p "$ARTIFACT_DIR/nim/configmaps"
@@ -143,6 +145,13 @@ done
 echo "Gathering Ingress configuration from $NIM_NAMESPACE"
 mkdir -p "$ARTIFACT_DIR/nim/ingress"
 $K get ingress -n "$NIM_NAMESPACE" -oyaml > "$ARTIFACT_DIR/nim/ingress/ingress.yaml" || true
+$K get httproutes,grpcroutes.gateway.networking.k8s.io -n "$NIM_NAMESPACE" -oyaml > "$ARTIFACT_DIR/nim/ingress/routes.yaml" || true
+$K get inferencepools,inferencemodels.inference.networking.x-k8s.io -n "$NIM_NAMESPACE" -oyaml > "$ARTIFACT_DIR/nim/ingress/inference.yaml" || true
+
+echo "Gathering autoscaling configuration from $NIM_NAMESPACE"
+mkdir -p "$ARTIFACT_DIR/nim/autoscaling"
+$K get horizontalpodautoscalers -n "$NIM_NAMESPACE" -oyaml > "$ARTIFACT_DIR/nim/autoscaling/hpa.yaml" || true
+$K get scaledobjects.keda.sh -n "$NIM_NAMESPACE" -oyaml > "$ARTIFACT_DIR/nim/autoscaling/scaledobjects.yaml" || true
 
 ######################################
 # NEMO MICROSERVICES
@@ -173,6 +182,7 @@ if [[ -n "${NEMO_NAMESPACE:-}" ]]; then
   echo "Gathering Ingress configuration from $NEMO_NAMESPACE"
   mkdir -p "$ARTIFACT_DIR/nemo/ingress"
   $K get ingress -n "$NEMO_NAMESPACE" -oyaml > "$ARTIFACT_DIR/nemo/ingress/ingress.yaml" || true
+  $K get httproutes.gateway.networking.k8s.io -n "$NEMO_NAMESPACE" -oyaml > "$ARTIFACT_DIR/nemo/ingress/routes.yaml" || true
 else
   echo "Skipping NeMo microservice collection. NEMO_NAMESPACE not set."
 fi
@@ -47,8 +47,18 @@ const (
 	ReasonServiceFailed = "ServiceFailed"
 	// ReasonIngressFailed indicates that the creation of ingress has failed.
 	ReasonIngressFailed = "IngressFailed"
+	// ReasonHTTPRouteFailed indicates that the creation of httproute has failed.
+	ReasonHTTPRouteFailed = "HTTPRouteFailed"
+	// ReasonGRPCRouteFailed indicates that the creation of grpcroute has failed.
+	ReasonGRPCRouteFailed = "GRPCRouteFailed"
+	// ReasonInferencePoolFailed indicates that the creation of inferencepool has failed.
+	ReasonInferencePoolFailed = "InferencePoolFailed"
+	// ReasonInferenceModelFailed indicates that the creation of inferencemodel has failed.
+	ReasonInferenceModelFailed = "InferenceModelFailed"
 	// ReasonHPAFailed indicates that the creation of hpa has failed.
 	ReasonHPAFailed = "HPAFailed"
+	// ReasonScaledObjectFailed indicates that the creation of KEDA scaledobject has failed.
+	ReasonScaledObjectFailed = "ScaledObjectFailed"
 	// ReasonSCCFailed indicates that the creation of scc has failed.
 	ReasonSCCFailed = "SCCFailed"
 	// ReasonServiceMonitorFailed indicates that the creation of Service Monitor has failed.
@@ -69,8 +79,25 @@ const (
 	ReasonNIMCacheNotFound = "NIMCacheNotFound"
 	// ReasonNIMCacheNotReady indicates that the NIMCache is not ready.
 	ReasonNIMCacheNotReady = "NIMCacheNotReady"
+	// ReasonNIMCacheNotGranted indicates that the NIMCache in another namespace is not granted to the NIMService.
+	ReasonNIMCacheNotGranted = "NIMCacheNotGranted"
+	// ReasonNIMCacheObjectStoreNotShared indicates that the NIMCache in another namespace is cached in an object store,
+	// whose credentials are not shared with the NIMService namespace.
+	ReasonNIMCacheObjectStoreNotShared = "NIMCacheObjectStoreNotShared"
+	// ReasonNIMBuildNotFound indicates that the NIMBuild tracked by the NIMService is not found.
+	ReasonNIMBuildNotFound = "NIMBuildNotFound"
+	// ReasonLoRAAdaptersNotReady indicates that the LoRA adapters of the NIMService cannot be resolved yet.
+	ReasonLoRAAdaptersNotReady = "LoRAAdaptersNotReady"
 	// ReasonDRAResourcesUnsupported indicates that the DRA resources are not supported on this cluster version.
 	ReasonDRAResourcesUnsupported = "DRAResourcesUnsupported"
+	// ReasonModelRouterUnavailable indicates that no model router image is configured for the multi-model NIMService.
+	ReasonModelRouterUnavailable = "ModelRouterUnavailable"
+	// ReasonGatewayUnavailable indicates that no gateway image is configured for the NIMGateway.
+	ReasonGatewayUnavailable = "GatewayUnavailable"
+	// ReasonNoModelsRouted indicates that none of the NIMServices selected by the NIMGateway serves a model yet.
+	ReasonNoModelsRouted = "NoModelsRouted"
+	// ReasonMultiNodeUnsupported indicates that the multi-node NIMService is not supported by the deployment mode.
+	ReasonMultiNodeUnsupported = "MultiNodeUnsupported"
 	// ReasonInferenceServiceFailed indicates that the creation of inferenceservice has failed.
 	ReasonInferenceServiceFailed = "InferenceServiceFailed"
 	// ReasonResourceClaimFailed indicates that the creation of resourceclaim has failed.
@@ -109,6 +136,8 @@ func (u *updater) SetConditionsReady(ctx context.Context, obj client.Object, rea
 		return u.SetConditionsReadyNemoDatastore(ctx, cr, reason, message)
 	case *appsv1alpha1.NemoEvaluator:
 		return u.SetConditionsReadyNemoEvaluator(ctx, cr, reason, message)
+	case *appsv1alpha1.NIMGateway:
+		return u.SetConditionsReadyNIMGateway(ctx, cr, reason, message)
 	default:
 		return fmt.Errorf("unknown CRD type for %v", obj)
 	}
@@ -216,6 +245,23 @@ func (u *updater) SetConditionsReadyNemoEvaluator(ctx context.Context, cr *appsv
 	return u.updateNemoEvaluatorStatus(ctx, cr)
 }
 
+func (u *updater) SetConditionsReadyNIMGateway(ctx context.Context, cr *appsv1alpha1.NIMGateway, reason, message string) error {
+	meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+		Type:    Ready,
+		Status:  metav1.ConditionTrue,
+		Reason:  reason,
+		Message: message,
+	})
+
+	meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+		Type:   Failed,
+		Status: metav1.ConditionFalse,
+		Reason: Ready,
+	})
+	cr.Status.State = appsv1alpha1.NIMGatewayStatusReady
+	return u.updateNIMGatewayStatus(ctx, cr)
+}
+
 func (u *updater) SetConditionsNotReady(ctx context.Context, obj client.Object, reason, message string) error {
 	switch cr := obj.(type) {
 	case *appsv1alpha1.NIMService:
@@ -230,6 +276,8 @@ func (u *updater) SetConditionsNotReady(ctx context.Context, obj client.Object,
 		return u.SetConditionsNotReadyNemoCustomizer(ctx, cr, reason, message)
 	case *appsv1alpha1.NemoEvaluator:
 		return u.SetConditionsNotReadyNemoEvaluator(ctx, cr, reason, message)
+	case *appsv1alpha1.NIMGateway:
+		return u.SetConditionsNotReadyNIMGateway(ctx, cr, reason, message)
 	default:
 		return fmt.Errorf("unknown CRD type for %v", obj)
 	}
@@ -343,6 +391,24 @@ func (u *updater) SetConditionsNotReadyNemoEvaluator(ctx context.Context, cr *ap
 	return u.updateNemoEvaluatorStatus(ctx, cr)
 }
 
+func (u *updater) SetConditionsNotReadyNIMGateway(ctx context.Context, cr *appsv1alpha1.NIMGateway, reason, message string) error {
+	meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+		Type:    Ready,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: message,
+	})
+
+	meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+		Type:    Failed,
+		Status:  metav1.ConditionFalse,
+		Reason:  Ready,
+		Message: message,
+	})
+	cr.Status.State = appsv1alpha1.NIMGatewayStatusNotReady
+	return u.updateNIMGatewayStatus(ctx, cr)
+}
+
 func (u *updater) SetConditionsFailed(ctx context.Context, obj client.Object, reason, message string) error {
 	switch cr := obj.(type) {
 	case *appsv1alpha1.NIMService:
@@ -357,6 +423,8 @@ func (u *updater) SetConditionsFailed(ctx context.Context, obj client.Object, re
 		return u.SetConditionsFailedNemoCustomizer(ctx, cr, reason, message)
 	case *appsv1alpha1.NemoEvaluator:
 		return u.SetConditionsFailedNemoEvaluator(ctx, cr, reason, message)
+	case *appsv1alpha1.NIMGateway:
+		return u.SetConditionsFailedNIMGateway(ctx, cr, reason, message)
 	default:
 		return fmt.Errorf("unknown CRD type for %v", obj)
 	}
@@ -464,6 +532,23 @@ func (u *updater) SetConditionsFailedNemoEvaluator(ctx context.Context, cr *apps
 	return u.updateNemoEvaluatorStatus(ctx, cr)
 }
 
+func (u *updater) SetConditionsFailedNIMGateway(ctx context.Context, cr *appsv1alpha1.NIMGateway, reason, message string) error {
+	meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+		Type:   Ready,
+		Status: metav1.ConditionFalse,
+		Reason: Failed,
+	})
+
+	meta.SetStatusCondition(&cr.Status.Conditions, metav1.Condition{
+		Type:    Failed,
+		Status:  metav1.ConditionTrue,
+		Reason:  reason,
+		Message: message,
+	})
+	cr.Status.State = appsv1alpha1.NIMGatewayStatusFailed
+	return u.updateNIMGatewayStatus(ctx, cr)
+}
+
 func (u *updater) updateNIMServiceStatus(ctx context.Context, cr *appsv1alpha1.NIMService) error {
 
 	obj := &appsv1alpha1.NIMService{}
@@ -543,6 +628,19 @@ func (u *updater) updateNemoEvaluatorStatus(ctx context.Context, cr *appsv1alpha
 	return nil
 }
 
+func (u *updater) updateNIMGatewayStatus(ctx context.Context, cr *appsv1alpha1.NIMGateway) error {
+	obj := &appsv1alpha1.NIMGateway{}
+	errGet := u.client.Get(ctx, types.NamespacedName{Name: cr.Name, Namespace: cr.GetNamespace()}, obj)
+	if errGet != nil {
+		return errGet
+	}
+	obj.Status = cr.Status
+	if err := u.client.Status().Update(ctx, obj); err != nil {
+		return err
+	}
+	return nil
+}
+
 // UpdateCondition updates the given condition into the conditions list.
 func UpdateCondition(conditions *[]metav1.Condition, conditionType string, status metav1.ConditionStatus, reason, message string) {
 	for i := range *conditions {
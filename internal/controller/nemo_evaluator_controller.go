@@ -42,6 +42,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
@@ -60,6 +61,7 @@ type NemoEvaluatorReconciler struct {
 	scheme           *runtime.Scheme
 	log              logr.Logger
 	updater          conditions.Updater
+	discoveryClient  discovery.DiscoveryInterface
 	renderer         render.Renderer
 	Config           *rest.Config
 	recorder         record.EventRecorder
@@ -70,13 +72,14 @@ type NemoEvaluatorReconciler struct {
 var _ shared.Reconciler = &NemoEvaluatorReconciler{}
 
 // NemoEvaluatorReconciler creates a new reconciler for NemoEvaluator with the given platform.
-func NewNemoEvaluatorReconciler(client client.Client, scheme *runtime.Scheme, updater conditions.Updater, renderer render.Renderer, log logr.Logger) *NemoEvaluatorReconciler {
+func NewNemoEvaluatorReconciler(client client.Client, scheme *runtime.Scheme, updater conditions.Updater, discoveryClient discovery.DiscoveryInterface, renderer render.Renderer, log logr.Logger) *NemoEvaluatorReconciler {
 	return &NemoEvaluatorReconciler{
-		Client:   client,
-		scheme:   scheme,
-		updater:  updater,
-		renderer: renderer,
-		log:      log,
+		Client:          client,
+		scheme:          scheme,
+		updater:         updater,
+		discoveryClient: discoveryClient,
+		renderer:        renderer,
+		log:             log,
 	}
 }
 
@@ -95,6 +98,7 @@ func NewNemoEvaluatorReconciler(client client.Client, scheme *runtime.Scheme, up
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes;grpcroutes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalars,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
 
@@ -200,7 +204,7 @@ func (r *NemoEvaluatorReconciler) GetUpdater() conditions.Updater {
 
 // GetDiscoveryClient returns the discovery client instance.
 func (r *NemoEvaluatorReconciler) GetDiscoveryClient() discovery.DiscoveryInterface {
-	return nil
+	return r.discoveryClient
 }
 
 // GetRenderer returns the renderer instance.
@@ -229,7 +233,7 @@ func (r *NemoEvaluatorReconciler) GetOrchestratorType(ctx context.Context) (k8su
 // SetupWithManager sets up the controller with the Manager.
 func (r *NemoEvaluatorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.recorder = mgr.GetEventRecorderFor("nemo-evaluator-service-controller")
-	return ctrl.NewControllerManagedBy(mgr).
+	nemoEvaluatorBuilder := ctrl.NewControllerManagedBy(mgr).
 		For(&appsv1alpha1.NemoEvaluator{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&appsv1.StatefulSet{}).
@@ -257,8 +261,17 @@ func (r *NemoEvaluatorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				// For other types we watch, reconcile them
 				return true
 			},
-		}).
-		Complete(r)
+		})
+
+	httpRouteCRDExists, err := k8sutil.CRDExists(r.GetDiscoveryClient(), gatewayv1.SchemeGroupVersion.WithResource("httproutes"))
+	if err != nil {
+		return err
+	}
+	if httpRouteCRDExists {
+		nemoEvaluatorBuilder = nemoEvaluatorBuilder.Owns(&gatewayv1.HTTPRoute{})
+	}
+
+	return nemoEvaluatorBuilder.Complete(r)
 }
 
 func (r *NemoEvaluatorReconciler) refreshMetrics(ctx context.Context) {
@@ -334,6 +347,24 @@ func (r *NemoEvaluatorReconciler) reconcileNemoEvaluator(ctx context.Context, ne
 		}
 	}
 
+	// Sync gateway routes
+	if nemoEvaluator.IsRouterEnabled() {
+		err = r.renderAndSyncResource(ctx, nemoEvaluator, &renderer, &gatewayv1.HTTPRoute{}, func() (client.Object, error) {
+			if err := shared.ValidateGatewayRouteResource(r.GetDiscoveryClient(), "httproutes"); err != nil {
+				return nil, err
+			}
+			return renderer.HTTPRoute(nemoEvaluator.GetHTTPRouteParams())
+		}, "httproute", conditions.ReasonHTTPRouteFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		err = k8sutil.CleanupResource(ctx, r.GetClient(), &gatewayv1.HTTPRoute{}, namespacedName)
+		if err != nil && !errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Sync HPA
 	if nemoEvaluator.IsAutoScalingEnabled() {
 		err = r.renderAndSyncResource(ctx, nemoEvaluator, &renderer, &autoscalingv2.HorizontalPodAutoscaler{}, func() (client.Object, error) {
@@ -42,6 +42,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
@@ -60,6 +61,7 @@ type NemoGuardrailReconciler struct {
 	scheme           *runtime.Scheme
 	log              logr.Logger
 	updater          conditions.Updater
+	discoveryClient  discovery.DiscoveryInterface
 	renderer         render.Renderer
 	Config           *rest.Config
 	recorder         record.EventRecorder
@@ -70,13 +72,14 @@ type NemoGuardrailReconciler struct {
 var _ shared.Reconciler = &NemoGuardrailReconciler{}
 
 // NewNemoGuardrailReconciler creates a new reconciler for NemoGuardrail with the given platform.
-func NewNemoGuardrailReconciler(client client.Client, scheme *runtime.Scheme, updater conditions.Updater, renderer render.Renderer, log logr.Logger) *NemoGuardrailReconciler {
+func NewNemoGuardrailReconciler(client client.Client, scheme *runtime.Scheme, updater conditions.Updater, discoveryClient discovery.DiscoveryInterface, renderer render.Renderer, log logr.Logger) *NemoGuardrailReconciler {
 	return &NemoGuardrailReconciler{
-		Client:   client,
-		scheme:   scheme,
-		updater:  updater,
-		renderer: renderer,
-		log:      log,
+		Client:          client,
+		scheme:          scheme,
+		updater:         updater,
+		discoveryClient: discoveryClient,
+		renderer:        renderer,
+		log:             log,
 	}
 }
 
@@ -96,6 +99,7 @@ func NewNemoGuardrailReconciler(client client.Client, scheme *runtime.Scheme, up
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes;grpcroutes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
 
@@ -201,7 +205,7 @@ func (r *NemoGuardrailReconciler) GetUpdater() conditions.Updater {
 
 // GetDiscoveryClient returns the discovery client instance.
 func (r *NemoGuardrailReconciler) GetDiscoveryClient() discovery.DiscoveryInterface {
-	return nil
+	return r.discoveryClient
 }
 
 // GetRenderer returns the renderer instance.
@@ -230,7 +234,7 @@ func (r *NemoGuardrailReconciler) GetOrchestratorType(ctx context.Context) (k8su
 // SetupWithManager sets up the controller with the Manager.
 func (r *NemoGuardrailReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.recorder = mgr.GetEventRecorderFor("nemo-guardrail-service-controller")
-	return ctrl.NewControllerManagedBy(mgr).
+	nemoGuardrailBuilder := ctrl.NewControllerManagedBy(mgr).
 		For(&appsv1alpha1.NemoGuardrail{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&appsv1.StatefulSet{}).
@@ -258,8 +262,17 @@ func (r *NemoGuardrailReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				// For other types we watch, reconcile them
 				return true
 			},
-		}).
-		Complete(r)
+		})
+
+	httpRouteCRDExists, err := k8sutil.CRDExists(r.GetDiscoveryClient(), gatewayv1.SchemeGroupVersion.WithResource("httproutes"))
+	if err != nil {
+		return err
+	}
+	if httpRouteCRDExists {
+		nemoGuardrailBuilder = nemoGuardrailBuilder.Owns(&gatewayv1.HTTPRoute{})
+	}
+
+	return nemoGuardrailBuilder.Complete(r)
 }
 
 func (r *NemoGuardrailReconciler) refreshMetrics(ctx context.Context) {
@@ -342,6 +355,24 @@ func (r *NemoGuardrailReconciler) reconcileNemoGuardrail(ctx context.Context, ne
 		}
 	}
 
+	// Sync gateway routes
+	if nemoGuardrail.IsRouterEnabled() {
+		err = r.renderAndSyncResource(ctx, nemoGuardrail, &renderer, &gatewayv1.HTTPRoute{}, func() (client.Object, error) {
+			if err := shared.ValidateGatewayRouteResource(r.GetDiscoveryClient(), "httproutes"); err != nil {
+				return nil, err
+			}
+			return renderer.HTTPRoute(nemoGuardrail.GetHTTPRouteParams())
+		}, "httproute", conditions.ReasonHTTPRouteFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		err = k8sutil.CleanupResource(ctx, r.GetClient(), &gatewayv1.HTTPRoute{}, namespacedName)
+		if err != nil && !errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Sync HPA
 	if nemoGuardrail.IsAutoScalingEnabled() {
 		err = r.renderAndSyncResource(ctx, nemoGuardrail, &renderer, &autoscalingv2.HorizontalPodAutoscaler{}, func() (client.Object, error) {
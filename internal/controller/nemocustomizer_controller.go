@@ -44,6 +44,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/yaml"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
@@ -67,6 +68,7 @@ type NemoCustomizerReconciler struct {
 	scheme           *runtime.Scheme
 	log              logr.Logger
 	updater          conditions.Updater
+	discoveryClient  discovery.DiscoveryInterface
 	renderer         render.Renderer
 	Config           *rest.Config
 	recorder         record.EventRecorder
@@ -77,13 +79,14 @@ type NemoCustomizerReconciler struct {
 var _ shared.Reconciler = &NemoCustomizerReconciler{}
 
 // NewNemoCustomizerReconciler creates a new reconciler for NemoCustomizer with the given platform.
-func NewNemoCustomizerReconciler(client client.Client, scheme *runtime.Scheme, updater conditions.Updater, renderer render.Renderer, log logr.Logger) *NemoCustomizerReconciler {
+func NewNemoCustomizerReconciler(client client.Client, scheme *runtime.Scheme, updater conditions.Updater, discoveryClient discovery.DiscoveryInterface, renderer render.Renderer, log logr.Logger) *NemoCustomizerReconciler {
 	return &NemoCustomizerReconciler{
-		Client:   client,
-		scheme:   scheme,
-		updater:  updater,
-		renderer: renderer,
-		log:      log,
+		Client:          client,
+		scheme:          scheme,
+		updater:         updater,
+		discoveryClient: discoveryClient,
+		renderer:        renderer,
+		log:             log,
 	}
 }
 
@@ -108,6 +111,7 @@ func NewNemoCustomizerReconciler(client client.Client, scheme *runtime.Scheme, u
 // +kubebuilder:rbac:groups=batch,resources=jobs;jobs/status,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes;grpcroutes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalars,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
 // +kubebuilder:rbac:groups="nodeinfo.volcano.sh",resources=numatopologies,verbs=get;list;watch
@@ -214,7 +218,7 @@ func (r *NemoCustomizerReconciler) GetUpdater() conditions.Updater {
 
 // GetDiscoveryClient returns the discovery client instance.
 func (r *NemoCustomizerReconciler) GetDiscoveryClient() discovery.DiscoveryInterface {
-	return nil
+	return r.discoveryClient
 }
 
 // GetRenderer returns the renderer instance.
@@ -243,7 +247,7 @@ func (r *NemoCustomizerReconciler) GetOrchestratorType(ctx context.Context) (k8s
 // SetupWithManager sets up the controller with the Manager.
 func (r *NemoCustomizerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.recorder = mgr.GetEventRecorderFor("nemo-customizer-service-controller")
-	return ctrl.NewControllerManagedBy(mgr).
+	nemoCustomizerBuilder := ctrl.NewControllerManagedBy(mgr).
 		For(&appsv1alpha1.NemoCustomizer{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&appsv1.StatefulSet{}).
@@ -272,8 +276,17 @@ func (r *NemoCustomizerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				// For other types we watch, reconcile them
 				return true
 			},
-		}).
-		Complete(r)
+		})
+
+	httpRouteCRDExists, err := k8sutil.CRDExists(r.GetDiscoveryClient(), gatewayv1.SchemeGroupVersion.WithResource("httproutes"))
+	if err != nil {
+		return err
+	}
+	if httpRouteCRDExists {
+		nemoCustomizerBuilder = nemoCustomizerBuilder.Owns(&gatewayv1.HTTPRoute{})
+	}
+
+	return nemoCustomizerBuilder.Complete(r)
 }
 
 func (r *NemoCustomizerReconciler) refreshMetrics(ctx context.Context) {
@@ -349,6 +362,24 @@ func (r *NemoCustomizerReconciler) reconcileNemoCustomizer(ctx context.Context,
 		}
 	}
 
+	// Sync gateway routes
+	if nemoCustomizer.IsRouterEnabled() {
+		err = r.renderAndSyncResource(ctx, nemoCustomizer, &renderer, &gatewayv1.HTTPRoute{}, func() (client.Object, error) {
+			if err := shared.ValidateGatewayRouteResource(r.GetDiscoveryClient(), "httproutes"); err != nil {
+				return nil, err
+			}
+			return renderer.HTTPRoute(nemoCustomizer.GetHTTPRouteParams())
+		}, "httproute", conditions.ReasonHTTPRouteFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		err = k8sutil.CleanupResource(ctx, r.GetClient(), &gatewayv1.HTTPRoute{}, namespacedName)
+		if err != nil && !errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Sync HPA
 	if nemoCustomizer.IsAutoScalingEnabled() {
 		err = r.renderAndSyncResource(ctx, nemoCustomizer, &renderer, &autoscalingv2.HorizontalPodAutoscaler{}, func() (client.Object, error) {
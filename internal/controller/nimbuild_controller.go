@@ -19,9 +19,11 @@ package controller
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -64,6 +66,12 @@ const (
 	NIMBuildContainerName = "nim-build-ctr"
 
 	NIMBuildManifestContainerName = "nim-build-manifest-ctr"
+
+	// NIMBuildEngineBuildLabelKey labels the pods of an engine build with its name.
+	NIMBuildEngineBuildLabelKey = "apps.nvidia.com/nimbuild-engine-build"
+
+	// oomKilledExitCode is the exit code of a container killed on running out of memory.
+	oomKilledExitCode = 137
 )
 
 // NIMBuildReconciler reconciles a NIMBuild object.
@@ -236,6 +244,7 @@ func (r *NIMBuildReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&appsv1alpha1.NIMBuild{}).
+		Owns(&batchv1.Job{}).
 		Owns(&corev1.Pod{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
 		WithEventFilter(predicate.Funcs{
@@ -248,7 +257,8 @@ func (r *NIMBuildReconciler) SetupWithManager(mgr ctrl.Manager) error {
 						if !newNIMBuild.ObjectMeta.DeletionTimestamp.IsZero() {
 							return true
 						}
-						return false
+						// Handle case where the engines are requested to be built again
+						return newNIMBuild.IsRebuildRequested()
 					}
 				}
 				// For other types we watch, reconcile them
@@ -291,25 +301,36 @@ func (r *NIMBuildReconciler) cleanupNIMBuild(ctx context.Context, nimBuild *apps
 	var errList []error
 	logger := r.GetLogger()
 
-	// All owned objects are garbage collected
-
-	// Fetch the pod
-	podName := types.NamespacedName{Name: nimBuild.GetEngineBuildPodName(), Namespace: nimBuild.Namespace}
-	pod := &corev1.Pod{}
-	if err := r.Get(ctx, podName, pod); err != nil {
-		if errors.IsNotFound(err) {
-			return nil
+	// All owned objects are garbage collected, the running engine builds are stopped right away
+	jobNames := []string{nimBuild.GetEngineBuildJobName()}
+	for _, build := range nimBuild.Status.Builds {
+		jobNames = append(jobNames, nimBuild.GetBuildJobName(build.Name))
+	}
+	for _, jobName := range uniqueValues(jobNames) {
+		job := &batchv1.Job{}
+		if err := r.Get(ctx, types.NamespacedName{Name: jobName, Namespace: nimBuild.Namespace}, job); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			logger.Error(err, "unable to fetch the job for cleanup", "job", jobName)
+			return err
+		}
+		if err := r.deleteJob(ctx, job); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "unable to delete associated jobs during cleanup", "job", jobName)
+			errList = append(errList, err)
 		}
-		logger.Error(err, "unable to fetch the pod for cleanup", "pod", podName)
-		return err
 	}
 
-	if err := r.Delete(ctx, pod); err != nil {
-		if errors.IsNotFound(err) {
-			logger.Info("Pod already deleted", "pod", podName)
-			return nil
+	// Fetch the pod reading the local model manifest
+	podName := types.NamespacedName{Name: nimBuild.GetLocalManifestReaderPodName(), Namespace: nimBuild.Namespace}
+	pod := &corev1.Pod{}
+	if err := r.Get(ctx, podName, pod); err != nil {
+		if !errors.IsNotFound(err) {
+			logger.Error(err, "unable to fetch the pod for cleanup", "pod", podName)
+			return err
 		}
-		logger.Error(err, "unable to delete associated pods during cleanup", "job", podName, "pod", pod.Name)
+	} else if err := r.Delete(ctx, pod); err != nil && !errors.IsNotFound(err) {
+		logger.Error(err, "unable to delete associated pods during cleanup", "pod", podName)
 		errList = append(errList, err)
 	}
 
@@ -320,8 +341,43 @@ func (r *NIMBuildReconciler) cleanupNIMBuild(ctx context.Context, nimBuild *apps
 	return nil
 }
 
+// rebuildNIMBuild stops the engine builds of the NIMBuild and resets its status, for its engines to be built again.
+func (r *NIMBuildReconciler) rebuildNIMBuild(ctx context.Context, nimBuild *appsv1alpha1.NIMBuild) error {
+	logger := r.GetLogger()
+	if err := r.cleanupNIMBuild(ctx, nimBuild); err != nil {
+		return err
+	}
+
+	logger.Info("Rebuilding the engines of NIMBuild", "name", nimBuild.Name)
+	nimBuild.Status = appsv1alpha1.NIMBuildStatus{}
+	if err := r.updateNIMBuildState(ctx, nimBuild, appsv1alpha1.NimBuildStatusPending); err != nil {
+		return err
+	}
+
+	// The request is handled, a new one can be made by annotating again
+	obj := &appsv1alpha1.NIMBuild{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(nimBuild), obj); err != nil {
+		return err
+	}
+	delete(obj.Annotations, appsv1alpha1.NIMBuildRebuildAnnotation)
+	if err := r.Update(ctx, obj); err != nil {
+		return err
+	}
+	delete(nimBuild.Annotations, appsv1alpha1.NIMBuildRebuildAnnotation)
+	return nil
+}
+
 func (r *NIMBuildReconciler) reconcileNIMBuild(ctx context.Context, nimBuild *appsv1alpha1.NIMBuild) (reconcile.Result, error) {
 	logger := r.GetLogger()
+
+	// Build the engines again on request, without recreating the NIMBuild
+	if nimBuild.IsRebuildRequested() {
+		if err := r.rebuildNIMBuild(ctx, nimBuild); err != nil {
+			logger.Error(err, "unable to rebuild NIMBuild", "name", nimBuild.Name)
+			return ctrl.Result{}, err
+		}
+	}
+
 	nimCacheNamespacedName := types.NamespacedName{Name: nimBuild.Spec.NIMCache.Name, Namespace: nimBuild.GetNamespace()}
 
 	nimCache := &appsv1alpha1.NIMCache{}
@@ -373,78 +429,249 @@ func (r *NIMBuildReconciler) updateNIMBuildState(ctx context.Context, nimBuild *
 
 func (r *NIMBuildReconciler) reconcileEngineBuild(ctx context.Context, nimBuild *appsv1alpha1.NIMBuild, nimCache *appsv1alpha1.NIMCache) error {
 	logger := r.GetLogger()
-	var buildableProfile appsv1alpha1.NIMProfile
-	if nimBuild.Spec.NIMCache.Profile == "" {
-		buildableProfiles := getBuildableProfiles(nimCache)
-		if len(buildableProfiles) > 0 {
-			switch {
-			case len(buildableProfiles) > 1:
-				logger.Info("Multiple buildable profiles found", "Profiles", buildableProfiles)
-				conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionMultipleBuildableProfilesFound, metav1.ConditionTrue, "MultipleBuildableProfilesFound", "Multiple buildable profiles found in NIM Cache, please select one profile to build")
+
+	// The engine builds are resolved once, as the NIMCache profiles change with the built engines
+	if len(nimBuild.Status.Builds) == 0 {
+		if nimBuild.IsBuildMatrixEnabled() {
+			builds, err := getBuildMatrix(nimBuild, nimCache)
+			if err != nil {
+				logger.Info("No buildable profiles found for the build matrix", "error", err.Error())
+				conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionNoBuildableProfilesFound, metav1.ConditionTrue, "NoBuildableProfilesFound", err.Error())
 				return r.updateNIMBuildState(ctx, nimBuild, appsv1alpha1.NimBuildStatusFailed)
-			case len(buildableProfiles) == 1:
-				logger.Info("Selected buildable profile found", "Profile", buildableProfiles[0].Name)
-				conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionSingleBuildableProfilesFound, metav1.ConditionTrue, "BuildableProfileFound", "Single buildable profile cached in NIM Cache")
-				buildableProfile = buildableProfiles[0]
-				nimBuild.Status.InputProfile = buildableProfile
-			default:
-				logger.Info("No buildable profiles found, skipping engine build")
-				conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionNoBuildableProfilesFound, metav1.ConditionTrue, "NoBuildableProfilesFound", "No buildable profiles found in NIM Cache")
+			}
+			logger.Info("Build matrix expanded", "builds", len(builds))
+			nimBuild.Status.Builds = builds
+		} else {
+			buildableProfile := r.selectBuildableProfile(nimBuild, nimCache)
+			if buildableProfile == nil {
 				return r.updateNIMBuildState(ctx, nimBuild, appsv1alpha1.NimBuildStatusFailed)
 			}
+			nimBuild.Status.InputProfile = *buildableProfile
+			nimBuild.Status.Builds = []appsv1alpha1.NIMBuildEngineStatus{getEngineBuild(nimBuild, *buildableProfile)}
+		}
+	}
 
-		} else {
-			logger.Info("No buildable profiles found, skipping engine build")
-			conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionNoBuildableProfilesFound, metav1.ConditionTrue, "NoBuildableProfilesFound", "No buildable profiles found for NIM Cache")
-			return r.updateNIMBuildState(ctx, nimBuild, appsv1alpha1.NimBuildStatusFailed)
+	// Update the engine builds from their jobs
+	for i := range nimBuild.Status.Builds {
+		build := &nimBuild.Status.Builds[i]
+		if build.State == appsv1alpha1.NimBuildStatusReady || build.State == appsv1alpha1.NimBuildStatusFailed {
+			continue
 		}
-	} else {
-		foundProfile := getBuildableProfileByName(nimCache, nimBuild.Spec.NIMCache.Profile)
-		if foundProfile == nil {
-			logger.Info("No buildable profiles found", "ProfileName", nimBuild.Spec.NIMCache.Profile)
-			conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionNoBuildableProfilesFound, metav1.ConditionTrue, "NoBuildableProfilesFound", "No buildable profiles found, please select a valid profile from the NIM cache")
-			return r.updateNIMBuildState(ctx, nimBuild, appsv1alpha1.NimBuildStatusFailed)
-		} else {
-			logger.Info("Selected buildable profile found", "Profile", foundProfile.Name)
-			conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionSingleBuildableProfilesFound, metav1.ConditionTrue, "BuildableProfileFound", "Single buildable profile found")
-			buildableProfile = *foundProfile
-			nimBuild.Status.InputProfile = buildableProfile
+		job := &batchv1.Job{}
+		err := r.Get(ctx, types.NamespacedName{Name: nimBuild.GetBuildJobName(build.Name), Namespace: nimBuild.GetNamespace()}, job)
+		if err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			// Build the engine again if its job is gone before completion
+			build.State = appsv1alpha1.NimBuildStatusPending
+			continue
+		}
+		if err := r.reconcileEngineBuildJobStatus(ctx, nimBuild, build, job); err != nil {
+			return err
 		}
 	}
-
-	pod := &corev1.Pod{}
-	podName := types.NamespacedName{Name: nimBuild.GetEngineBuildPodName(), Namespace: nimBuild.GetNamespace()}
-	err := r.Get(ctx, podName, pod)
-	if err != nil && client.IgnoreNotFound(err) != nil {
-		return err
+	nimBuild.Status.Retries = 0
+	for _, build := range nimBuild.Status.Builds {
+		nimBuild.Status.Retries += build.Retries
 	}
-	// If pod does not exist and caching is not complete, create a new one
-	if err != nil && !meta.IsStatusConditionTrue(nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCreated) {
-		pod, err = r.constructEngineBuildPod(nimBuild, nimCache, r.orchestratorType, buildableProfile)
+
+	// Start the pending engine builds, up to the maximum number of concurrent builds
+	inProgress := countEngineBuilds(nimBuild.Status.Builds, appsv1alpha1.NimBuildStatusInProgress)
+	for i := range nimBuild.Status.Builds {
+		build := &nimBuild.Status.Builds[i]
+		if inProgress >= nimBuild.GetMaxConcurrentBuilds() {
+			break
+		}
+		if build.State != appsv1alpha1.NimBuildStatusPending {
+			continue
+		}
+
+		inputProfile := getBuildableProfileByName(nimCache, build.InputProfile)
+		if inputProfile == nil {
+			return fmt.Errorf("input profile %s of engine build %s is not a buildable profile of the NIM cache", build.InputProfile, build.Name)
+		}
+		pod, err := r.constructEngineBuildPod(nimBuild, nimCache, r.orchestratorType, *inputProfile, *build)
 		if err != nil {
 			logger.Error(err, "Failed to construct job")
 			return err
 		}
-		if err := controllerutil.SetControllerReference(nimBuild, pod, r.GetScheme()); err != nil {
+		job := constructEngineBuildJob(nimBuild, *build, pod)
+		if err := controllerutil.SetControllerReference(nimBuild, job, r.GetScheme()); err != nil {
 			return err
 		}
 
-		err = r.createPod(ctx, pod)
-		if err != nil {
-			logger.Error(err, "failed to create", "pod", pod.Name)
+		err = r.Create(ctx, job)
+		if err != nil && !errors.IsAlreadyExists(err) {
+			logger.Error(err, "failed to create", "job", job.Name)
 			return err
 		}
 
-		logger.Info("Created pod for NIM Cache engine build", "pod", podName)
-		conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCreated, metav1.ConditionTrue, "EngineBuildPodCreated", "The pod to build engine has been created")
+		logger.Info("Created job for NIM Cache engine build", "job", job.Name)
+		conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCreated, metav1.ConditionTrue, "EngineBuildJobCreated", "The job to build engine has been created")
+		build.State = appsv1alpha1.NimBuildStatusInProgress
+		inProgress++
+	}
+
+	builds := len(nimBuild.Status.Builds)
+	ready := countEngineBuilds(nimBuild.Status.Builds, appsv1alpha1.NimBuildStatusReady)
+	failed := countEngineBuilds(nimBuild.Status.Builds, appsv1alpha1.NimBuildStatusFailed)
+	switch {
+	case ready+failed < builds:
+		conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodPending, metav1.ConditionTrue, "JobRunning",
+			fmt.Sprintf("The jobs to build engines are in progress, %d of %d engines built", ready, builds))
+		return r.updateNIMBuildState(ctx, nimBuild, appsv1alpha1.NimBuildStatusInProgress)
+	case ready == 0:
+		conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCompleted, metav1.ConditionFalse, "JobFailed",
+			fmt.Sprintf("The jobs to build engines have failed: %s", nimBuild.Status.LastFailureReason))
+		return r.updateNIMBuildState(ctx, nimBuild, appsv1alpha1.NimBuildStatusFailed)
+	default:
+		conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCompleted, metav1.ConditionTrue, "JobCompleted",
+			fmt.Sprintf("The jobs to build engines have completed, %d of %d engines built", ready, builds))
 		return r.updateNIMBuildState(ctx, nimBuild, appsv1alpha1.NimBuildStatusInProgress)
 	}
+}
 
-	// Reconcile the job status
-	if err := r.reconcileEngineBuildPodStatus(ctx, nimBuild, pod); err != nil {
-		return err
+// selectBuildableProfile returns the buildable profile of the NIMCache to build a single engine from,
+// or nil if no profile can be selected, the NIMBuild conditions being updated accordingly.
+func (r *NIMBuildReconciler) selectBuildableProfile(nimBuild *appsv1alpha1.NIMBuild, nimCache *appsv1alpha1.NIMCache) *appsv1alpha1.NIMProfile {
+	logger := r.GetLogger()
+	if nimBuild.Spec.NIMCache.Profile == "" {
+		buildableProfiles := getBuildableProfiles(nimCache)
+		switch {
+		case len(buildableProfiles) > 1:
+			logger.Info("Multiple buildable profiles found", "Profiles", buildableProfiles)
+			conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionMultipleBuildableProfilesFound, metav1.ConditionTrue, "MultipleBuildableProfilesFound", "Multiple buildable profiles found in NIM Cache, please select one profile to build or use a build matrix to build them all")
+			return nil
+		case len(buildableProfiles) == 1:
+			logger.Info("Selected buildable profile found", "Profile", buildableProfiles[0].Name)
+			conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionSingleBuildableProfilesFound, metav1.ConditionTrue, "BuildableProfileFound", "Single buildable profile cached in NIM Cache")
+			return &buildableProfiles[0]
+		default:
+			logger.Info("No buildable profiles found, skipping engine build")
+			conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionNoBuildableProfilesFound, metav1.ConditionTrue, "NoBuildableProfilesFound", "No buildable profiles found in NIM Cache")
+			return nil
+		}
 	}
-	return nil
+
+	foundProfile := getBuildableProfileByName(nimCache, nimBuild.Spec.NIMCache.Profile)
+	if foundProfile == nil {
+		logger.Info("No buildable profiles found", "ProfileName", nimBuild.Spec.NIMCache.Profile)
+		conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionNoBuildableProfilesFound, metav1.ConditionTrue, "NoBuildableProfilesFound", "No buildable profiles found, please select a valid profile from the NIM cache")
+		return nil
+	}
+	logger.Info("Selected buildable profile found", "Profile", foundProfile.Name)
+	conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionSingleBuildableProfilesFound, metav1.ConditionTrue, "BuildableProfileFound", "Single buildable profile found")
+	return foundProfile
+}
+
+// getEngineBuild returns the engine build of a NIMBuild building a single engine from the given profile.
+func getEngineBuild(nimBuild *appsv1alpha1.NIMBuild, profile appsv1alpha1.NIMProfile) appsv1alpha1.NIMBuildEngineStatus {
+	tensorParallelism, _ := utils.GetTensorParallelismByProfileTags(profile.Config)
+	return appsv1alpha1.NIMBuildEngineStatus{
+		Name:              nimBuild.Name,
+		InputProfile:      profile.Name,
+		TensorParallelism: tensorParallelism,
+		ModelName:         nimBuild.GetModelName(),
+		State:             appsv1alpha1.NimBuildStatusPending,
+	}
+}
+
+// getBuildMatrix expands the build matrix of the NIMBuild into an engine build per combination
+// of its profiles, tensor parallel sizes and GPU products.
+func getBuildMatrix(nimBuild *appsv1alpha1.NIMBuild, nimCache *appsv1alpha1.NIMCache) ([]appsv1alpha1.NIMBuildEngineStatus, error) {
+	matrix := nimBuild.Spec.BuildMatrix
+
+	var profiles []appsv1alpha1.NIMProfile
+	profileNames := matrix.Profiles
+	if len(profileNames) == 0 && nimBuild.Spec.NIMCache.Profile != "" {
+		profileNames = []string{nimBuild.Spec.NIMCache.Profile}
+	}
+	if len(profileNames) == 0 {
+		profiles = getBuildableProfiles(nimCache)
+		if len(profiles) == 0 {
+			return nil, fmt.Errorf("no buildable profiles found in NIM Cache")
+		}
+	}
+	for _, name := range uniqueValues(profileNames) {
+		profile := getBuildableProfileByName(nimCache, name)
+		if profile == nil {
+			return nil, fmt.Errorf("profile %s of the build matrix is not a buildable profile of the NIM cache", name)
+		}
+		profiles = append(profiles, *profile)
+	}
+
+	var tensorParallelisms []string
+	for _, tp := range matrix.TensorParallelism {
+		tensorParallelisms = append(tensorParallelisms, fmt.Sprintf("%d", tp))
+	}
+	tensorParallelisms = uniqueValues(tensorParallelisms)
+
+	gpuProducts := uniqueValues(matrix.GPUProducts)
+	if len(gpuProducts) == 0 {
+		gpuProducts = []string{""}
+	}
+
+	var builds []appsv1alpha1.NIMBuildEngineStatus
+	for _, profile := range profiles {
+		profileTensorParallelisms := tensorParallelisms
+		if len(profileTensorParallelisms) == 0 {
+			tp, _ := utils.GetTensorParallelismByProfileTags(profile.Config)
+			profileTensorParallelisms = []string{tp}
+		}
+
+		for _, tp := range profileTensorParallelisms {
+			for _, gpuProduct := range gpuProducts {
+				// Name the engines after their cell of the matrix
+				modelName := nimBuild.GetModelName()
+				if len(profiles) > 1 {
+					modelName = fmt.Sprintf("%s-%s", modelName, profile.Name[:min(8, len(profile.Name))])
+				}
+				if tp != "" {
+					modelName = fmt.Sprintf("%s-tp%s", modelName, tp)
+				}
+				if gpuProduct != "" {
+					modelName = fmt.Sprintf("%s-%s", modelName, strings.ToLower(gpuProduct))
+				}
+				// The built profiles are looked up by model name
+				if slices.ContainsFunc(builds, func(build appsv1alpha1.NIMBuildEngineStatus) bool { return build.ModelName == modelName }) {
+					modelName = fmt.Sprintf("%s-%d", modelName, len(builds))
+				}
+
+				builds = append(builds, appsv1alpha1.NIMBuildEngineStatus{
+					Name:              fmt.Sprintf("%s-%d", nimBuild.Name, len(builds)),
+					InputProfile:      profile.Name,
+					TensorParallelism: tp,
+					GPUProduct:        gpuProduct,
+					ModelName:         modelName,
+					State:             appsv1alpha1.NimBuildStatusPending,
+				})
+			}
+		}
+	}
+	return builds, nil
+}
+
+// uniqueValues returns the given values without duplicates, in order.
+func uniqueValues(values []string) []string {
+	var unique []string
+	for _, value := range values {
+		if !slices.Contains(unique, value) {
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+// countEngineBuilds returns the number of engine builds in the given state.
+func countEngineBuilds(builds []appsv1alpha1.NIMBuildEngineStatus, state string) int {
+	count := 0
+	for _, build := range builds {
+		if build.State == state {
+			count++
+		}
+	}
+	return count
 }
 
 func getBuildableProfiles(cache *appsv1alpha1.NIMCache) []appsv1alpha1.NIMProfile {
@@ -466,34 +693,102 @@ func getBuildableProfileByName(cache *appsv1alpha1.NIMCache, profileName string)
 	return nil
 }
 
-func (r *NIMBuildReconciler) reconcileEngineBuildPodStatus(ctx context.Context, nimBuild *appsv1alpha1.NIMBuild, pod *corev1.Pod) error {
+func (r *NIMBuildReconciler) reconcileEngineBuildJobStatus(ctx context.Context, nimBuild *appsv1alpha1.NIMBuild, build *appsv1alpha1.NIMBuildEngineStatus, job *batchv1.Job) error {
 	logger := log.FromContext(ctx)
-	podName := pod.Name
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(job.Namespace), client.MatchingLabels{NIMBuildEngineBuildLabelKey: build.Name}); err != nil {
+		return err
+	}
+
+	// Record the reason of a new failed attempt, retried by the job
+	failureReason := getEngineBuildFailureReason(pods.Items)
+	if job.Status.Failed > build.Retries {
+		build.Retries = job.Status.Failed
+		if failureReason != "" {
+			nimBuild.Status.LastFailureReason = fmt.Sprintf("engine build %s failed: %s", build.Name, failureReason)
+		}
+	}
 
 	switch {
-	case isPodReady(pod) && !meta.IsStatusConditionTrue(nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCompleted):
-		logger.Info("Pod Ready", "pod", podName)
-		conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCompleted, metav1.ConditionTrue, "PodReady", "The Pod to build engine has successfully completed")
-		if err := r.deletePod(ctx, pod); err != nil {
-			logger.Error(err, "Unable to delete NIM Cache build engine pod", "Name", pod.Name)
+	case isEngineBuildJobReady(job, pods.Items):
+		logger.Info("Engine built, build job ready", "job", job.Name)
+		build.State = appsv1alpha1.NimBuildStatusReady
+		if err := r.deleteJob(ctx, job); err != nil {
+			logger.Error(err, "Unable to delete NIM Cache build engine job", "Name", job.Name)
 			return err
 		}
 
-	case pod.Status.Phase == corev1.PodFailed && !meta.IsStatusConditionTrue(nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCompleted):
-		logger.Info("Failed to cache NIM, build pod failed", "pod", pod)
-		conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCompleted, metav1.ConditionFalse, "PodFailed", "The pod to build engine has failed")
-		return r.updateNIMBuildState(ctx, nimBuild, appsv1alpha1.NimBuildStatusFailed)
+	case getJobCondition(job, batchv1.JobFailed) != nil:
+		cond := getJobCondition(job, batchv1.JobFailed)
+		logger.Info("Failed to build engine, build job failed", "job", job.Name, "reason", cond.Reason)
+		build.State = appsv1alpha1.NimBuildStatusFailed
+		reason := cond.Reason
+		if failureReason != "" {
+			reason = fmt.Sprintf("%s, last failed attempt: %s", cond.Reason, failureReason)
+		}
+		nimBuild.Status.LastFailureReason = fmt.Sprintf("engine build %s failed: %s", build.Name, reason)
 
-	case !isPodReady(pod) && !meta.IsStatusConditionTrue(nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCompleted):
-		logger.Info("Caching NIM is in progress, build engine pod running", "job", podName)
-		conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodPending, metav1.ConditionTrue, "PodRunning", "The Pod to build engine is in progress")
-		return r.updateNIMBuildState(ctx, nimBuild, appsv1alpha1.NimBuildStatusInProgress)
+	default:
+		logger.Info("Building engine is in progress, build engine job running", "job", job.Name)
+		build.State = appsv1alpha1.NimBuildStatusInProgress
+	}
+
+	return nil
+}
 
+// isEngineBuildJobReady returns true if the engine build job has a ready pod, serving the built engine.
+func isEngineBuildJobReady(job *batchv1.Job, pods []corev1.Pod) bool {
+	if job.Status.Ready != nil && *job.Status.Ready > 0 {
+		return true
 	}
+	return slices.ContainsFunc(pods, func(pod corev1.Pod) bool {
+		return isPodReady(&pod)
+	})
+}
 
+// getJobCondition returns the condition of the given type of the job if true, else nil.
+func getJobCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) *batchv1.JobCondition {
+	for i := range job.Status.Conditions {
+		if job.Status.Conditions[i].Type == conditionType && job.Status.Conditions[i].Status == corev1.ConditionTrue {
+			return &job.Status.Conditions[i]
+		}
+	}
 	return nil
 }
 
+// getEngineBuildFailureReason returns the reason of the last failed pod of an engine build, if any.
+func getEngineBuildFailureReason(pods []corev1.Pod) string {
+	var lastFailed *corev1.Pod
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase != corev1.PodFailed {
+			continue
+		}
+		if lastFailed == nil || lastFailed.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			lastFailed = pod
+		}
+	}
+	if lastFailed == nil {
+		return ""
+	}
+
+	for _, status := range lastFailed.Status.ContainerStatuses {
+		if status.Name != NIMBuildContainerName || status.State.Terminated == nil {
+			continue
+		}
+		reason := status.State.Terminated.Reason
+		if reason == "" {
+			reason = "Error"
+		}
+		return fmt.Sprintf("%s (exit code %d)", reason, status.State.Terminated.ExitCode)
+	}
+	if lastFailed.Status.Reason != "" {
+		return lastFailed.Status.Reason
+	}
+	return "PodFailed"
+}
+
 func (r *NIMBuildReconciler) updateNIMBuildStatus(ctx context.Context, nimBuild *appsv1alpha1.NIMBuild) error {
 	logger := r.GetLogger()
 
@@ -513,13 +808,14 @@ func (r *NIMBuildReconciler) updateNIMBuildStatus(ctx context.Context, nimBuild
 	})
 }
 
-func (r *NIMBuildReconciler) constructEngineBuildPod(nimBuild *appsv1alpha1.NIMBuild, nimCache *appsv1alpha1.NIMCache, platformType k8sutil.OrchestratorType, inputNimProfile appsv1alpha1.NIMProfile) (*corev1.Pod, error) {
+func (r *NIMBuildReconciler) constructEngineBuildPod(nimBuild *appsv1alpha1.NIMBuild, nimCache *appsv1alpha1.NIMCache, platformType k8sutil.OrchestratorType, inputNimProfile appsv1alpha1.NIMProfile, build appsv1alpha1.NIMBuildEngineStatus) (*corev1.Pod, error) {
 	logger := r.GetLogger()
 	pvcName := shared.GetPVCName(nimCache, nimCache.Spec.Storage.PVC)
 	labels := map[string]string{
 		"app":                          "k8s-nim-operator",
 		"app.kubernetes.io/name":       nimBuild.Name,
 		"app.kubernetes.io/managed-by": "k8s-nim-operator",
+		NIMBuildEngineBuildLabelKey:    build.Name,
 	}
 
 	if nimBuild.GetLabels() != nil {
@@ -533,41 +829,54 @@ func (r *NIMBuildReconciler) constructEngineBuildPod(nimBuild *appsv1alpha1.NIMB
 		annotations = utils.MergeMaps(annotations, nimBuild.GetAnnotations())
 	}
 
-	// Get tensorParallelism from the profile
-	tensorParallelism, err := utils.GetTensorParallelismByProfileTags(inputNimProfile.Config)
+	// Get tensorParallelism from the profile, unless set by the build matrix
+	profileTensorParallelism, err := utils.GetTensorParallelismByProfileTags(inputNimProfile.Config)
 	if err != nil {
 		logger.Error(err, "Failed to retrieve tensorParallelism")
 		return nil, err
 	}
-
-	// Ensure Resources, Requests, and Limits are initialized
-	if nimBuild.Spec.Resources == nil {
-		nimBuild.Spec.Resources = &appsv1alpha1.ResourceRequirements{}
-	}
-	if nimBuild.Spec.Resources.Requests == nil {
-		nimBuild.Spec.Resources.Requests = corev1.ResourceList{}
+	tensorParallelism := build.TensorParallelism
+	if tensorParallelism == "" {
+		tensorParallelism = profileTensorParallelism
 	}
-	if nimBuild.Spec.Resources.Limits == nil {
-		nimBuild.Spec.Resources.Limits = corev1.ResourceList{}
+
+	// Initialize Requests and Limits, the resources of the spec being shared by the engine builds
+	requests := corev1.ResourceList{}
+	limits := corev1.ResourceList{}
+	if nimBuild.Spec.Resources != nil {
+		requests = nimBuild.Spec.Resources.Requests.DeepCopy()
+		limits = nimBuild.Spec.Resources.Limits.DeepCopy()
+		if requests == nil {
+			requests = corev1.ResourceList{}
+		}
+		if limits == nil {
+			limits = corev1.ResourceList{}
+		}
 	}
 	if tensorParallelism == "" {
-		if _, present := nimBuild.Spec.Resources.Requests["nvidia.com/gpu"]; !present {
+		if _, present := requests["nvidia.com/gpu"]; !present {
 			return nil, fmt.Errorf("tensorParallelism is not set in the profile tags or resources")
 		}
 	} else {
-		if _, present := nimBuild.Spec.Resources.Requests["nvidia.com/gpu"]; !present {
+		if _, present := requests["nvidia.com/gpu"]; !present {
 			gpuQuantity, err := apiResource.ParseQuantity(tensorParallelism)
 			if err != nil {
 				return nil, fmt.Errorf("failed to parse tensorParallelism: %w", err)
 			}
-			nimBuild.Spec.Resources.Requests["nvidia.com/gpu"] = gpuQuantity
-			nimBuild.Spec.Resources.Limits["nvidia.com/gpu"] = gpuQuantity
+			requests["nvidia.com/gpu"] = gpuQuantity
+			limits["nvidia.com/gpu"] = gpuQuantity
 
 		} else {
 			return nil, fmt.Errorf("tensorParallelism is set in the profile tags, but nvidia.com/gpu is already set in resources")
 		}
 	}
 
+	// Build the engine on the GPU product of the build matrix
+	nodeSelector := nimBuild.GetNodeSelectors()
+	if build.GPUProduct != "" {
+		nodeSelector = utils.MergeMaps(map[string]string{"nvidia.com/gpu.product": build.GPUProduct}, nodeSelector)
+	}
+
 	if platformType == k8sutil.OpenShift {
 		annotations["openshift.io/scc"] = "nonroot"
 	}
@@ -579,7 +888,6 @@ func (r *NIMBuildReconciler) constructEngineBuildPod(nimBuild *appsv1alpha1.NIMB
 
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        nimBuild.GetEngineBuildPodName(),
 			Namespace:   nimBuild.Namespace,
 			Labels:      labels,
 			Annotations: annotations,
@@ -605,7 +913,7 @@ func (r *NIMBuildReconciler) constructEngineBuildPod(nimBuild *appsv1alpha1.NIMB
 			},
 			ImagePullSecrets: imagePullSecrets,
 			Tolerations:      nimBuild.GetTolerations(),
-			NodeSelector:     nimBuild.GetNodeSelectors(),
+			NodeSelector:     nodeSelector,
 		},
 	}
 
@@ -635,7 +943,7 @@ func (r *NIMBuildReconciler) constructEngineBuildPod(nimBuild *appsv1alpha1.NIMB
 				},
 				{
 					Name:  "NIM_CUSTOM_MODEL_NAME",
-					Value: nimBuild.GetModelName(),
+					Value: build.ModelName,
 				},
 				{
 					Name:  "NIM_MODEL_PROFILE",
@@ -660,7 +968,7 @@ func (r *NIMBuildReconciler) constructEngineBuildPod(nimBuild *appsv1alpha1.NIMB
 					SubPath:   nimCache.Spec.Storage.PVC.SubPath,
 				},
 			},
-			Resources:                corev1.ResourceRequirements{Limits: nimBuild.Spec.Resources.Limits, Requests: nimBuild.Spec.Resources.Requests},
+			Resources:                corev1.ResourceRequirements{Limits: limits, Requests: requests},
 			TerminationMessagePath:   "/dev/termination-log",
 			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 			Ports: []corev1.ContainerPort{{
@@ -719,6 +1027,16 @@ func (r *NIMBuildReconciler) constructEngineBuildPod(nimBuild *appsv1alpha1.NIMB
 		},
 	}
 
+	// Build the engine for the tensor parallel size of the build matrix
+	if tensorParallelism != profileTensorParallelism {
+		pod.Spec.Containers[0].Env = utils.MergeEnvVars(pod.Spec.Containers[0].Env, []corev1.EnvVar{
+			{
+				Name:  "NIM_TENSOR_PARALLEL_SIZE",
+				Value: tensorParallelism,
+			},
+		})
+	}
+
 	// Merge env with the user provided values
 	pod.Spec.Containers[0].Env = utils.MergeEnvVars(pod.Spec.Containers[0].Env, nimBuild.Spec.Env)
 
@@ -744,11 +1062,67 @@ func isPodReady(pod *corev1.Pod) bool {
 	return false
 }
 
-func (r *NIMBuildReconciler) deletePod(ctx context.Context, pod *corev1.Pod) error {
+// constructEngineBuildJob returns the job running the engine build pod, retried and timed out per the build policy of the NIMBuild.
+func constructEngineBuildJob(nimBuild *appsv1alpha1.NIMBuild, build appsv1alpha1.NIMBuildEngineStatus, pod *corev1.Pod) *batchv1.Job {
+	// Disruptions are retried without being counted, the other failures count towards the backoff limit
+	rules := []batchv1.PodFailurePolicyRule{
+		{
+			Action: batchv1.PodFailurePolicyActionIgnore,
+			OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{
+				{
+					Type:   corev1.DisruptionTarget,
+					Status: corev1.ConditionTrue,
+				},
+			},
+		},
+	}
+
+	// User errors, and OOMs unless retried, fail the build right away
+	exitCodes := slices.Clone(nimBuild.GetNonRetryableExitCodes())
+	if !nimBuild.IsRetryOnOOMEnabled() {
+		exitCodes = append(exitCodes, oomKilledExitCode)
+	}
+	slices.Sort(exitCodes)
+	exitCodes = slices.Compact(exitCodes)
+	if len(exitCodes) > 0 {
+		rules = append(rules, batchv1.PodFailurePolicyRule{
+			Action: batchv1.PodFailurePolicyActionFailJob,
+			OnExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+				ContainerName: ptr.To(NIMBuildContainerName),
+				Operator:      batchv1.PodFailurePolicyOnExitCodesOpIn,
+				Values:        exitCodes,
+			},
+		})
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nimBuild.GetBuildJobName(build.Name),
+			Namespace: nimBuild.Namespace,
+			Labels:    pod.Labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          ptr.To(nimBuild.GetBackoffLimit()),
+			ActiveDeadlineSeconds: nimBuild.GetActiveDeadlineSeconds(),
+			PodFailurePolicy: &batchv1.PodFailurePolicy{
+				Rules: rules,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      pod.Labels,
+					Annotations: pod.Annotations,
+				},
+				Spec: pod.Spec,
+			},
+		},
+	}
+}
+
+func (r *NIMBuildReconciler) deleteJob(ctx context.Context, job *batchv1.Job) error {
 	logger := log.FromContext(ctx)
-	logger.Info("Deleting Engine Build Pod", "name", pod.Name, "namespace", pod.Namespace)
-	if err := r.Delete(ctx, pod); err != nil {
-		logger.Error(err, "Failed to Delete Engine Build Pod", "name", pod.Name)
+	logger.Info("Deleting Engine Build Job", "name", job.Name, "namespace", job.Namespace)
+	if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		logger.Error(err, "Failed to Delete Engine Build Job", "name", job.Name)
 		return err
 	}
 	return nil
@@ -831,27 +1205,47 @@ func (r *NIMBuildReconciler) reconcileLocalModelManifest(ctx context.Context, ni
 		return err
 	}
 
+	// NIMBuilds built before the engine builds were tracked have built a single engine
+	if len(nimBuild.Status.Builds) == 0 {
+		build := getEngineBuild(nimBuild, nimBuild.Status.InputProfile)
+		build.State = appsv1alpha1.NimBuildStatusReady
+		nimBuild.Status.Builds = []appsv1alpha1.NIMBuildEngineStatus{build}
+	}
+
 	// To Do: Explore changing the profile list on NIMCache to a map for faster lookups
-	// Update the NIMCache status with the details of the built profile
-	builtProfileName := getBuiltProfileName(manifest, nimBuild)
-	if builtProfileName != "" {
+	// Update the NIMCache status with the details of the built profiles
+	nimBuild.Status.OutputProfiles = nil
+	for i := range nimBuild.Status.Builds {
+		build := &nimBuild.Status.Builds[i]
+		if build.State != appsv1alpha1.NimBuildStatusReady {
+			continue
+		}
+		builtProfileName := getBuiltProfileName(manifest, build.ModelName)
+		if builtProfileName == "" {
+			logger.Info("Built profile not found in the local model manifest", "modelName", build.ModelName)
+			continue
+		}
 		builtProfile := appsv1alpha1.NIMProfile{
 			Name:    builtProfileName,
 			Model:   manifest.GetProfileModel(builtProfileName),
 			Config:  manifest.GetProfileTags(builtProfileName),
 			Release: manifest.GetProfileRelease(builtProfileName),
 		}
-		nimBuild.Status.OutputProfile = builtProfile
+		build.OutputProfile = builtProfileName
+		nimBuild.Status.OutputProfiles = append(nimBuild.Status.OutputProfiles, builtProfile)
 
 		presentOnNIMCache := isBuiltProfilePresentOnNIMCacheStatus(nimCache, builtProfileName)
 		// If built profile is not present on NIMCache status, add it
 		if !presentOnNIMCache {
 			tagsMap := manifest.GetProfileTags(builtProfileName)
-			tagsMap["input_profile"] = nimBuild.Status.InputProfile.Name
+			tagsMap["input_profile"] = build.InputProfile
 			logger.Info("Adding profile to NIMCache status", "profileName", builtProfileName)
 			nimCache.Status.Profiles = append(nimCache.Status.Profiles, builtProfile)
 		}
 	}
+	if len(nimBuild.Status.OutputProfiles) > 0 {
+		nimBuild.Status.OutputProfile = nimBuild.Status.OutputProfiles[0]
+	}
 
 	// Update the NIMCache status with the new profiles
 	obj := &appsv1alpha1.NIMCache{}
@@ -865,8 +1259,11 @@ func (r *NIMBuildReconciler) reconcileLocalModelManifest(ctx context.Context, ni
 		logger.Error(err, "Failed to update status", "NIMCache", nimCache.Name)
 		return err
 	}
-	// Update the NIMBuild status
+	// Update the NIMBuild status, failed if any of its engines could not be built
 	conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionModelManifestPodCompleted, metav1.ConditionTrue, "PodCompleted", "The Pod to read local model manifest is completed")
+	if countEngineBuilds(nimBuild.Status.Builds, appsv1alpha1.NimBuildStatusFailed) > 0 {
+		return r.updateNIMBuildState(ctx, nimBuild, appsv1alpha1.NimBuildStatusFailed)
+	}
 	return r.updateNIMBuildState(ctx, nimBuild, appsv1alpha1.NimBuildStatusReady)
 }
 
@@ -879,11 +1276,11 @@ func isBuiltProfilePresentOnNIMCacheStatus(nimCache *appsv1alpha1.NIMCache, buil
 	return false
 }
 
-// getBuiltProfileName retrieves the auto generated profile name assigned for the built engine.
-func getBuiltProfileName(manifest nimparser.NIMManifestInterface, nimBuild *appsv1alpha1.NIMBuild) string {
+// getBuiltProfileName retrieves the auto generated profile name assigned for the engine built with the given model name.
+func getBuiltProfileName(manifest nimparser.NIMManifestInterface, modelName string) string {
 	for _, profileName := range manifest.GetProfilesList() {
 		tagsMap := manifest.GetProfileTags(profileName)
-		if tagsMap["model_name"] == nimBuild.GetModelName() {
+		if tagsMap["model_name"] == modelName {
 			return profileName
 		}
 	}
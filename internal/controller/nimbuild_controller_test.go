@@ -22,6 +22,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -54,10 +55,12 @@ var _ = Describe("NIMBuild Controller", func() {
 		scheme = runtime.NewScheme()
 		Expect(appsv1alpha1.AddToScheme(scheme)).To(Succeed())
 		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
 
 		cli = fake.NewClientBuilder().WithScheme(scheme).
 			WithStatusSubresource(&appsv1alpha1.NIMBuild{}).
 			WithStatusSubresource(&appsv1alpha1.NIMCache{}).
+			WithStatusSubresource(&batchv1.Job{}).
 			WithStatusSubresource(&corev1.Pod{}).
 			WithStatusSubresource(&corev1.ConfigMap{}).
 			Build()
@@ -233,7 +236,7 @@ var _ = Describe("NIMBuild Controller", func() {
 			Expect(cli.Create(ctx, nimCache)).To(Succeed())
 		})
 
-		It("should create engine build pod when no profile specified", func() {
+		It("should create engine build job when no profile specified", func() {
 			nimBuild := &appsv1alpha1.NIMBuild{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-nimbuild",
@@ -261,16 +264,18 @@ var _ = Describe("NIMBuild Controller", func() {
 			})
 			Expect(err).ToNot(HaveOccurred())
 
-			// Check if pod was created
-			pod := &corev1.Pod{}
-			podName := types.NamespacedName{
-				Name:      nimBuild.GetEngineBuildPodName(),
+			// Check if job was created
+			job := &batchv1.Job{}
+			jobName := types.NamespacedName{
+				Name:      nimBuild.GetEngineBuildJobName(),
 				Namespace: nimBuild.Namespace,
 			}
-			Expect(cli.Get(ctx, podName, pod)).To(Succeed())
-			Expect(pod.Spec.Containers).To(HaveLen(1))
-			Expect(pod.Spec.Containers[0].Name).To(Equal(NIMBuildContainerName))
-			Expect(pod.Spec.Containers[0].Image).To(Equal(nimBuild.GetImage()))
+			Expect(cli.Get(ctx, jobName, job)).To(Succeed())
+			Expect(job.Spec.BackoffLimit).To(Equal(ptr.To[int32](3)))
+			Expect(job.Spec.Template.Labels).To(HaveKeyWithValue(NIMBuildEngineBuildLabelKey, nimBuild.Name))
+			Expect(job.Spec.Template.Spec.Containers).To(HaveLen(1))
+			Expect(job.Spec.Template.Spec.Containers[0].Name).To(Equal(NIMBuildContainerName))
+			Expect(job.Spec.Template.Spec.Containers[0].Image).To(Equal(nimBuild.GetImage()))
 
 			// Check status
 			updatedNIMBuild := &appsv1alpha1.NIMBuild{}
@@ -368,11 +373,25 @@ var _ = Describe("NIMBuild Controller", func() {
 		})
 	})
 
-	Context("When engine build pod is running", func() {
+	Context("When engine build job is running", func() {
 		var nimBuild *appsv1alpha1.NIMBuild
 		var nimCache *appsv1alpha1.NIMCache
+		var job *batchv1.Job
 		var pod *corev1.Pod
 
+		reconcileNIMBuild := func() *appsv1alpha1.NIMBuild {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      nimBuild.Name,
+					Namespace: nimBuild.Namespace,
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			updatedNIMBuild := &appsv1alpha1.NIMBuild{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: nimBuild.Name, Namespace: nimBuild.Namespace}, updatedNIMBuild)).To(Succeed())
+			return updatedNIMBuild
+		}
+
 		BeforeEach(func() {
 			nimCache = &appsv1alpha1.NIMCache{
 				ObjectMeta: metav1.ObjectMeta{
@@ -433,10 +452,31 @@ var _ = Describe("NIMBuild Controller", func() {
 			}
 			Expect(cli.Create(ctx, nimBuild)).To(Succeed())
 
+			job = &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      nimBuild.GetEngineBuildJobName(),
+					Namespace: nimBuild.Namespace,
+				},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:  NIMBuildContainerName,
+									Image: nimBuild.GetImage(),
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(cli.Create(ctx, job)).To(Succeed())
+
 			pod = &corev1.Pod{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      nimBuild.GetEngineBuildPodName(),
+					Name:      nimBuild.GetEngineBuildJobName() + "-abcde",
 					Namespace: nimBuild.Namespace,
+					Labels:    map[string]string{NIMBuildEngineBuildLabelKey: nimBuild.Name},
 				},
 				Spec: corev1.PodSpec{
 					Containers: []corev1.Container{
@@ -458,59 +498,120 @@ var _ = Describe("NIMBuild Controller", func() {
 			}
 			Expect(cli.Create(ctx, pod)).To(Succeed())
 
-			// Set condition that pod was created
-			conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCreated, metav1.ConditionTrue, "PodCreated", "Pod created")
+			// Set condition that job was created
+			conditions.UpdateCondition(&nimBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCreated, metav1.ConditionTrue, "JobCreated", "Job created")
 			Expect(cli.Status().Update(ctx, nimBuild)).To(Succeed())
 		})
 
-		It("should update status to in progress when pod is running", func() {
-			// Reconcile
-			_, err := reconciler.Reconcile(ctx, reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name:      nimBuild.Name,
-					Namespace: nimBuild.Namespace,
-				},
-			})
-			Expect(err).ToNot(HaveOccurred())
-
-			// Check status
-			updatedNIMBuild := &appsv1alpha1.NIMBuild{}
-			Expect(cli.Get(ctx, types.NamespacedName{Name: nimBuild.Name, Namespace: nimBuild.Namespace}, updatedNIMBuild)).To(Succeed())
+		It("should update status to in progress when job is running", func() {
+			updatedNIMBuild := reconcileNIMBuild()
 			Expect(updatedNIMBuild.Status.State).To(Equal(appsv1alpha1.NimBuildStatusInProgress))
 			Expect(meta.IsStatusConditionTrue(updatedNIMBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodPending)).To(BeTrue())
 		})
 
-		It("should update status to failed when pod fails", func() {
-			// Update pod to failed state
+		It("should record the retries and the reason of the last failed attempt", func() {
+			// The first attempt is OOM killed and retried by the job
 			pod.Status.Phase = corev1.PodFailed
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+				{
+					Name: NIMBuildContainerName,
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+					},
+				},
+			}
 			Expect(cli.Status().Update(ctx, pod)).To(Succeed())
+			job.Status.Failed = 1
+			Expect(cli.Status().Update(ctx, job)).To(Succeed())
 
-			// Reconcile
-			_, err := reconciler.Reconcile(ctx, reconcile.Request{
-				NamespacedName: types.NamespacedName{
-					Name:      nimBuild.Name,
-					Namespace: nimBuild.Namespace,
+			updatedNIMBuild := reconcileNIMBuild()
+			Expect(updatedNIMBuild.Status.State).To(Equal(appsv1alpha1.NimBuildStatusInProgress))
+			Expect(updatedNIMBuild.Status.Retries).To(Equal(int32(1)))
+			Expect(updatedNIMBuild.Status.Builds[0].Retries).To(Equal(int32(1)))
+			Expect(updatedNIMBuild.Status.LastFailureReason).To(Equal("engine build test-nimbuild failed: OOMKilled (exit code 137)"))
+		})
+
+		It("should update status to failed when job fails", func() {
+			pod.Status.Phase = corev1.PodFailed
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+				{
+					Name: NIMBuildContainerName,
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: 2},
+					},
 				},
-			})
-			Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(cli.Status().Update(ctx, pod)).To(Succeed())
+			job.Status.Failed = 1
+			job.Status.Conditions = []batchv1.JobCondition{
+				{
+					Type:   batchv1.JobFailed,
+					Status: corev1.ConditionTrue,
+					Reason: batchv1.JobReasonPodFailurePolicy,
+				},
+			}
+			Expect(cli.Status().Update(ctx, job)).To(Succeed())
 
-			// Check status
-			updatedNIMBuild := &appsv1alpha1.NIMBuild{}
-			Expect(cli.Get(ctx, types.NamespacedName{Name: nimBuild.Name, Namespace: nimBuild.Namespace}, updatedNIMBuild)).To(Succeed())
+			updatedNIMBuild := reconcileNIMBuild()
 			Expect(updatedNIMBuild.Status.State).To(Equal(appsv1alpha1.NimBuildStatusFailed))
+			Expect(updatedNIMBuild.Status.LastFailureReason).To(Equal("engine build test-nimbuild failed: PodFailurePolicy, last failed attempt: Error (exit code 2)"))
+			Expect(meta.IsStatusConditionFalse(updatedNIMBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCompleted)).To(BeTrue())
+		})
+
+		It("should complete when job is ready", func() {
+			job.Status.Ready = ptr.To[int32](1)
+			Expect(cli.Status().Update(ctx, job)).To(Succeed())
+
+			updatedNIMBuild := reconcileNIMBuild()
+			Expect(meta.IsStatusConditionTrue(updatedNIMBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCompleted)).To(BeTrue())
+
+			// Check if job was deleted
+			deletedJob := &batchv1.Job{}
+			err := cli.Get(ctx, types.NamespacedName{Name: job.Name, Namespace: job.Namespace}, deletedJob)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
 		})
 
-		It("should complete when pod is ready", func() {
-			// Update pod to ready state
-			pod.Status.Conditions = []corev1.PodCondition{
+		It("should build the engine again when a rebuild is requested", func() {
+			// The GPUs of the engine build are set by the resources of the NIMBuild
+			nimCache.Status.Profiles[0].Config = map[string]string{"trtllm_buildable": "true"}
+			Expect(cli.Status().Update(ctx, nimCache)).To(Succeed())
+
+			job.Status.Failed = 4
+			job.Status.Conditions = []batchv1.JobCondition{
 				{
-					Type:   corev1.PodReady,
+					Type:   batchv1.JobFailed,
 					Status: corev1.ConditionTrue,
+					Reason: batchv1.JobReasonBackoffLimitExceeded,
 				},
 			}
-			Expect(cli.Status().Update(ctx, pod)).To(Succeed())
+			Expect(cli.Status().Update(ctx, job)).To(Succeed())
+			updatedNIMBuild := reconcileNIMBuild()
+			Expect(updatedNIMBuild.Status.State).To(Equal(appsv1alpha1.NimBuildStatusFailed))
 
-			// Reconcile
+			updatedNIMBuild.Annotations = map[string]string{appsv1alpha1.NIMBuildRebuildAnnotation: "true"}
+			Expect(cli.Update(ctx, updatedNIMBuild)).To(Succeed())
+
+			updatedNIMBuild = reconcileNIMBuild()
+			Expect(updatedNIMBuild.Annotations).NotTo(HaveKey(appsv1alpha1.NIMBuildRebuildAnnotation))
+			Expect(updatedNIMBuild.Status.State).To(Equal(appsv1alpha1.NimBuildStatusInProgress))
+			Expect(updatedNIMBuild.Status.Retries).To(BeZero())
+			Expect(updatedNIMBuild.Status.LastFailureReason).To(BeEmpty())
+			Expect(updatedNIMBuild.Status.Builds).To(HaveLen(1))
+			Expect(updatedNIMBuild.Status.Builds[0].State).To(Equal(appsv1alpha1.NimBuildStatusInProgress))
+
+			// The failed job is replaced by a new one
+			newJob := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: job.Name, Namespace: job.Namespace}, newJob)).To(Succeed())
+			Expect(newJob.Status.Failed).To(BeZero())
+			Expect(newJob.Spec.PodFailurePolicy).NotTo(BeNil())
+		})
+	})
+
+	Context("When building a build matrix", func() {
+		var nimCache *appsv1alpha1.NIMCache
+		var nimBuild *appsv1alpha1.NIMBuild
+
+		reconcileNIMBuild := func() *appsv1alpha1.NIMBuild {
 			_, err := reconciler.Reconcile(ctx, reconcile.Request{
 				NamespacedName: types.NamespacedName{
 					Name:      nimBuild.Name,
@@ -518,16 +619,122 @@ var _ = Describe("NIMBuild Controller", func() {
 				},
 			})
 			Expect(err).ToNot(HaveOccurred())
-
-			// Check status
 			updatedNIMBuild := &appsv1alpha1.NIMBuild{}
 			Expect(cli.Get(ctx, types.NamespacedName{Name: nimBuild.Name, Namespace: nimBuild.Namespace}, updatedNIMBuild)).To(Succeed())
-			Expect(meta.IsStatusConditionTrue(updatedNIMBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCompleted)).To(BeTrue())
+			return updatedNIMBuild
+		}
 
-			// Check if pod was deleted
-			deletedPod := &corev1.Pod{}
-			err = cli.Get(ctx, types.NamespacedName{Name: pod.Name, Namespace: pod.Namespace}, deletedPod)
-			Expect(errors.IsNotFound(err)).To(BeTrue())
+		BeforeEach(func() {
+			nimCache = &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{
+						NGC: &appsv1alpha1.NGCSource{
+							ModelPuller: "nvcr.io/nim/test",
+							AuthSecret:  "my-secret",
+						},
+					},
+					Storage: appsv1alpha1.NIMCacheStorage{
+						PVC: appsv1alpha1.PersistentVolumeClaim{
+							Create:       ptr.To[bool](true),
+							StorageClass: "standard",
+							Size:         "1Gi",
+						},
+					},
+				},
+				Status: appsv1alpha1.NIMCacheStatus{
+					State: appsv1alpha1.NimCacheStatusReady,
+					Profiles: []appsv1alpha1.NIMProfile{
+						{
+							Name:   "6e2a5b1f0c9d",
+							Model:  "test-model",
+							Config: map[string]string{"trtllm_buildable": "true", "tp": "1"},
+						},
+						{
+							Name:   "d41f3c07ab52",
+							Model:  "test-model",
+							Config: map[string]string{"trtllm_buildable": "true", "tp": "1"},
+						},
+					},
+				},
+			}
+			Expect(cli.Create(ctx, nimCache)).To(Succeed())
+
+			nimBuild = &appsv1alpha1.NIMBuild{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimbuild",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMBuildSpec{
+					NIMCache: appsv1alpha1.NIMCacheReference{
+						Name: nimCache.Name,
+					},
+					ModelName: "llama",
+					BuildMatrix: &appsv1alpha1.NIMBuildMatrix{
+						TensorParallelism:   []int32{1, 2},
+						GPUProducts:         []string{"NVIDIA-H100-80GB-HBM3"},
+						MaxConcurrentBuilds: 2,
+					},
+					NodeSelector: map[string]string{"pool": "build"},
+					Image: appsv1alpha1.Image{
+						Repository: "nvcr.io/nim/test",
+						Tag:        "latest",
+					},
+				},
+			}
+		})
+
+		It("should build an engine per cell up to the maximum number of concurrent builds", func() {
+			Expect(cli.Create(ctx, nimBuild)).To(Succeed())
+
+			updatedNIMBuild := reconcileNIMBuild()
+			Expect(updatedNIMBuild.Status.State).To(Equal(appsv1alpha1.NimBuildStatusInProgress))
+			Expect(updatedNIMBuild.Status.Builds).To(Equal([]appsv1alpha1.NIMBuildEngineStatus{
+				{Name: "test-nimbuild-0", InputProfile: "6e2a5b1f0c9d", TensorParallelism: "1", GPUProduct: "NVIDIA-H100-80GB-HBM3", ModelName: "llama-6e2a5b1f-tp1-nvidia-h100-80gb-hbm3", State: appsv1alpha1.NimBuildStatusInProgress},
+				{Name: "test-nimbuild-1", InputProfile: "6e2a5b1f0c9d", TensorParallelism: "2", GPUProduct: "NVIDIA-H100-80GB-HBM3", ModelName: "llama-6e2a5b1f-tp2-nvidia-h100-80gb-hbm3", State: appsv1alpha1.NimBuildStatusInProgress},
+				{Name: "test-nimbuild-2", InputProfile: "d41f3c07ab52", TensorParallelism: "1", GPUProduct: "NVIDIA-H100-80GB-HBM3", ModelName: "llama-d41f3c07-tp1-nvidia-h100-80gb-hbm3", State: appsv1alpha1.NimBuildStatusPending},
+				{Name: "test-nimbuild-3", InputProfile: "d41f3c07ab52", TensorParallelism: "2", GPUProduct: "NVIDIA-H100-80GB-HBM3", ModelName: "llama-d41f3c07-tp2-nvidia-h100-80gb-hbm3", State: appsv1alpha1.NimBuildStatusPending},
+			}))
+
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: nimBuild.GetBuildJobName("test-nimbuild-1"), Namespace: "default"}, job)).To(Succeed())
+			pod := job.Spec.Template
+			Expect(pod.Labels).To(HaveKeyWithValue(NIMBuildEngineBuildLabelKey, "test-nimbuild-1"))
+			Expect(pod.Spec.NodeSelector).To(Equal(map[string]string{"pool": "build", "nvidia.com/gpu.product": "NVIDIA-H100-80GB-HBM3"}))
+			Expect(pod.Spec.Containers[0].Resources.Requests).To(HaveKeyWithValue(corev1.ResourceName("nvidia.com/gpu"), resource.MustParse("2")))
+			Expect(pod.Spec.Containers[0].Env).To(ContainElements(
+				corev1.EnvVar{Name: "NIM_MODEL_PROFILE", Value: "6e2a5b1f0c9d"},
+				corev1.EnvVar{Name: "NIM_TENSOR_PARALLEL_SIZE", Value: "2"},
+				corev1.EnvVar{Name: "NIM_CUSTOM_MODEL_NAME", Value: "llama-6e2a5b1f-tp2-nvidia-h100-80gb-hbm3"},
+			))
+			Expect(cli.Get(ctx, types.NamespacedName{Name: nimBuild.GetBuildJobName("test-nimbuild-2"), Namespace: "default"}, job)).ToNot(Succeed())
+
+			// The next engine is built once an engine is built
+			Expect(cli.Get(ctx, types.NamespacedName{Name: nimBuild.GetBuildJobName("test-nimbuild-0"), Namespace: "default"}, job)).To(Succeed())
+			Expect(job.Spec.Template.Spec.Containers[0].Env).ToNot(ContainElement(HaveField("Name", "NIM_TENSOR_PARALLEL_SIZE")))
+			job.Status.Ready = ptr.To[int32](1)
+			Expect(cli.Status().Update(ctx, job)).To(Succeed())
+
+			updatedNIMBuild = reconcileNIMBuild()
+			Expect(updatedNIMBuild.Status.Builds[0].State).To(Equal(appsv1alpha1.NimBuildStatusReady))
+			Expect(updatedNIMBuild.Status.Builds[2].State).To(Equal(appsv1alpha1.NimBuildStatusInProgress))
+			Expect(updatedNIMBuild.Status.Builds[3].State).To(Equal(appsv1alpha1.NimBuildStatusPending))
+			Expect(errors.IsNotFound(cli.Get(ctx, types.NamespacedName{Name: nimBuild.GetBuildJobName("test-nimbuild-0"), Namespace: "default"}, job))).To(BeTrue())
+			Expect(cli.Get(ctx, types.NamespacedName{Name: nimBuild.GetBuildJobName("test-nimbuild-2"), Namespace: "default"}, job)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(updatedNIMBuild.Status.Conditions, appsv1alpha1.NimBuildConditionEngineBuildPodCompleted)).To(BeFalse())
+		})
+
+		It("should fail when a profile of the build matrix is not buildable", func() {
+			nimBuild.Spec.BuildMatrix.Profiles = []string{"6e2a5b1f0c9d", "unknown-profile"}
+			Expect(cli.Create(ctx, nimBuild)).To(Succeed())
+
+			updatedNIMBuild := reconcileNIMBuild()
+			Expect(updatedNIMBuild.Status.State).To(Equal(appsv1alpha1.NimBuildStatusFailed))
+			Expect(updatedNIMBuild.Status.Builds).To(BeEmpty())
+			Expect(meta.IsStatusConditionTrue(updatedNIMBuild.Status.Conditions, appsv1alpha1.NimBuildConditionNoBuildableProfilesFound)).To(BeTrue())
 		})
 	})
 
@@ -646,15 +853,51 @@ var _ = Describe("NIMBuild Controller", func() {
 				Config: map[string]string{"tp": "8"},
 			}
 
-			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, nimProfile)
+			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, nimProfile, getEngineBuild(nimBuild, nimProfile))
 			Expect(err).ToNot(HaveOccurred())
-			Expect(pod.Name).To(Equal(nimBuild.GetEngineBuildPodName()))
 			Expect(pod.Namespace).To(Equal(nimBuild.Namespace))
 			Expect(pod.Spec.Containers).To(HaveLen(1))
 			Expect(pod.Spec.Containers[0].Name).To(Equal(NIMBuildContainerName))
 			Expect(pod.Spec.Containers[0].Image).To(Equal(nimBuild.GetImage()))
 			Expect(pod.Spec.Volumes).To(HaveLen(1))
 			Expect(pod.Spec.Volumes[0].Name).To(Equal("nim-cache-volume"))
+
+			job := constructEngineBuildJob(nimBuild, getEngineBuild(nimBuild, nimProfile), pod)
+			Expect(job.Name).To(Equal(nimBuild.GetEngineBuildJobName()))
+			Expect(job.Spec.Template.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+			Expect(job.Spec.ActiveDeadlineSeconds).To(BeNil())
+			Expect(job.Spec.PodFailurePolicy.Rules).To(HaveLen(1))
+			Expect(job.Spec.PodFailurePolicy.Rules[0].Action).To(Equal(batchv1.PodFailurePolicyActionIgnore))
+		})
+
+		It("should construct engine build job from the build policy", func() {
+			nimBuild := &appsv1alpha1.NIMBuild{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimbuild",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMBuildSpec{
+					BuildPolicy: &appsv1alpha1.NIMBuildPolicy{
+						BackoffLimit:          ptr.To[int32](5),
+						ActiveDeadlineSeconds: ptr.To[int64](7200),
+						RetryOnOOM:            ptr.To(false),
+						NonRetryableExitCodes: []int32{2, 1, 2},
+					},
+				},
+			}
+
+			job := constructEngineBuildJob(nimBuild, appsv1alpha1.NIMBuildEngineStatus{Name: "test-nimbuild-0"}, &corev1.Pod{})
+			Expect(job.Name).To(Equal("test-nimbuild-0-engine-build-job"))
+			Expect(job.Spec.BackoffLimit).To(Equal(ptr.To[int32](5)))
+			Expect(job.Spec.ActiveDeadlineSeconds).To(Equal(ptr.To[int64](7200)))
+			Expect(job.Spec.PodFailurePolicy.Rules).To(HaveLen(2))
+			Expect(job.Spec.PodFailurePolicy.Rules[0].OnPodConditions).To(ConsistOf(batchv1.PodFailurePolicyOnPodConditionsPattern{
+				Type:   corev1.DisruptionTarget,
+				Status: corev1.ConditionTrue,
+			}))
+			Expect(job.Spec.PodFailurePolicy.Rules[1].Action).To(Equal(batchv1.PodFailurePolicyActionFailJob))
+			Expect(job.Spec.PodFailurePolicy.Rules[1].OnExitCodes.ContainerName).To(Equal(ptr.To(NIMBuildContainerName)))
+			Expect(job.Spec.PodFailurePolicy.Rules[1].OnExitCodes.Values).To(Equal([]int32{1, 2, 137}))
 		})
 	})
 
@@ -722,7 +965,7 @@ var _ = Describe("NIMBuild Controller", func() {
 			}
 
 			// Test pod construction with default environment variables
-			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, nimCache.Status.Profiles[0])
+			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, nimCache.Status.Profiles[0], getEngineBuild(nimBuild, nimCache.Status.Profiles[0]))
 			Expect(err).ToNot(HaveOccurred())
 
 			// Check that default environment variables are set
@@ -804,7 +1047,7 @@ var _ = Describe("NIMBuild Controller", func() {
 			}
 
 			// Test pod construction with user-provided environment variables
-			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, nimCache.Status.Profiles[0])
+			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, nimCache.Status.Profiles[0], getEngineBuild(nimBuild, nimCache.Status.Profiles[0]))
 			Expect(err).ToNot(HaveOccurred())
 
 			// Check that all environment variables are present
@@ -881,7 +1124,7 @@ var _ = Describe("NIMBuild Controller", func() {
 			}
 
 			// Test pod construction with environment variables using valueFrom
-			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, nimCache.Status.Profiles[0])
+			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, nimCache.Status.Profiles[0], getEngineBuild(nimBuild, nimCache.Status.Profiles[0]))
 			Expect(err).ToNot(HaveOccurred())
 
 			// Check that environment variables with valueFrom are preserved
@@ -939,7 +1182,7 @@ var _ = Describe("NIMBuild Controller", func() {
 			}
 
 			// Test pod construction
-			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, nimCache.Status.Profiles[0])
+			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, nimCache.Status.Profiles[0], getEngineBuild(nimBuild, nimCache.Status.Profiles[0]))
 			Expect(err).ToNot(HaveOccurred())
 
 			// Check that both default and user environment variables are present
@@ -986,7 +1229,7 @@ var _ = Describe("NIMBuild Controller", func() {
 			}
 
 			// Test pod construction with empty environment variables
-			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, nimCache.Status.Profiles[0])
+			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, nimCache.Status.Profiles[0], getEngineBuild(nimBuild, nimCache.Status.Profiles[0]))
 			Expect(err).ToNot(HaveOccurred())
 
 			// Check that default environment variables are still set
@@ -1052,16 +1295,16 @@ var _ = Describe("NIMBuild Controller", func() {
 			})
 			Expect(err).ToNot(HaveOccurred())
 
-			// Check if pod was created with correct environment variables
-			pod := &corev1.Pod{}
-			podName := types.NamespacedName{
-				Name:      nimBuild.GetEngineBuildPodName(),
+			// Check if job was created with correct environment variables
+			job := &batchv1.Job{}
+			jobName := types.NamespacedName{
+				Name:      nimBuild.GetEngineBuildJobName(),
 				Namespace: nimBuild.Namespace,
 			}
-			Expect(cli.Get(ctx, podName, pod)).To(Succeed())
+			Expect(cli.Get(ctx, jobName, job)).To(Succeed())
 
-			// Verify environment variables in the created pod
-			envVars := pod.Spec.Containers[0].Env
+			// Verify environment variables in the created job
+			envVars := job.Spec.Template.Spec.Containers[0].Env
 			var buildVarFound, customModelPathFound, defaultCachePathFound bool
 			for _, env := range envVars {
 				switch env.Name {
@@ -1114,7 +1357,7 @@ var _ = Describe("NIMBuild Controller", func() {
 			}
 
 			// Test pod construction with LORA-enabled profile
-			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, loraProfile)
+			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, loraProfile, getEngineBuild(nimBuild, loraProfile))
 			Expect(err).ToNot(HaveOccurred())
 
 			// Check that NIM_PEFT_SOURCE environment variable is set
@@ -1163,7 +1406,7 @@ var _ = Describe("NIMBuild Controller", func() {
 			}
 
 			// Test pod construction with LORA-disabled profile
-			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, noLoraProfile)
+			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, noLoraProfile, getEngineBuild(nimBuild, noLoraProfile))
 			Expect(err).ToNot(HaveOccurred())
 
 			// Check that NIM_PEFT_SOURCE environment variable is NOT set
@@ -1206,7 +1449,7 @@ var _ = Describe("NIMBuild Controller", func() {
 			}
 
 			// Test pod construction with profile missing LORA config
-			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, noLoraConfigProfile)
+			pod, err := reconciler.constructEngineBuildPod(nimBuild, nimCache, k8sutil.K8s, noLoraConfigProfile, getEngineBuild(nimBuild, noLoraConfigProfile))
 			Expect(err).ToNot(HaveOccurred())
 
 			// Check that NIM_PEFT_SOURCE environment variable is NOT set
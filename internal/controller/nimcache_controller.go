@@ -105,6 +105,7 @@ func NewNIMCacheReconciler(client client.Client, scheme *runtime.Scheme, log log
 // +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimcaches,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimcaches/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimcaches/finalizers,verbs=update
+// +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimservices;nimbuilds,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,verbs=use,resourceNames=nonroot
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;delete
@@ -265,6 +266,11 @@ func (r *NIMCacheReconciler) SetupWithManager(mgr ctrl.Manager) error {
 							return true
 						}
 
+						// Handle verify requests
+						if !oldNIMCache.IsVerifyRequested() && newNIMCache.IsVerifyRequested() {
+							return true
+						}
+
 						// Handle only spec updates
 						return !reflect.DeepEqual(oldNIMCache.Spec, newNIMCache.Spec)
 					}
@@ -499,6 +505,11 @@ func (r *NIMCacheReconciler) reconcileServiceAccount(ctx context.Context, nimCac
 
 func (r *NIMCacheReconciler) reconcilePVC(ctx context.Context, nimCache *appsv1alpha1.NIMCache) error {
 	logger := r.GetLogger()
+	// The model is staged in an emptyDir when caching into an object store without a PVC
+	if nimCache.IsObjectStoreEnabled() && !nimCache.IsPVCEnabled() {
+		return nil
+	}
+
 	pvcName := shared.GetPVCName(nimCache, nimCache.Spec.Storage.PVC)
 	pvcNamespacedName := types.NamespacedName{Name: pvcName, Namespace: nimCache.GetNamespace()}
 	pvc := &corev1.PersistentVolumeClaim{}
@@ -599,65 +610,77 @@ func (r *NIMCacheReconciler) reconcileModelManifest(ctx context.Context, nimCach
 	// Create a configmap by extracting the model manifest
 	// Create a temporary pod for parsing model manifest
 	pod := constructPodSpec(nimCache, r.orchestratorType)
+	manifest, requeue, err := r.extractManifestFromPod(ctx, nimCache, pod)
+	if err != nil || requeue {
+		return requeue, err
+	}
+
+	// Create a ConfigMap with the model manifest file for re-use
+	err = r.createManifestConfigMap(ctx, nimCache, getManifestConfigName(nimCache), &manifest)
+	if err != nil {
+		logger.Error(err, "Failed to create model manifest config map")
+		return false, err
+	}
+	return false, nil
+}
+
+// extractManifestFromPod runs the given pod to extract the model manifest from the NIM container.
+// It returns requeue until the manifest is available, and cleans up the pod once the manifest is parsed.
+func (r *NIMCacheReconciler) extractManifestFromPod(ctx context.Context, nimCache *appsv1alpha1.NIMCache, pod *corev1.Pod) (manifest nimparser.NIMManifestInterface, requeue bool, err error) {
+	logger := r.GetLogger()
+
 	// Add nimCache as owner for watching on status change
 	if err := controllerutil.SetControllerReference(nimCache, pod, r.GetScheme()); err != nil {
-		return false, err
+		return nil, false, err
 	}
 	err = r.createPod(ctx, pod)
 	if err != nil {
 		logger.Error(err, "failed to create", "pod", pod.Name)
-		return false, err
+		return nil, false, err
 	}
 
 	existingPod := &corev1.Pod{}
 	err = r.Get(ctx, client.ObjectKey{Name: pod.Name, Namespace: nimCache.Namespace}, existingPod)
 	if err != nil {
 		logger.Error(err, "failed to get pod for model selection", "pod", pod.Name)
-		return false, err
+		return nil, false, err
 	}
 
 	if existingPod.Status.Phase != corev1.PodRunning {
 		// requeue request with delay until the pod is ready
-		return true, nil
+		return nil, true, nil
 	}
 
 	// Extract manifest file
 	output, err := k8sutil.GetPodLogs(ctx, existingPod, NIMCacheContainerName)
 	if err != nil {
 		logger.Error(err, "failed to get pod logs for parsing model manifest file", "pod", pod.Name)
-		return false, err
+		return nil, false, err
 	}
 
 	if output == "" {
 		logger.Info("Requeuing to wait for the manifest to be copied from the container")
-		return true, nil
+		return nil, true, nil
 	}
 
 	parser := nimparserutils.GetNIMParser([]byte(output))
 	// Parse the file
-	manifest, err := parser.ParseModelManifestFromRawOutput([]byte(output))
+	manifest, err = parser.ParseModelManifestFromRawOutput([]byte(output))
 	if err != nil {
 		logger.Error(err, "Failed to parse model manifest from the pod")
-		return false, err
+		return nil, false, err
 	}
 	logger.V(2).Info("manifest file", "nimcache", nimCache.Name, "manifest", manifest)
 
-	// Create a ConfigMap with the model manifest file for re-use
-	err = r.createManifestConfigMap(ctx, nimCache, &manifest)
-	if err != nil {
-		logger.Error(err, "Failed to create model manifest config map")
-		return false, err
-	}
-
 	// Model manifest is successfully extracted, cleanup temporary pod
 	err = r.Delete(ctx, existingPod)
 	if err != nil && !errors.IsNotFound(err) {
 		logger.Error(err, "failed to delete", "pod", pod.Name)
 		// requeue request with delay until the pod is cleaned up
 		// this is required as NIM containers are resource heavy
-		return true, err
+		return nil, true, err
 	}
-	return false, nil
+	return manifest, false, nil
 }
 
 func (r *NIMCacheReconciler) reconcileModelSelection(ctx context.Context, nimCache *appsv1alpha1.NIMCache) error {
@@ -665,17 +688,6 @@ func (r *NIMCacheReconciler) reconcileModelSelection(ctx context.Context, nimCac
 
 	// reconcile model selection pod
 	if isModelSelectionRequired(nimCache) && !isModelSelectionDone(nimCache) {
-		var discoveredGPUs []string
-		// If no specific GPUs are provided, then auto-detect GPUs in the cluster for profile selection
-		if len(nimCache.GetModelSpec().GPUs) == 0 {
-			gpusByNode, err := r.GetNodeGPUProducts(ctx)
-			if err != nil {
-				logger.Error(err, "Failed to get gpus in the cluster")
-				return err
-			}
-			discoveredGPUs = getUniqueGPUProducts(gpusByNode)
-		}
-
 		// Get the model manifest from the config
 		nimManifest, err := r.extractNIMManifest(ctx, getManifestConfigName(nimCache), nimCache.GetNamespace())
 		if err != nil {
@@ -683,9 +695,8 @@ func (r *NIMCacheReconciler) reconcileModelSelection(ctx context.Context, nimCac
 		}
 
 		// Match profiles with user input
-		profiles, err := nimManifest.MatchProfiles(nimCache.GetModelSpec(), discoveredGPUs)
+		profiles, err := r.matchProfiles(ctx, nimCache, nimManifest)
 		if err != nil {
-			logger.Error(err, "Failed to match profiles for given model parameters")
 			return err
 		}
 
@@ -705,6 +716,30 @@ func (r *NIMCacheReconciler) reconcileModelSelection(ctx context.Context, nimCac
 	return nil
 }
 
+// matchProfiles matches the profiles of the model manifest with the model parameters of the NIMCache,
+// auto-detecting the GPUs in the cluster when none are provided.
+func (r *NIMCacheReconciler) matchProfiles(ctx context.Context, nimCache *appsv1alpha1.NIMCache, nimManifest nimparser.NIMManifestInterface) ([]string, error) {
+	logger := r.GetLogger()
+
+	var discoveredGPUs []string
+	// If no specific GPUs are provided, then auto-detect GPUs in the cluster for profile selection
+	if len(nimCache.GetModelSpec().GPUs) == 0 {
+		gpusByNode, err := r.GetNodeGPUProducts(ctx)
+		if err != nil {
+			logger.Error(err, "Failed to get gpus in the cluster")
+			return nil, err
+		}
+		discoveredGPUs = getUniqueGPUProducts(gpusByNode)
+	}
+
+	profiles, err := nimManifest.MatchProfiles(nimCache.GetModelSpec(), discoveredGPUs)
+	if err != nil {
+		logger.Error(err, "Failed to match profiles for given model parameters")
+		return nil, err
+	}
+	return profiles, nil
+}
+
 func (r *NIMCacheReconciler) reconcileJob(ctx context.Context, nimCache *appsv1alpha1.NIMCache) error {
 	logger := r.GetLogger()
 
@@ -755,7 +790,12 @@ func (r *NIMCacheReconciler) reconcileJobStatus(ctx context.Context, nimCache *a
 		logger.Info("Job completed", "job", jobName)
 		conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionJobCompleted, metav1.ConditionTrue, "JobCompleted", "The Job to cache NIM has successfully completed")
 		nimCache.Status.State = appsv1alpha1.NimCacheStatusReady
-		nimCache.Status.PVC = shared.GetPVCName(nimCache, nimCache.Spec.Storage.PVC)
+		if nimCache.IsObjectStoreEnabled() {
+			nimCache.Status.ObjectStoreURI = nimCache.GetObjectStoreURI()
+		}
+		if !nimCache.IsObjectStoreEnabled() || nimCache.IsPVCEnabled() {
+			nimCache.Status.PVC = shared.GetPVCName(nimCache, nimCache.Spec.Storage.PVC)
+		}
 
 		selectedProfiles, err := getSelectedProfiles(nimCache)
 		if err != nil {
@@ -875,6 +915,30 @@ func (r *NIMCacheReconciler) reconcileNIMCache(ctx context.Context, nimCache *ap
 		return ctrl.Result{}, err
 	}
 
+	// Reconcile disk usage and checksums of the cached profiles
+	err = r.reconcileIntegrity(ctx, nimCache)
+	if err != nil {
+		logger.Error(err, "reconciliation of nimcache integrity failed", "job", getVerifyJobName(nimCache))
+		return ctrl.Result{}, err
+	}
+
+	// Reconcile refresh of the cached profiles against the upstream model manifest
+	result, err := r.reconcileRefresh(ctx, nimCache)
+	if err != nil {
+		logger.Error(err, "reconciliation of nimcache refresh failed")
+		return ctrl.Result{}, err
+	}
+
+	// Reconcile garbage collection of the cached profiles
+	retentionResult, err := r.reconcileRetention(ctx, nimCache)
+	if err != nil {
+		logger.Error(err, "reconciliation of nimcache retention failed")
+		return ctrl.Result{}, err
+	}
+	if retentionResult.RequeueAfter > 0 && (result.RequeueAfter == 0 || retentionResult.RequeueAfter < result.RequeueAfter) {
+		result = retentionResult
+	}
+
 	conditions.IfPresentUpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionReconcileFailed, metav1.ConditionFalse, "Reconciled", "")
 
 	err = r.updateNIMCacheStatus(ctx, nimCache)
@@ -882,7 +946,7 @@ func (r *NIMCacheReconciler) reconcileNIMCache(ctx context.Context, nimCache *ap
 		logger.Error(err, "Failed to update NIMCache status", "NIMCache", nimCache.Name)
 		return ctrl.Result{}, err
 	}
-	return ctrl.Result{}, nil
+	return result, nil
 }
 
 func (r *NIMCacheReconciler) updateNIMCacheStatus(ctx context.Context, nimCache *appsv1alpha1.NIMCache) error {
@@ -1271,6 +1335,155 @@ func (r *NIMCacheReconciler) constructJob(ctx context.Context, nimCache *appsv1a
 		job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, k8sutil.GetVolumesForUpdatingCaCert(nimCache.Spec.Proxy.CertConfigMap)...)
 
 	}
+
+	if nimCache.IsObjectStoreEnabled() {
+		addObjectStoreUploadContainer(nimCache, job)
+	}
+	return job, nil
+}
+
+// addObjectStoreUploadContainer runs the caching container as an init container staging the model,
+// followed by a container uploading the staged model to the object store.
+func addObjectStoreUploadContainer(nimCache *appsv1alpha1.NIMCache, job *batchv1.Job) {
+	objectStore := nimCache.Spec.Storage.ObjectStore
+	podSpec := &job.Spec.Template.Spec
+
+	if !nimCache.IsPVCEnabled() {
+		podSpec.Volumes[0].VolumeSource = corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		}
+	}
+
+	uploadContainer := corev1.Container{
+		Name:    NIMCacheContainerName + "-upload",
+		Image:   objectStore.Image,
+		Command: shared.ObjectStoreSyncCommand(objectStore, utils.DefaultModelStorePath, nimCache.GetObjectStoreURI()),
+		Env:     shared.GetObjectStoreEnv(objectStore),
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "nim-cache-volume",
+				MountPath: utils.DefaultModelStorePath,
+				SubPath:   nimCache.Spec.Storage.PVC.SubPath,
+			},
+		},
+		Resources:                podSpec.Containers[0].Resources,
+		TerminationMessagePath:   "/dev/termination-log",
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		SecurityContext:          podSpec.Containers[0].SecurityContext,
+	}
+	if nimCache.GetProxySpec() != nil {
+		uploadContainer.Env = utils.MergeEnvVars(uploadContainer.Env, nimCache.GetEnvWithProxy())
+		uploadContainer.VolumeMounts = append(uploadContainer.VolumeMounts, k8sutil.GetVolumesMountsForUpdatingCaCert()...)
+	}
+
+	podSpec.InitContainers = append(podSpec.InitContainers, podSpec.Containers[0])
+	podSpec.Containers = []corev1.Container{uploadContainer}
+	if objectStore.PullSecret != "" {
+		podSpec.ImagePullSecrets = append(podSpec.ImagePullSecrets, corev1.LocalObjectReference{Name: objectStore.PullSecret})
+	}
+}
+
+// constructCacheVolumeJob constructs a job running the given shell script with the model puller image against the NIM cache in the PVC.
+func (r *NIMCacheReconciler) constructCacheVolumeJob(nimCache *appsv1alpha1.NIMCache, name, script string, env []corev1.EnvVar) (*batchv1.Job, error) {
+	if nimCache.Spec.Source.NGC == nil {
+		return nil, fmt.Errorf("jobs against the NIM cache are only supported for NIMs from NGC")
+	}
+
+	labels := map[string]string{
+		"app":                          "k8s-nim-operator",
+		"app.kubernetes.io/name":       nimCache.Name,
+		"app.kubernetes.io/managed-by": "k8s-nim-operator",
+	}
+
+	annotations := map[string]string{
+		"sidecar.istio.io/inject": "false",
+	}
+	if r.orchestratorType == k8sutil.OpenShift {
+		annotations["openshift.io/scc"] = "nonroot"
+	}
+
+	securityContext := &corev1.PodSecurityContext{
+		RunAsUser:    nimCache.GetUserID(),
+		FSGroup:      nimCache.GetGroupID(),
+		RunAsNonRoot: ptr.To[bool](true),
+	}
+	// SeccompProfile must be set for TKGS
+	if r.orchestratorType == k8sutil.TKGS {
+		securityContext.SeccompProfile = &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		}
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: nimCache.Namespace,
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					RuntimeClassName: nimCache.GetRuntimeClassName(),
+					SecurityContext:  securityContext,
+					Containers: []corev1.Container{
+						{
+							Name:    NIMCacheContainerName,
+							Image:   nimCache.Spec.Source.NGC.ModelPuller,
+							Command: []string{"/bin/sh", "-c", script},
+							Env: append([]corev1.EnvVar{
+								{
+									Name:  "NIM_CACHE_PATH",
+									Value: utils.DefaultModelStorePath,
+								},
+							}, env...),
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "nim-cache-volume",
+									MountPath: utils.DefaultModelStorePath,
+									SubPath:   nimCache.Spec.Storage.PVC.SubPath,
+								},
+							},
+							TerminationMessagePath:   "/dev/termination-log",
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: ptr.To[bool](false),
+								Capabilities: &corev1.Capabilities{
+									Drop: []corev1.Capability{"ALL"},
+								},
+								RunAsNonRoot: ptr.To[bool](true),
+								RunAsGroup:   nimCache.GetGroupID(),
+								RunAsUser:    nimCache.GetUserID(),
+							},
+						},
+					},
+					RestartPolicy: corev1.RestartPolicyNever,
+					Volumes: []corev1.Volume{
+						{
+							Name: "nim-cache-volume",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: shared.GetPVCName(nimCache, nimCache.Spec.Storage.PVC),
+								},
+							},
+						},
+					},
+					ImagePullSecrets: []corev1.LocalObjectReference{
+						{
+							Name: nimCache.Spec.Source.NGC.PullSecret,
+						},
+					},
+					ServiceAccountName: NIMCacheServiceAccount,
+					Tolerations:        nimCache.GetTolerations(),
+					NodeSelector:       nimCache.GetNodeSelectors(),
+				},
+			},
+			// Failures are reported, the job is not retried
+			BackoffLimit: ptr.To[int32](0),
+		},
+	}
 	return job, nil
 }
 
@@ -1302,7 +1515,7 @@ func (r *NIMCacheReconciler) extractNIMManifest(ctx context.Context, configName,
 }
 
 // createManifestConfigMap creates a ConfigMap with the given model manifest data.
-func (r *NIMCacheReconciler) createManifestConfigMap(ctx context.Context, nimCache *appsv1alpha1.NIMCache, manifestData *nimparser.NIMManifestInterface) error {
+func (r *NIMCacheReconciler) createManifestConfigMap(ctx context.Context, nimCache *appsv1alpha1.NIMCache, name string, manifestData *nimparser.NIMManifestInterface) error {
 	// Convert manifestData to YAML
 	manifestBytes, err := yaml.Marshal(manifestData)
 	if err != nil {
@@ -1311,7 +1524,7 @@ func (r *NIMCacheReconciler) createManifestConfigMap(ctx context.Context, nimCac
 
 	configMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      getManifestConfigName(nimCache),
+			Name:      name,
 			Namespace: nimCache.GetNamespace(),
 			Labels: map[string]string{
 				"app": nimCache.GetName(),
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"path/filepath"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -28,6 +29,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -41,6 +43,7 @@ import (
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+	"github.com/NVIDIA/k8s-nim-operator/internal/nimparser"
 	nimparserv1 "github.com/NVIDIA/k8s-nim-operator/internal/nimparser/v1"
 	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
 )
@@ -85,7 +88,7 @@ var _ = Describe("NIMCache Controller", func() {
 		manifestData, err := nimparser.ParseModelManifest(filePath)
 		Expect(err).NotTo(HaveOccurred())
 
-		err = reconciler.createManifestConfigMap(context.TODO(), nimCache, &manifestData)
+		err = reconciler.createManifestConfigMap(context.TODO(), nimCache, getManifestConfigName(nimCache), &manifestData)
 		Expect(err).NotTo(HaveOccurred())
 
 		// Verify that the ConfigMap was created
@@ -692,7 +695,7 @@ var _ = Describe("NIMCache Controller", func() {
 			manifestData, err := nimparser.ParseModelManifest(filePath)
 			Expect(err).NotTo(HaveOccurred())
 
-			err = reconciler.createManifestConfigMap(ctx, nimCache, &manifestData)
+			err = reconciler.createManifestConfigMap(ctx, nimCache, getManifestConfigName(nimCache), &manifestData)
 			Expect(err).NotTo(HaveOccurred())
 
 			// Verify that the ConfigMap was created
@@ -808,6 +811,639 @@ var _ = Describe("NIMCache Controller", func() {
 				Name: "my-secret",
 			}))
 		})
+
+		It("should construct a job uploading the model to an object store", func() {
+			modelEndpoint := "https://api.ngc.nvidia.com/v2/models/nvidia/nim-llama2-7b/versions/1.0.0"
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{
+						NGC: &appsv1alpha1.NGCSource{
+							ModelPuller:   "nvcr.io/nim:test",
+							PullSecret:    "my-secret",
+							ModelEndpoint: &modelEndpoint,
+						},
+					},
+					Storage: appsv1alpha1.NIMCacheStorage{
+						ObjectStore: &appsv1alpha1.NIMCacheObjectStore{
+							Endpoint:    "http://minio.minio:9000",
+							Bucket:      "nim-cache",
+							Region:      "us-east-1",
+							Credentials: appsv1alpha1.ObjectStoreAccessKeys{SecretName: "minio-creds", AccessKeyIDKey: "accesskey", SecretAccessKeyKey: "secretkey"},
+							Image:       "amazon/aws-cli:test",
+							PullSecret:  "aws-cli-secret",
+						},
+					},
+				},
+			}
+
+			job, err := reconciler.constructJob(context.TODO(), nimCache, k8sutil.K8s)
+			Expect(err).ToNot(HaveOccurred())
+
+			podSpec := job.Spec.Template.Spec
+			Expect(podSpec.Volumes[0].Name).To(Equal("nim-cache-volume"))
+			Expect(podSpec.Volumes[0].EmptyDir).NotTo(BeNil())
+			Expect(podSpec.Volumes[0].PersistentVolumeClaim).To(BeNil())
+
+			// The model is staged by the caching container running as an init container
+			Expect(podSpec.InitContainers).To(HaveLen(1))
+			Expect(podSpec.InitContainers[0].Name).To(Equal(NIMCacheContainerName))
+			Expect(podSpec.InitContainers[0].Command).To(Equal([]string{"create-model-store"}))
+
+			Expect(podSpec.Containers).To(HaveLen(1))
+			container := podSpec.Containers[0]
+			Expect(container.Image).To(Equal("amazon/aws-cli:test"))
+			Expect(container.Command).To(Equal([]string{"aws", "s3", "sync", "/model-store", "s3://nim-cache/default/test-nimcache", "--endpoint-url", "http://minio.minio:9000", "--only-show-errors"}))
+			Expect(container.Env).To(ContainElements(
+				corev1.EnvVar{
+					Name: "AWS_ACCESS_KEY_ID",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "minio-creds"},
+							Key:                  "accesskey",
+						},
+					},
+				},
+				corev1.EnvVar{Name: "AWS_DEFAULT_REGION", Value: "us-east-1"},
+			))
+			Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      "nim-cache-volume",
+				MountPath: "/model-store",
+			}))
+			Expect(podSpec.ImagePullSecrets).To(ContainElements(
+				corev1.LocalObjectReference{Name: "my-secret"},
+				corev1.LocalObjectReference{Name: "aws-cli-secret"},
+			))
+		})
+
+		It("should not create a PVC and report the object store location for an object store NIMCache", func() {
+			ctx := context.TODO()
+			nimCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{ModelPuller: "nvcr.io/nim:test", PullSecret: "my-secret"}},
+					Storage: appsv1alpha1.NIMCacheStorage{
+						ObjectStore: &appsv1alpha1.NIMCacheObjectStore{
+							Endpoint:    "http://minio.minio:9000",
+							Bucket:      "nim-cache",
+							Prefix:      "/models/llama/",
+							Credentials: appsv1alpha1.ObjectStoreAccessKeys{SecretName: "minio-creds"},
+						},
+					},
+				},
+			}
+
+			Expect(reconciler.reconcilePVC(ctx, nimCache)).To(Succeed())
+			pvcList := &corev1.PersistentVolumeClaimList{}
+			Expect(cli.List(ctx, pvcList)).To(Succeed())
+			Expect(pvcList.Items).To(BeEmpty())
+
+			job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: getJobName(nimCache), Namespace: "default"}}
+			job.Status.Succeeded = 1
+			Expect(reconciler.reconcileJobStatus(ctx, nimCache, job)).To(Succeed())
+			Expect(nimCache.Status.State).To(Equal(appsv1alpha1.NimCacheStatusReady))
+			Expect(nimCache.Status.ObjectStoreURI).To(Equal("s3://nim-cache/models/llama"))
+			Expect(nimCache.Status.PVC).To(BeEmpty())
+		})
+	})
+
+	Context("When refreshing a NIMCache", func() {
+		const (
+			updatedProfile   = "03fdb4d11f01be10c31b00e7c0540e2835e89a0079b483ad2dd3c25c8cc29b61"
+			unchangedProfile = "04fdb4d11f01be10c31b00e7c0540e2835e89a0079b483ad2dd3c25c8cc12345"
+		)
+		var (
+			ctx      context.Context
+			nimCache *appsv1alpha1.NIMCache
+		)
+
+		BeforeEach(func() {
+			ctx = context.TODO()
+			nimCache = &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "refresh-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{
+						ModelPuller: "nvcr.io/nim:test",
+						PullSecret:  "my-secret",
+						Model:       &appsv1alpha1.ModelSpec{Profiles: []string{updatedProfile, unchangedProfile}},
+					}},
+					Storage: appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To[bool](true), StorageClass: "standard", Size: "1Gi"}},
+					Refresh: &appsv1alpha1.NIMCacheRefresh{Interval: &metav1.Duration{Duration: time.Hour}},
+				},
+			}
+
+			nimparser := nimparserv1.NIMParser{}
+			cachedManifest, err := nimparser.ParseModelManifest(filepath.Join("testdata", "manifest_trtllm.yaml"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reconciler.createManifestConfigMap(ctx, nimCache, getManifestConfigName(nimCache), &cachedManifest)).To(Succeed())
+
+			// Cannot run a sample NIM container, create the manifest extracted upstream with a new release of a profile
+			upstreamManifest, err := nimparser.ParseModelManifest(filepath.Join("testdata", "manifest_trtllm.yaml"))
+			Expect(err).NotTo(HaveOccurred())
+			profiles := upstreamManifest.(nimparserv1.NIMManifest)
+			profile := profiles[updatedProfile]
+			profile.Release = "1.1.0"
+			profiles[updatedProfile] = profile
+			Expect(reconciler.createManifestConfigMap(ctx, nimCache, getRefreshManifestConfigName(nimCache), &upstreamManifest)).To(Succeed())
+
+			nimCache.Status = appsv1alpha1.NIMCacheStatus{
+				State:           appsv1alpha1.NimCacheStatusReady,
+				Profiles:        getNIMProfiles(cachedManifest, []string{updatedProfile, unchangedProfile}),
+				LastRefreshTime: &metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
+			}
+		})
+
+		It("should report the profiles changed upstream", func() {
+			result, err := reconciler.reconcileRefresh(ctx, nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			Expect(nimCache.Status.UpdatedProfiles).To(Equal([]string{updatedProfile}))
+			Expect(time.Since(nimCache.Status.LastRefreshTime.Time)).To(BeNumerically("<", time.Minute))
+			cond := meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionUpdateAvailable)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(ReasonUpdateAvailable))
+
+			// Without auto update, no job is created and the upstream manifest is discarded
+			err = cli.Get(ctx, types.NamespacedName{Name: getRefreshJobName(nimCache), Namespace: "default"}, &batchv1.Job{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = cli.Get(ctx, types.NamespacedName{Name: getRefreshManifestConfigName(nimCache), Namespace: "default"}, &corev1.ConfigMap{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			// The next check is scheduled after the interval
+			result, err = reconciler.reconcileRefresh(ctx, nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+		})
+
+		It("should cache only the profiles changed upstream with auto update", func() {
+			nimCache.Spec.Refresh.AutoUpdate = true
+			_, err := reconciler.reconcileRefresh(ctx, nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			cond := meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionUpdateAvailable)
+			Expect(cond.Reason).To(Equal(ReasonUpdating))
+
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: getRefreshJobName(nimCache), Namespace: "default"}, job)).To(Succeed())
+			Expect(job.Spec.Template.Spec.Containers[0].Args).To(Equal([]string{"--profiles", updatedProfile}))
+			Expect(job.Spec.Template.Spec.Containers[0].ImagePullPolicy).To(Equal(corev1.PullAlways))
+
+			job.Status.Succeeded = 1
+			Expect(cli.Status().Update(ctx, job)).To(Succeed())
+			_, err = reconciler.reconcileRefresh(ctx, nimCache)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(nimCache.Status.UpdatedProfiles).To(BeEmpty())
+			Expect(nimCache.Status.Profiles).To(HaveLen(2))
+			for _, profile := range nimCache.Status.Profiles {
+				if profile.Name == updatedProfile {
+					Expect(profile.Release).To(Equal("1.1.0"))
+				} else {
+					Expect(profile.Release).To(Equal("1.0.0"))
+				}
+			}
+			cond = meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionUpdateAvailable)
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(ReasonUpdated))
+
+			// The upstream manifest replaces the cached one
+			cachedManifest, err := reconciler.extractNIMManifest(ctx, getManifestConfigName(nimCache), "default")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cachedManifest.GetProfileRelease(updatedProfile)).To(Equal("1.1.0"))
+			err = cli.Get(ctx, types.NamespacedName{Name: getRefreshJobName(nimCache), Namespace: "default"}, &batchv1.Job{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = cli.Get(ctx, types.NamespacedName{Name: getRefreshManifestConfigName(nimCache), Namespace: "default"}, &corev1.ConfigMap{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should compute the next refresh time from the interval or schedule", func() {
+			last := time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)
+			nimCache.Status.LastRefreshTime = &metav1.Time{Time: last}
+
+			next, err := getNextRefreshTime(nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(next).To(Equal(last.Add(time.Hour)))
+
+			nimCache.Spec.Refresh = &appsv1alpha1.NIMCacheRefresh{Schedule: ptr.To("0 2 * * *")}
+			next, err = getNextRefreshTime(nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(next).To(Equal(time.Date(2025, 1, 2, 2, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Context("When garbage collecting a NIMCache", func() {
+		const (
+			oldProfile  = "old-profile"
+			newProfile  = "new-profile"
+			usedProfile = "used-profile"
+		)
+		var (
+			ctx      context.Context
+			nimCache *appsv1alpha1.NIMCache
+		)
+
+		BeforeEach(func() {
+			ctx = context.TODO()
+			nimCache = &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "retention-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{
+						ModelPuller: "nvcr.io/nim:test",
+						PullSecret:  "my-secret",
+					}},
+					Storage:   appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To[bool](true), StorageClass: "standard", Size: "1Gi"}},
+					Retention: &appsv1alpha1.NIMCacheRetention{KeepReleases: ptr.To[int32](1)},
+				},
+			}
+
+			// Profiles of two releases sharing the tokenizer repository
+			component := func(repoID string) nimparserv1.Component {
+				return nimparserv1.Component{Src: nimparserv1.Src{RepoID: repoID}}
+			}
+			profile := func(release string, components ...nimparserv1.Component) nimparserv1.NIMProfile {
+				return nimparserv1.NIMProfile{
+					Model:     "meta/llama3-70b-instruct",
+					Release:   release,
+					Workspace: nimparserv1.Workspace{Components: components},
+				}
+			}
+			var manifest nimparser.NIMManifestInterface = nimparserv1.NIMManifest{
+				oldProfile:  profile("1.0.0", component("ngc://nim/meta/llama3-70b-instruct:1.0.0-tp1"), component("ngc://nim/meta/llama3-tokenizer:1")),
+				usedProfile: profile("1.0.0", component("ngc://nim/meta/llama3-70b-instruct:1.0.0-tp2")),
+				newProfile:  profile("1.1.0", component("ngc://nim/meta/llama3-70b-instruct:1.1.0-tp1"), component("ngc://nim/meta/llama3-tokenizer:1")),
+			}
+			Expect(reconciler.createManifestConfigMap(ctx, nimCache, getManifestConfigName(nimCache), &manifest)).To(Succeed())
+
+			nimCache.Status = appsv1alpha1.NIMCacheStatus{
+				State:    appsv1alpha1.NimCacheStatusReady,
+				Profiles: getNIMProfiles(manifest, []string{oldProfile, usedProfile, newProfile}),
+			}
+
+			// A NIMService in another namespace uses one of the old profiles
+			nimService := &appsv1alpha1.NIMService{
+				ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "team-a"},
+				Spec: appsv1alpha1.NIMServiceSpec{
+					Storage: appsv1alpha1.NIMServiceStorage{
+						NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: "retention-nimcache", Namespace: "default", Profile: usedProfile},
+					},
+				},
+			}
+			Expect(cli.Create(ctx, nimService)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			_ = cli.Delete(ctx, &appsv1alpha1.NIMService{ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "team-a"}})
+		})
+
+		It("should delete the unreferenced profiles of older releases", func() {
+			result, err := reconciler.reconcileRetention(ctx, nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+			for _, profile := range nimCache.Status.Profiles {
+				Expect(profile.LastReferencedTime).NotTo(BeNil())
+			}
+
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: getCleanupJobName(nimCache), Namespace: "default"}, job)).To(Succeed())
+			Expect(job.Annotations).To(HaveKeyWithValue(PrunedProfilesAnnotationKey, oldProfile))
+			container := job.Spec.Template.Spec.Containers[0]
+			Expect(container.Image).To(Equal("nvcr.io/nim:test"))
+			// The tokenizer is still used by the new release
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{
+				Name:  "NIM_CACHE_PRUNE_PATHS",
+				Value: "ngc/hub/models--nim--meta--llama3-70b-instruct/snapshots/1.0.0-tp1",
+			}))
+			Expect(job.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(shared.GetPVCName(nimCache, nimCache.Spec.Storage.PVC)))
+
+			// Cannot run the cleanup job, report its completion
+			job.Status.Succeeded = 1
+			Expect(cli.Status().Update(ctx, job)).To(Succeed())
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "retention-nimcache-cleanup-job-abcde",
+					Namespace: "default",
+					Labels:    map[string]string{batchv1.JobNameLabel: job.Name},
+				},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: NIMCacheContainerName, Image: "nvcr.io/nim:test"}}},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodSucceeded,
+					ContainerStatuses: []corev1.ContainerStatus{{
+						Name:  NIMCacheContainerName,
+						State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: "1048576\n"}},
+					}},
+				},
+			}
+			Expect(cli.Create(ctx, pod)).To(Succeed())
+
+			_, err = reconciler.reconcileRetention(ctx, nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nimCache.Status.Profiles).To(HaveLen(2))
+			for _, profile := range nimCache.Status.Profiles {
+				Expect(profile.Name).NotTo(Equal(oldProfile))
+			}
+			Expect(nimCache.Status.StorageUsage.String()).To(Equal("1Gi"))
+			Expect(nimCache.Status.LastCleanupTime).NotTo(BeNil())
+			err = cli.Get(ctx, types.NamespacedName{Name: getCleanupJobName(nimCache), Namespace: "default"}, &batchv1.Job{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			// The next garbage collection is scheduled after the interval
+			result, err = reconciler.reconcileRetention(ctx, nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", 24*time.Hour, time.Minute))
+		})
+
+		It("should keep all profiles when a NIMService does not select a profile", func() {
+			nimService := &appsv1alpha1.NIMService{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "llm", Namespace: "team-a"}, nimService)).To(Succeed())
+			nimService.Spec.Storage.NIMCache.Profile = ""
+			Expect(cli.Update(ctx, nimService)).To(Succeed())
+
+			_, err := reconciler.reconcileRetention(ctx, nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: getCleanupJobName(nimCache), Namespace: "default"}, job)).To(Succeed())
+			Expect(job.Annotations).To(HaveKeyWithValue(PrunedProfilesAnnotationKey, ""))
+		})
+
+		It("should keep the profiles of the additional models of a NIMService", func() {
+			nimService := &appsv1alpha1.NIMService{
+				ObjectMeta: metav1.ObjectMeta{Name: "multi-model", Namespace: "default"},
+				Spec: appsv1alpha1.NIMServiceSpec{
+					MultiModel: &appsv1alpha1.MultiModelSpec{
+						Models: []appsv1alpha1.NIMServiceModelSpec{{
+							Name:     "llama3",
+							NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: "retention-nimcache", Profile: oldProfile},
+						}},
+					},
+				},
+			}
+			Expect(cli.Create(ctx, nimService)).To(Succeed())
+			defer func() { Expect(cli.Delete(ctx, nimService)).To(Succeed()) }()
+
+			_, err := reconciler.reconcileRetention(ctx, nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: getCleanupJobName(nimCache), Namespace: "default"}, job)).To(Succeed())
+			Expect(job.Annotations).To(HaveKeyWithValue(PrunedProfilesAnnotationKey, ""))
+		})
+
+		It("should keep all profiles when an additional model of a NIMService does not select a profile", func() {
+			nimService := &appsv1alpha1.NIMService{
+				ObjectMeta: metav1.ObjectMeta{Name: "multi-model", Namespace: "default"},
+				Spec: appsv1alpha1.NIMServiceSpec{
+					MultiModel: &appsv1alpha1.MultiModelSpec{
+						Models: []appsv1alpha1.NIMServiceModelSpec{{
+							Name:     "llama3",
+							NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: "retention-nimcache"},
+						}},
+					},
+				},
+			}
+			Expect(cli.Create(ctx, nimService)).To(Succeed())
+			defer func() { Expect(cli.Delete(ctx, nimService)).To(Succeed()) }()
+
+			_, err := reconciler.reconcileRetention(ctx, nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: getCleanupJobName(nimCache), Namespace: "default"}, job)).To(Succeed())
+			Expect(job.Annotations).To(HaveKeyWithValue(PrunedProfilesAnnotationKey, ""))
+		})
+
+		It("should keep all profiles when a NIMService serves a LoRA adapter of the NIMCache", func() {
+			nimService := &appsv1alpha1.NIMService{
+				ObjectMeta: metav1.ObjectMeta{Name: "lora", Namespace: "default"},
+				Spec: appsv1alpha1.NIMServiceSpec{
+					LoRA: &appsv1alpha1.LoRASpec{
+						Adapters: []appsv1alpha1.LoRAAdapter{{
+							Name:     "adapter",
+							NIMCache: &appsv1alpha1.LoRANIMCacheSource{Name: "retention-nimcache"},
+						}},
+					},
+				},
+			}
+			Expect(cli.Create(ctx, nimService)).To(Succeed())
+			defer func() { Expect(cli.Delete(ctx, nimService)).To(Succeed()) }()
+
+			_, err := reconciler.reconcileRetention(ctx, nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: getCleanupJobName(nimCache), Namespace: "default"}, job)).To(Succeed())
+			Expect(job.Annotations).To(HaveKeyWithValue(PrunedProfilesAnnotationKey, ""))
+		})
+
+		It("should ignore the additional models and LoRA adapters using a NIMCache of the same name in another namespace", func() {
+			nimService := &appsv1alpha1.NIMService{
+				ObjectMeta: metav1.ObjectMeta{Name: "multi-model", Namespace: "team-a"},
+				Spec: appsv1alpha1.NIMServiceSpec{
+					MultiModel: &appsv1alpha1.MultiModelSpec{
+						Models: []appsv1alpha1.NIMServiceModelSpec{{
+							Name:     "llama3",
+							NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: "retention-nimcache"},
+						}},
+					},
+					LoRA: &appsv1alpha1.LoRASpec{
+						Adapters: []appsv1alpha1.LoRAAdapter{{
+							Name:     "adapter",
+							NIMCache: &appsv1alpha1.LoRANIMCacheSource{Name: "retention-nimcache"},
+						}},
+					},
+				},
+			}
+			Expect(cli.Create(ctx, nimService)).To(Succeed())
+			defer func() { Expect(cli.Delete(ctx, nimService)).To(Succeed()) }()
+
+			_, err := reconciler.reconcileRetention(ctx, nimCache)
+			Expect(err).NotTo(HaveOccurred())
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: getCleanupJobName(nimCache), Namespace: "default"}, job)).To(Succeed())
+			Expect(job.Annotations).To(HaveKeyWithValue(PrunedProfilesAnnotationKey, oldProfile))
+		})
+
+		It("should select the profiles unreferenced for longer than the retention period", func() {
+			now := time.Now()
+			profiles := []appsv1alpha1.NIMProfile{
+				{Name: oldProfile, LastReferencedTime: &metav1.Time{Time: now.Add(-48 * time.Hour)}},
+				{Name: newProfile, LastReferencedTime: &metav1.Time{Time: now.Add(-time.Hour)}},
+				{Name: usedProfile, LastReferencedTime: &metav1.Time{Time: now.Add(-48 * time.Hour)}},
+			}
+			retention := &appsv1alpha1.NIMCacheRetention{UnreferencedFor: &metav1.Duration{Duration: 24 * time.Hour}}
+			isReferenced := func(name string) bool { return name == usedProfile }
+			Expect(getPrunedProfiles(profiles, retention, isReferenced, now)).To(Equal([]string{oldProfile}))
+		})
+
+		It("should only map NGC repositories to the cache", func() {
+			Expect(getNGCCacheSnapshotPath("ngc://nim/meta/llama3-8b-instruct:hf-1d54af3")).To(Equal("ngc/hub/models--nim--meta--llama3-8b-instruct/snapshots/hf-1d54af3"))
+			Expect(getNGCCacheSnapshotPath("hf://meta/llama3-8b-instruct:main")).To(BeEmpty())
+			Expect(getNGCCacheSnapshotPath("ngc://nim/meta/llama3-8b-instruct")).To(BeEmpty())
+			Expect(getNGCCacheSnapshotPath("ngc://nim/../../etc:1")).To(BeEmpty())
+			Expect(getNGCCacheSnapshotPath("ngc://nim/meta/llama3-8b-instruct:../1")).To(BeEmpty())
+		})
+	})
+
+	Context("When verifying a NIMCache", func() {
+		const profileName = "tp1-profile"
+		var (
+			ctx      context.Context
+			nimCache *appsv1alpha1.NIMCache
+		)
+
+		BeforeEach(func() {
+			ctx = context.TODO()
+			nimCache = &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "verify-nimcache",
+					Namespace:   "default",
+					Annotations: map[string]string{},
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{
+						ModelPuller: "nvcr.io/nim:test",
+						PullSecret:  "my-secret",
+					}},
+					Storage: appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To[bool](true), StorageClass: "standard", Size: "1Gi"}},
+				},
+			}
+			Expect(cli.Create(ctx, nimCache)).To(Succeed())
+
+			var manifest nimparser.NIMManifestInterface = nimparserv1.NIMManifest{
+				profileName: nimparserv1.NIMProfile{
+					Model:   "meta/llama3-70b-instruct",
+					Release: "1.0.0",
+					Workspace: nimparserv1.Workspace{Components: []nimparserv1.Component{
+						{Src: nimparserv1.Src{RepoID: "ngc://nim/meta/llama3-70b-instruct:1.0.0-tp1", Files: []nimparserv1.File{{Name: "rank0.engine"}, {Name: "config.json"}}}},
+						{Src: nimparserv1.Src{RepoID: "ngc://nim/meta/llama3-70b-instruct:1.0.0-tp1", Files: []nimparserv1.File{{Name: "../../escape.json"}}}},
+					}},
+				},
+			}
+			Expect(reconciler.createManifestConfigMap(ctx, nimCache, getManifestConfigName(nimCache), &manifest)).To(Succeed())
+			nimCache.Status = appsv1alpha1.NIMCacheStatus{
+				State:    appsv1alpha1.NimCacheStatusReady,
+				Profiles: getNIMProfiles(manifest, []string{profileName}),
+			}
+		})
+
+		AfterEach(func() {
+			_ = cli.Delete(ctx, &appsv1alpha1.NIMCache{ObjectMeta: metav1.ObjectMeta{Name: "verify-nimcache", Namespace: "default"}})
+		})
+
+		It("should report the model files of the cached profiles once cached", func() {
+			Expect(reconciler.reconcileIntegrity(ctx, nimCache)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: getVerifyJobName(nimCache), Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data[verifyFilesKey]).To(Equal(
+				"tp1-profile ngc/hub/models--nim--meta--llama3-70b-instruct/snapshots/1.0.0-tp1/config.json\n" +
+					"tp1-profile ngc/hub/models--nim--meta--llama3-70b-instruct/snapshots/1.0.0-tp1/rank0.engine\n"))
+
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: getVerifyJobName(nimCache), Namespace: "default"}, job)).To(Succeed())
+			container := job.Spec.Template.Spec.Containers[0]
+			Expect(container.Command).To(Equal([]string{"/bin/sh", "-c", verifyScript}))
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "NIM_CACHE_VERIFY_FILES", Value: "/etc/nim-cache-verify/files"}))
+			Expect(container.VolumeMounts[0].ReadOnly).To(BeTrue())
+			Expect(job.Spec.Template.Spec.Volumes[1].ConfigMap.Name).To(Equal(configMap.Name))
+		})
+
+		It("should record the size and checksum of the cached profiles", func() {
+			report := "NIM banner\n" +
+				"file\ttp1-profile\t1073741824\taa\ta/rank0.engine\n" +
+				"file\ttp1-profile\t1024\tbb\ta/config.json\n"
+			reports, err := parseVerifyReport(report)
+			Expect(err).NotTo(HaveOccurred())
+			reconciler.updateProfileIntegrity(nimCache, reports)
+
+			profile := nimCache.Status.Profiles[0]
+			Expect(profile.Size.Value()).To(Equal(int64(1073742848)))
+			Expect(profile.Files).To(Equal(int32(2)))
+			Expect(profile.Checksum).NotTo(BeEmpty())
+			cond := meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionIntegrityVerified)
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(ReasonVerified))
+
+			// The profile is not reported again until requested
+			Expect(reconciler.reconcileIntegrity(ctx, nimCache)).To(Succeed())
+			err = cli.Get(ctx, types.NamespacedName{Name: getVerifyJobName(nimCache), Namespace: "default"}, &batchv1.Job{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			// A truncated file changes the checksum of the profile
+			checksum := profile.Checksum
+			reports, err = parseVerifyReport(strings.Replace(report, "1024\tbb", "10\tcc", 1))
+			Expect(err).NotTo(HaveOccurred())
+			reconciler.updateProfileIntegrity(nimCache, reports)
+			Expect(nimCache.Status.Profiles[0].Checksum).To(Equal(checksum))
+			cond = meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionIntegrityVerified)
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(ReasonChecksumMismatch))
+
+			// A missing file is reported
+			reports, err = parseVerifyReport("missing\ttp1-profile\t\t\ta/rank0.engine\n")
+			Expect(err).NotTo(HaveOccurred())
+			reconciler.updateProfileIntegrity(nimCache, reports)
+			cond = meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionIntegrityVerified)
+			Expect(cond.Reason).To(Equal(ReasonFilesMissing))
+		})
+
+		It("should report model file paths with any character", func() {
+			reports, err := parseVerifyReport("file\ttp1-profile\t1024\tbb\ta/\"quoted\\ \tfile\".json\r\n" +
+				"missing\ttp1-profile\t\t\ta/\"missing\\\".json\n")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reports).To(HaveKey("tp1-profile"))
+			Expect(reports["tp1-profile"].Size).To(Equal(int64(1024)))
+			Expect(reports["tp1-profile"].Files).To(Equal(int32(1)))
+			Expect(reports["tp1-profile"].Missing).To(Equal([]string{`a/"missing\".json`}))
+
+			_, err = parseVerifyReport("file\ttp1-profile\tabc\tbb\ta/config.json\n")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should verify the cached profiles on request", func() {
+			nimCache.Status.Profiles[0].Size = resource.NewQuantity(1024, resource.BinarySI)
+			nimCache.Status.Profiles[0].Checksum = "abc"
+			Expect(reconciler.reconcileIntegrity(ctx, nimCache)).To(Succeed())
+			err := cli.Get(ctx, types.NamespacedName{Name: getVerifyJobName(nimCache), Namespace: "default"}, &batchv1.Job{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			nimCache.Annotations[appsv1alpha1.NIMCacheVerifyAnnotation] = "true"
+			Expect(reconciler.reconcileIntegrity(ctx, nimCache)).To(Succeed())
+			job := &batchv1.Job{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: getVerifyJobName(nimCache), Namespace: "default"}, job)).To(Succeed())
+
+			// The request is cleared once handled
+			obj := &appsv1alpha1.NIMCache{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "verify-nimcache", Namespace: "default"}, obj)).To(Succeed())
+			Expect(obj.Annotations).NotTo(HaveKey(appsv1alpha1.NIMCacheVerifyAnnotation))
+			Expect(nimCache.IsVerifyRequested()).To(BeFalse())
+
+			// A failed verification is reported and not retried
+			job.Status.Failed = 1
+			Expect(cli.Status().Update(ctx, job)).To(Succeed())
+			Expect(reconciler.reconcileIntegrity(ctx, nimCache)).To(Succeed())
+			cond := meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionIntegrityVerified)
+			Expect(cond.Reason).To(Equal(ReasonVerifyFailed))
+			Expect(nimCache.Status.LastVerifiedTime).NotTo(BeNil())
+			err = cli.Get(ctx, types.NamespacedName{Name: getVerifyJobName(nimCache), Namespace: "default"}, &batchv1.Job{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = cli.Get(ctx, types.NamespacedName{Name: getVerifyJobName(nimCache), Namespace: "default"}, &corev1.ConfigMap{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			nimCache.Status.Profiles[0].Size = nil
+			Expect(reconciler.reconcileIntegrity(ctx, nimCache)).To(Succeed())
+			err = cli.Get(ctx, types.NamespacedName{Name: getVerifyJobName(nimCache), Namespace: "default"}, &batchv1.Job{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
 	})
 
 	Context("when error reconciling NIMCache resource", func() {
@@ -0,0 +1,428 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+	"github.com/NVIDIA/k8s-nim-operator/internal/nimparser"
+)
+
+const (
+	// ReasonVerified indicates that the model files of the cached profiles match their checksums.
+	ReasonVerified = "Verified"
+	// ReasonChecksumMismatch indicates that model files of cached profiles changed since they were cached.
+	ReasonChecksumMismatch = "ChecksumMismatch"
+	// ReasonFilesMissing indicates that model files of cached profiles are missing from the cache.
+	ReasonFilesMissing = "FilesMissing"
+	// ReasonVerifyFailed indicates that the job checking the model files of the cached profiles has failed.
+	ReasonVerifyFailed = "VerifyFailed"
+
+	// verifyFilesKey is the key of the verification ConfigMap listing the profile and path of each model file.
+	verifyFilesKey = "files"
+	// verifyFilesMountPath is where the verification ConfigMap is mounted in the verification job.
+	verifyFilesMountPath = "/etc/nim-cache-verify"
+
+	// verifyScript reports the size and sha256 checksum of each model file as a tab-separated line,
+	// the path coming last so that it is reported as is whatever the characters it contains.
+	verifyScript = `set -u
+cd "$NIM_CACHE_PATH"
+while read -r profile file; do
+  if [ -z "$file" ]; then
+    continue
+  fi
+  if [ -f "$file" ]; then
+    size=$(stat -Lc %s "$file")
+    sum=$(sha256sum < "$file" | cut -d' ' -f1)
+    printf 'file\t%s\t%s\t%s\t%s\n' "$profile" "$size" "$sum" "$file"
+  else
+    printf 'missing\t%s\t\t\t%s\n' "$profile" "$file"
+  fi
+done < "$NIM_CACHE_VERIFY_FILES"
+`
+)
+
+// Kinds of the lines reported by the verification job, telling them apart from the logs of the NIM image.
+const (
+	verifyReportFile    = "file"
+	verifyReportMissing = "missing"
+)
+
+// cachedFileReport is the result reported by the verification job for a model file of a profile.
+type cachedFileReport struct {
+	Profile string
+	Path    string
+	Size    int64
+	SHA256  string
+	Missing bool
+}
+
+// parseVerifyReportLine parses a line reported by the verification job, returning false for other log lines.
+func parseVerifyReportLine(line string) (*cachedFileReport, bool, error) {
+	fields := strings.SplitN(line, "\t", 5)
+	if len(fields) != 5 || (fields[0] != verifyReportFile && fields[0] != verifyReportMissing) {
+		return nil, false, nil
+	}
+	file := &cachedFileReport{
+		Profile: fields[1],
+		SHA256:  fields[3],
+		Path:    fields[4],
+		Missing: fields[0] == verifyReportMissing,
+	}
+	if !file.Missing {
+		size, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid size in report line %q: %w", line, err)
+		}
+		file.Size = size
+	}
+	return file, true, nil
+}
+
+// cachedProfileReport aggregates the results reported for the model files of a profile.
+type cachedProfileReport struct {
+	Size     int64
+	Files    int32
+	Missing  []string
+	Checksum string
+}
+
+// reconcileIntegrity reports the disk usage and checksums of the model files of each cached profile once cached,
+// and checks the model files against the recorded checksums when requested with the verify annotation.
+func (r *NIMCacheReconciler) reconcileIntegrity(ctx context.Context, nimCache *appsv1alpha1.NIMCache) error {
+	logger := r.GetLogger()
+
+	if !nimCache.IsOptimizedNIM() || !nimCache.IsPVCEnabled() || nimCache.Status.State != appsv1alpha1.NimCacheStatusReady {
+		return nil
+	}
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: getVerifyJobName(nimCache), Namespace: nimCache.GetNamespace()}, job)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		return r.reconcileVerifyJobStatus(ctx, nimCache, job)
+	}
+
+	// Model files are not checked while they are updated or deleted, the job completion triggers the next reconciliation
+	running, err := r.isCacheJobRunning(ctx, nimCache, getRefreshJobName(nimCache), getCleanupJobName(nimCache))
+	if err != nil || running {
+		return err
+	}
+
+	profiles, err := r.getCachedProfiles(ctx, nimCache)
+	if err != nil {
+		return err
+	}
+	nimManifest, err := r.extractNIMManifest(ctx, getManifestConfigName(nimCache), nimCache.GetNamespace())
+	if err != nil {
+		return fmt.Errorf("failed to get model manifest config file: %w", err)
+	}
+	files := getProfileFilePaths(nimManifest, profiles)
+
+	// Profiles are reported once cached, or when cached again by a refresh. A failed job is only retried on request.
+	pending := nimCache.IsVerifyRequested()
+	if cond := meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionIntegrityVerified); cond == nil || cond.Reason != ReasonVerifyFailed {
+		for _, profile := range profiles {
+			if profile.Size == nil && len(files[profile.Name]) > 0 {
+				pending = true
+			}
+		}
+	}
+	if !pending {
+		return nil
+	}
+	nimCache.Status.Profiles = profiles
+
+	if err := r.createVerifyJob(ctx, nimCache, files); err != nil {
+		return err
+	}
+	logger.Info("Created Job for verifying NIM Cache", "job", getVerifyJobName(nimCache))
+
+	// The request is handled, a new one can be made by annotating again
+	if nimCache.IsVerifyRequested() {
+		obj := &appsv1alpha1.NIMCache{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(nimCache), obj); err != nil {
+			return err
+		}
+		delete(obj.Annotations, appsv1alpha1.NIMCacheVerifyAnnotation)
+		if err := r.Update(ctx, obj); err != nil {
+			return err
+		}
+		delete(nimCache.Annotations, appsv1alpha1.NIMCacheVerifyAnnotation)
+	}
+	return nil
+}
+
+// createVerifyJob creates the job reporting the size and checksum of the given model files, listed in a ConfigMap.
+func (r *NIMCacheReconciler) createVerifyJob(ctx context.Context, nimCache *appsv1alpha1.NIMCache, files map[string][]string) error {
+	lines := []string{}
+	for profile, paths := range files {
+		for _, filePath := range paths {
+			lines = append(lines, fmt.Sprintf("%s %s", profile, filePath))
+		}
+	}
+	slices.Sort(lines)
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getVerifyJobName(nimCache),
+			Namespace: nimCache.GetNamespace(),
+		},
+		Data: map[string]string{
+			verifyFilesKey: strings.Join(lines, "\n") + "\n",
+		},
+	}
+	if err := controllerutil.SetControllerReference(nimCache, configMap, r.GetScheme()); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, configMap); err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create ConfigMap %s: %w", configMap.Name, err)
+		}
+		if err := r.Update(ctx, configMap); err != nil {
+			return fmt.Errorf("failed to update ConfigMap %s: %w", configMap.Name, err)
+		}
+	}
+
+	job, err := r.constructCacheVolumeJob(nimCache, getVerifyJobName(nimCache), verifyScript, []corev1.EnvVar{
+		{
+			Name:  "NIM_CACHE_VERIFY_FILES",
+			Value: path.Join(verifyFilesMountPath, verifyFilesKey),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	podSpec := &job.Spec.Template.Spec
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: "nim-cache-verify",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: configMap.Name},
+			},
+		},
+	})
+	podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      "nim-cache-verify",
+		MountPath: verifyFilesMountPath,
+		ReadOnly:  true,
+	})
+	podSpec.Containers[0].VolumeMounts[0].ReadOnly = true
+
+	if err := controllerutil.SetControllerReference(nimCache, job, r.GetScheme()); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, job); err != nil {
+		return fmt.Errorf("failed to create verify job %s: %w", job.Name, err)
+	}
+	return nil
+}
+
+// reconcileVerifyJobStatus records the report of the verification job in the NIMCache status once it completes.
+func (r *NIMCacheReconciler) reconcileVerifyJobStatus(ctx context.Context, nimCache *appsv1alpha1.NIMCache, job *batchv1.Job) error {
+	logger := r.GetLogger()
+
+	switch {
+	case job.Status.Succeeded > 0:
+		logger.Info("Verify job completed", "job", job.Name)
+		output, err := r.getVerifyJobOutput(ctx, job)
+		if err != nil {
+			return err
+		}
+		reports, err := parseVerifyReport(output)
+		if err != nil {
+			return fmt.Errorf("failed to parse the report of job %s: %w", job.Name, err)
+		}
+		r.updateProfileIntegrity(nimCache, reports)
+
+	case job.Status.Failed > 0:
+		logger.Info("Failed to verify NIM cache, verify job failed", "job", job.Name)
+		conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionIntegrityVerified, metav1.ConditionFalse, ReasonVerifyFailed, "The Job to verify the cached profiles has failed")
+		r.GetEventRecorder().Eventf(nimCache, corev1.EventTypeWarning, ReasonVerifyFailed, "NIMCache %s: the Job to verify the cached profiles has failed", nimCache.Name)
+
+	default:
+		// The job status change triggers the next reconciliation
+		return nil
+	}
+
+	nimCache.Status.LastVerifiedTime = ptr.To(metav1.Now())
+	if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: getVerifyJobName(nimCache), Namespace: nimCache.GetNamespace()}}
+	if err := r.Delete(ctx, configMap); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete ConfigMap %s: %w", configMap.Name, err)
+	}
+	return nil
+}
+
+// getVerifyJobOutput returns the logs of the succeeded pod of the verification job.
+func (r *NIMCacheReconciler) getVerifyJobOutput(ctx context.Context, job *batchv1.Job) (string, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(job.GetNamespace()), client.MatchingLabels{batchv1.JobNameLabel: job.GetName()}); err != nil {
+		return "", fmt.Errorf("failed to list pods of job %s: %w", job.Name, err)
+	}
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1.PodSucceeded {
+			return k8sutil.GetPodLogs(ctx, &pods.Items[i], NIMCacheContainerName)
+		}
+	}
+	return "", fmt.Errorf("no succeeded pod found for job %s", job.Name)
+}
+
+// updateProfileIntegrity records the size and checksum of the reported profiles, and reports the profiles whose
+// model files are missing or changed since their checksum was first recorded.
+func (r *NIMCacheReconciler) updateProfileIntegrity(nimCache *appsv1alpha1.NIMCache, reports map[string]*cachedProfileReport) {
+	missing := []string{}
+	mismatched := []string{}
+	for i := range nimCache.Status.Profiles {
+		profile := &nimCache.Status.Profiles[i]
+		report, ok := reports[profile.Name]
+		if !ok {
+			continue
+		}
+		profile.Size = apiResource.NewQuantity(report.Size, apiResource.BinarySI)
+		profile.Files = report.Files
+		switch {
+		case len(report.Missing) > 0:
+			missing = append(missing, profile.Name)
+		case profile.Checksum != "" && profile.Checksum != report.Checksum:
+			// The recorded checksum is kept to report the mismatch until the profile is cached again
+			mismatched = append(mismatched, profile.Name)
+		default:
+			profile.Checksum = report.Checksum
+		}
+	}
+
+	switch {
+	case len(missing) > 0:
+		msg := fmt.Sprintf("Model files are missing for profiles: %s", strings.Join(missing, ", "))
+		conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionIntegrityVerified, metav1.ConditionFalse, ReasonFilesMissing, msg)
+		r.GetEventRecorder().Eventf(nimCache, corev1.EventTypeWarning, ReasonFilesMissing, "NIMCache %s: %s", nimCache.Name, msg)
+	case len(mismatched) > 0:
+		msg := fmt.Sprintf("Model files changed for profiles: %s", strings.Join(mismatched, ", "))
+		conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionIntegrityVerified, metav1.ConditionFalse, ReasonChecksumMismatch, msg)
+		r.GetEventRecorder().Eventf(nimCache, corev1.EventTypeWarning, ReasonChecksumMismatch, "NIMCache %s: %s", nimCache.Name, msg)
+	default:
+		conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionIntegrityVerified, metav1.ConditionTrue, ReasonVerified, "The model files of the cached profiles match their checksums")
+	}
+}
+
+// isCacheJobRunning returns true if any of the given jobs updating the NIM cache exists.
+func (r *NIMCacheReconciler) isCacheJobRunning(ctx context.Context, nimCache *appsv1alpha1.NIMCache, names ...string) (bool, error) {
+	for _, name := range names {
+		err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: nimCache.GetNamespace()}, &batchv1.Job{})
+		if err == nil {
+			return true, nil
+		}
+		if !errors.IsNotFound(err) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// parseVerifyReport aggregates the JSON lines reported by the verification job by profile, ignoring any other output.
+func parseVerifyReport(output string) (map[string]*cachedProfileReport, error) {
+	checksums := map[string][]string{}
+	reports := map[string]*cachedProfileReport{}
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		file, ok, err := parseVerifyReportLine(strings.TrimSuffix(scanner.Text(), "\r"))
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		report, ok := reports[file.Profile]
+		if !ok {
+			report = &cachedProfileReport{}
+			reports[file.Profile] = report
+		}
+		if file.Missing {
+			report.Missing = append(report.Missing, file.Path)
+			continue
+		}
+		report.Size += file.Size
+		report.Files++
+		checksums[file.Profile] = append(checksums[file.Profile], fmt.Sprintf("%s  %s", file.SHA256, file.Path))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for profile, lines := range checksums {
+		slices.Sort(lines)
+		digest := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+		reports[profile].Checksum = hex.EncodeToString(digest[:])
+	}
+	return reports, nil
+}
+
+// getProfileFilePaths returns the paths of the model files of each profile relative to the NIM cache.
+func getProfileFilePaths(nimManifest nimparser.NIMManifestInterface, profiles []appsv1alpha1.NIMProfile) map[string][]string {
+	files := map[string][]string{}
+	for _, profile := range profiles {
+		for source, names := range nimManifest.GetProfileFiles(profile.Name) {
+			snapshot := getNGCCacheSnapshotPath(source)
+			if snapshot == "" {
+				continue
+			}
+			for _, name := range names {
+				filePath := path.Join(snapshot, name)
+				// Skip names escaping the snapshot or containing whitespace, which the job cannot report
+				if !strings.HasPrefix(filePath, snapshot+"/") || strings.ContainsAny(name, " \t\n\"\\") {
+					continue
+				}
+				files[profile.Name] = append(files[profile.Name], filePath)
+			}
+		}
+		slices.Sort(files[profile.Name])
+	}
+	return files
+}
+
+func getVerifyJobName(nimCache *appsv1alpha1.NIMCache) string {
+	return fmt.Sprintf("%s-verify-job", nimCache.GetName())
+}
@@ -0,0 +1,361 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/nimparser"
+)
+
+const (
+	// ReasonUpdateAvailable indicates that cached profiles were added or changed upstream.
+	ReasonUpdateAvailable = "UpdateAvailable"
+	// ReasonUpToDate indicates that the cached profiles match the upstream model manifest.
+	ReasonUpToDate = "UpToDate"
+	// ReasonUpdating indicates that the profiles changed upstream are being cached.
+	ReasonUpdating = "Updating"
+	// ReasonUpdated indicates that the profiles changed upstream have been cached.
+	ReasonUpdated = "Updated"
+	// ReasonUpdateFailed indicates that caching the profiles changed upstream has failed.
+	ReasonUpdateFailed = "UpdateFailed"
+)
+
+// reconcileRefresh periodically extracts the upstream model manifest of a ready NIMCache, reports the profiles
+// added or changed since they were cached and optionally re-runs the caching job for those profiles only.
+func (r *NIMCacheReconciler) reconcileRefresh(ctx context.Context, nimCache *appsv1alpha1.NIMCache) (ctrl.Result, error) {
+	logger := r.GetLogger()
+
+	if !nimCache.IsRefreshEnabled() || nimCache.Status.State != appsv1alpha1.NimCacheStatusReady {
+		return ctrl.Result{}, nil
+	}
+
+	// The upstream manifest is kept until the changed profiles are cached
+	_, err := r.getConfigMap(ctx, getRefreshManifestConfigName(nimCache), nimCache.GetNamespace())
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		job := &batchv1.Job{}
+		err = r.Get(ctx, types.NamespacedName{Name: getRefreshJobName(nimCache), Namespace: nimCache.GetNamespace()}, job)
+		if err != nil && !errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		if err == nil {
+			return ctrl.Result{}, r.reconcileRefreshJobStatus(ctx, nimCache, job)
+		}
+		return ctrl.Result{}, r.reconcileRefreshManifest(ctx, nimCache)
+	}
+
+	// The upstream profiles are not cached while the cached profiles are garbage collected or verified
+	running, err := r.isCacheJobRunning(ctx, nimCache, getCleanupJobName(nimCache), getVerifyJobName(nimCache))
+	if err != nil || running {
+		return ctrl.Result{}, err
+	}
+
+	nextRefreshTime, err := getNextRefreshTime(nimCache)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if wait := time.Until(nextRefreshTime); wait > 0 {
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	// Extract the upstream model manifest, always pulling the model puller to pick up new releases of mutable tags
+	pod := constructPodSpec(nimCache, r.orchestratorType)
+	pod.Name = getRefreshPodName(nimCache)
+	pod.Spec.Containers[0].ImagePullPolicy = corev1.PullAlways
+	manifest, requeue, err := r.extractManifestFromPod(ctx, nimCache, pod)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if requeue {
+		logger.V(2).Info("requeueing for extracting the upstream model manifest", "pod", pod.Name)
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+	if err := r.createManifestConfigMap(ctx, nimCache, getRefreshManifestConfigName(nimCache), &manifest); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, r.reconcileRefreshManifest(ctx, nimCache)
+}
+
+// reconcileRefreshManifest diffs the upstream model manifest against the cached profiles.
+func (r *NIMCacheReconciler) reconcileRefreshManifest(ctx context.Context, nimCache *appsv1alpha1.NIMCache) error {
+	logger := r.GetLogger()
+
+	upstreamManifest, err := r.extractNIMManifest(ctx, getRefreshManifestConfigName(nimCache), nimCache.GetNamespace())
+	if err != nil {
+		return fmt.Errorf("failed to get upstream model manifest config file: %w", err)
+	}
+	cachedProfiles, err := r.getCachedProfiles(ctx, nimCache)
+	if err != nil {
+		return err
+	}
+	wantedProfiles, err := r.getWantedProfiles(ctx, nimCache, upstreamManifest)
+	if err != nil {
+		return err
+	}
+
+	updatedProfiles := getUpdatedProfiles(cachedProfiles, upstreamManifest, wantedProfiles)
+	nimCache.Status.LastRefreshTime = ptr.To(metav1.Now())
+	nimCache.Status.UpdatedProfiles = updatedProfiles
+
+	if len(updatedProfiles) == 0 {
+		logger.Info("NIMCache is up to date with the upstream model manifest", "nimcache", nimCache.Name)
+		conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionUpdateAvailable, metav1.ConditionFalse, ReasonUpToDate, "The cached profiles are up to date")
+		return r.deleteRefreshManifest(ctx, nimCache)
+	}
+
+	msg := fmt.Sprintf("Profiles added or changed upstream: %s", strings.Join(updatedProfiles, ", "))
+	logger.Info("NIMCache update available", "nimcache", nimCache.Name, "profiles", updatedProfiles)
+	conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionUpdateAvailable, metav1.ConditionTrue, ReasonUpdateAvailable, msg)
+	r.GetEventRecorder().Eventf(nimCache, corev1.EventTypeNormal, ReasonUpdateAvailable, "NIMCache %s: %s", nimCache.Name, msg)
+
+	if !nimCache.Spec.Refresh.AutoUpdate {
+		return r.deleteRefreshManifest(ctx, nimCache)
+	}
+
+	// Re-run the caching job for the updated profiles only
+	job, err := r.constructRefreshJob(ctx, nimCache, updatedProfiles)
+	if err != nil {
+		return err
+	}
+	if err := controllerutil.SetControllerReference(nimCache, job, r.GetScheme()); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, job); err != nil {
+		return fmt.Errorf("failed to create refresh job %s: %w", job.Name, err)
+	}
+	logger.Info("Created Job for updating NIM Cache", "job", job.Name)
+	conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionUpdateAvailable, metav1.ConditionTrue, ReasonUpdating, msg)
+	return nil
+}
+
+// reconcileRefreshJobStatus promotes the upstream model manifest once the updated profiles are cached.
+func (r *NIMCacheReconciler) reconcileRefreshJobStatus(ctx context.Context, nimCache *appsv1alpha1.NIMCache, job *batchv1.Job) error {
+	logger := r.GetLogger()
+
+	switch {
+	case job.Status.Succeeded > 0:
+		logger.Info("Refresh job completed", "job", job.Name)
+		upstreamConfig, err := r.getConfigMap(ctx, getRefreshManifestConfigName(nimCache), nimCache.GetNamespace())
+		if err != nil {
+			return err
+		}
+		upstreamManifest, err := r.extractNIMManifest(ctx, upstreamConfig.Name, nimCache.GetNamespace())
+		if err != nil {
+			return fmt.Errorf("failed to get upstream model manifest config file: %w", err)
+		}
+
+		// Replace the cached manifest with the upstream one
+		manifestConfig, err := r.getConfigMap(ctx, getManifestConfigName(nimCache), nimCache.GetNamespace())
+		if err != nil {
+			return err
+		}
+		manifestConfig.Data = upstreamConfig.Data
+		if err := r.Update(ctx, manifestConfig); err != nil {
+			return fmt.Errorf("failed to update manifest ConfigMap %s: %w", manifestConfig.Name, err)
+		}
+
+		// Status only lists the profiles when specific profiles are cached
+		if len(nimCache.Status.Profiles) > 0 {
+			profiles := []appsv1alpha1.NIMProfile{}
+			for _, profile := range nimCache.Status.Profiles {
+				if !slices.Contains(nimCache.Status.UpdatedProfiles, profile.Name) {
+					profiles = append(profiles, profile)
+				}
+			}
+			nimCache.Status.Profiles = append(profiles, getNIMProfiles(upstreamManifest, nimCache.Status.UpdatedProfiles)...)
+		}
+
+		msg := fmt.Sprintf("Profiles updated: %s", strings.Join(nimCache.Status.UpdatedProfiles, ", "))
+		conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionUpdateAvailable, metav1.ConditionFalse, ReasonUpdated, msg)
+		r.GetEventRecorder().Eventf(nimCache, corev1.EventTypeNormal, ReasonUpdated, "NIMCache %s: %s", nimCache.Name, msg)
+		nimCache.Status.UpdatedProfiles = nil
+
+	case job.Status.Failed > 0:
+		logger.Info("Failed to update NIM cache, refresh job failed", "job", job.Name)
+		conditions.UpdateCondition(&nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionUpdateAvailable, metav1.ConditionTrue, ReasonUpdateFailed, "The Job to cache the updated profiles has failed")
+		r.GetEventRecorder().Eventf(nimCache, corev1.EventTypeWarning, ReasonUpdateFailed, "NIMCache %s: the Job to cache the updated profiles has failed", nimCache.Name)
+
+	default:
+		// The job status change triggers the next reconciliation
+		return nil
+	}
+
+	// The next refresh starts over from a new upstream manifest
+	if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return r.deleteRefreshManifest(ctx, nimCache)
+}
+
+func (r *NIMCacheReconciler) deleteRefreshManifest(ctx context.Context, nimCache *appsv1alpha1.NIMCache) error {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getRefreshManifestConfigName(nimCache),
+			Namespace: nimCache.GetNamespace(),
+		},
+	}
+	if err := r.Delete(ctx, configMap); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete ConfigMap %s: %w", configMap.Name, err)
+	}
+	return nil
+}
+
+// getCachedProfiles returns the cached profiles, read from the cached model manifest when all profiles are cached.
+func (r *NIMCacheReconciler) getCachedProfiles(ctx context.Context, nimCache *appsv1alpha1.NIMCache) ([]appsv1alpha1.NIMProfile, error) {
+	if len(nimCache.Status.Profiles) > 0 {
+		return nimCache.Status.Profiles, nil
+	}
+	nimManifest, err := r.extractNIMManifest(ctx, getManifestConfigName(nimCache), nimCache.GetNamespace())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model manifest config file: %w", err)
+	}
+	return getNIMProfiles(nimManifest, nimManifest.GetProfilesList()), nil
+}
+
+// getWantedProfiles returns the profiles of the upstream model manifest selected for caching.
+func (r *NIMCacheReconciler) getWantedProfiles(ctx context.Context, nimCache *appsv1alpha1.NIMCache, upstreamManifest nimparser.NIMManifestInterface) ([]string, error) {
+	profiles := nimCache.GetModelSpec().Profiles
+	if len(profiles) == 0 {
+		var err error
+		profiles, err = r.matchProfiles(ctx, nimCache, upstreamManifest)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if slices.Contains(profiles, AllProfiles) {
+		return upstreamManifest.GetProfilesList(), nil
+	}
+	return profiles, nil
+}
+
+// constructRefreshJob constructs the caching job for the given profiles only.
+func (r *NIMCacheReconciler) constructRefreshJob(ctx context.Context, nimCache *appsv1alpha1.NIMCache, profiles []string) (*batchv1.Job, error) {
+	refreshCache := nimCache.DeepCopy()
+	modelSpec := refreshCache.GetModelSpec()
+	modelSpec.Profiles = profiles
+	refreshCache.Spec.Source.NGC.Model = &modelSpec
+
+	job, err := r.constructJob(ctx, refreshCache, r.orchestratorType)
+	if err != nil {
+		return nil, err
+	}
+	job.Name = getRefreshJobName(nimCache)
+	for i := range job.Spec.Template.Spec.InitContainers {
+		if job.Spec.Template.Spec.InitContainers[i].Name == NIMCacheContainerName {
+			job.Spec.Template.Spec.InitContainers[i].ImagePullPolicy = corev1.PullAlways
+		}
+	}
+	for i := range job.Spec.Template.Spec.Containers {
+		if job.Spec.Template.Spec.Containers[i].Name == NIMCacheContainerName {
+			job.Spec.Template.Spec.Containers[i].ImagePullPolicy = corev1.PullAlways
+		}
+	}
+	return job, nil
+}
+
+// getNextRefreshTime returns the time of the next check for updates, based on the last check or
+// on the completion of the caching job for the first check.
+func getNextRefreshTime(nimCache *appsv1alpha1.NIMCache) (time.Time, error) {
+	last := nimCache.GetCreationTimestamp().Time
+	if nimCache.Status.LastRefreshTime != nil {
+		last = nimCache.Status.LastRefreshTime.Time
+	} else if cond := meta.FindStatusCondition(nimCache.Status.Conditions, appsv1alpha1.NimCacheConditionJobCompleted); cond != nil {
+		last = cond.LastTransitionTime.Time
+	}
+
+	refresh := nimCache.Spec.Refresh
+	if refresh.Schedule != nil {
+		schedule, err := cron.ParseStandard(*refresh.Schedule)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid refresh schedule %q: %w", *refresh.Schedule, err)
+		}
+		return schedule.Next(last), nil
+	}
+	if refresh.Interval != nil {
+		return last.Add(refresh.Interval.Duration), nil
+	}
+	return time.Time{}, fmt.Errorf("one of refresh interval or schedule must be defined")
+}
+
+// getUpdatedProfiles returns the wanted profiles which are not cached yet, or whose model or release changed upstream.
+func getUpdatedProfiles(cachedProfiles []appsv1alpha1.NIMProfile, upstreamManifest nimparser.NIMManifestInterface, wantedProfiles []string) []string {
+	cached := map[string]appsv1alpha1.NIMProfile{}
+	for _, profile := range cachedProfiles {
+		cached[profile.Name] = profile
+	}
+	upstreamProfiles := upstreamManifest.GetProfilesList()
+
+	updated := []string{}
+	for _, name := range wantedProfiles {
+		if !slices.Contains(upstreamProfiles, name) {
+			continue
+		}
+		profile, ok := cached[name]
+		if !ok || profile.Release != upstreamManifest.GetProfileRelease(name) || profile.Model != upstreamManifest.GetProfileModel(name) {
+			updated = append(updated, name)
+		}
+	}
+	slices.Sort(updated)
+	return slices.Compact(updated)
+}
+
+// getNIMProfiles returns the status of the given profiles from the model manifest.
+func getNIMProfiles(nimManifest nimparser.NIMManifestInterface, names []string) []appsv1alpha1.NIMProfile {
+	profiles := []appsv1alpha1.NIMProfile{}
+	for _, name := range names {
+		profiles = append(profiles, appsv1alpha1.NIMProfile{
+			Name:    name,
+			Model:   nimManifest.GetProfileModel(name),
+			Config:  nimManifest.GetProfileTags(name),
+			Release: nimManifest.GetProfileRelease(name),
+		})
+	}
+	return profiles
+}
+
+func getRefreshPodName(nimCache *appsv1alpha1.NIMCache) string {
+	return fmt.Sprintf("%s-refresh-pod", nimCache.GetName())
+}
+
+func getRefreshJobName(nimCache *appsv1alpha1.NIMCache) string {
+	return fmt.Sprintf("%s-refresh-job", nimCache.GetName())
+}
+
+func getRefreshManifestConfigName(nimCache *appsv1alpha1.NIMCache) string {
+	return fmt.Sprintf("%s-manifest-refresh", nimCache.GetName())
+}
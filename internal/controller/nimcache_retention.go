@@ -0,0 +1,438 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/nimparser"
+)
+
+const (
+	// ReasonProfilesDeleted indicates that cached profiles were deleted by the retention policy.
+	ReasonProfilesDeleted = "ProfilesDeleted"
+	// ReasonCleanupFailed indicates that the garbage collection of the cached profiles has failed.
+	ReasonCleanupFailed = "CleanupFailed"
+
+	// PrunedProfilesAnnotationKey is the annotation on the cleanup job listing the profiles it deletes.
+	PrunedProfilesAnnotationKey = "nvidia.com/pruned-profiles"
+
+	// cleanupScript deletes the snapshots of the pruned profiles from the NGC cache, then the blobs
+	// no longer linked from any snapshot, and reports the disk usage of the cache in KiB.
+	cleanupScript = `set -eu
+cd "$NIM_CACHE_PATH"
+printf '%s\n' "$NIM_CACHE_PRUNE_PATHS" | while IFS= read -r snapshot; do
+  if [ -n "$snapshot" ]; then
+    rm -rf -- "./$snapshot"
+  fi
+done
+for repo in ngc/hub/models--*; do
+  if [ ! -d "$repo/blobs" ]; then
+    continue
+  fi
+  find "$repo/snapshots" -type l -exec readlink -f {} + 2>/dev/null | sort -u > /tmp/linked-blobs || true
+  for blob in "$repo"/blobs/*; do
+    if [ -e "$blob" ] && ! grep -qxF "$(readlink -f "$blob")" /tmp/linked-blobs; then
+      rm -f -- "$blob"
+    fi
+  done
+done
+du -sk . | cut -f1 > /dev/termination-log
+`
+)
+
+// reconcileRetention periodically garbage collects the cached profiles of a ready NIMCache according to its
+// retention policy, and measures the disk space used by the cache.
+func (r *NIMCacheReconciler) reconcileRetention(ctx context.Context, nimCache *appsv1alpha1.NIMCache) (ctrl.Result, error) {
+	logger := r.GetLogger()
+
+	if !nimCache.IsRetentionEnabled() || nimCache.Status.State != appsv1alpha1.NimCacheStatusReady {
+		return ctrl.Result{}, nil
+	}
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: getCleanupJobName(nimCache), Namespace: nimCache.GetNamespace()}, job)
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		return ctrl.Result{}, r.reconcileCleanupJobStatus(ctx, nimCache, job)
+	}
+
+	// Profiles are not deleted while a refresh may be caching them, the refresh completion triggers the next reconciliation
+	_, err = r.getConfigMap(ctx, getRefreshManifestConfigName(nimCache), nimCache.GetNamespace())
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		return ctrl.Result{}, nil
+	}
+	// Profiles are not deleted while they are verified
+	running, err := r.isCacheJobRunning(ctx, nimCache, getVerifyJobName(nimCache))
+	if err != nil || running {
+		return ctrl.Result{}, err
+	}
+
+	if wait := time.Until(getNextCleanupTime(nimCache)); wait > 0 {
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	profiles, err := r.getCachedProfiles(ctx, nimCache)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	allReferenced, referencedProfiles, err := r.getReferencedProfiles(ctx, nimCache)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	isReferenced := func(name string) bool {
+		return allReferenced || slices.Contains(referencedProfiles, name)
+	}
+
+	now := metav1.Now()
+	profiles = updateProfileReferences(profiles, isReferenced, now)
+	nimCache.Status.Profiles = profiles
+
+	nimManifest, err := r.extractNIMManifest(ctx, getManifestConfigName(nimCache), nimCache.GetNamespace())
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get model manifest config file: %w", err)
+	}
+
+	prunedProfiles := []string{}
+	for _, name := range getPrunedProfiles(profiles, nimCache.Spec.Retention, isReferenced, now.Time) {
+		// Locally built engines are not downloaded from a known repository
+		if len(nimManifest.GetProfileSources(name)) == 0 {
+			logger.V(2).Info("skipping deletion of profile without model sources", "profile", name)
+			continue
+		}
+		prunedProfiles = append(prunedProfiles, name)
+	}
+
+	// The cleanup job also deletes dangling model files and measures the disk usage when no profile is pruned
+	job, err = r.constructCleanupJob(nimCache, prunedProfiles, getPrunedPaths(nimManifest, profiles, prunedProfiles))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := controllerutil.SetControllerReference(nimCache, job, r.GetScheme()); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.Create(ctx, job); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create cleanup job %s: %w", job.Name, err)
+	}
+	logger.Info("Created Job for garbage collecting NIM Cache", "job", job.Name, "profiles", prunedProfiles)
+	return ctrl.Result{}, nil
+}
+
+// reconcileCleanupJobStatus removes the deleted profiles from the NIMCache status once the cleanup job completes.
+func (r *NIMCacheReconciler) reconcileCleanupJobStatus(ctx context.Context, nimCache *appsv1alpha1.NIMCache, job *batchv1.Job) error {
+	logger := r.GetLogger()
+
+	prunedProfiles := []string{}
+	if value := job.GetAnnotations()[PrunedProfilesAnnotationKey]; value != "" {
+		prunedProfiles = strings.Split(value, ",")
+	}
+
+	switch {
+	case job.Status.Succeeded > 0:
+		logger.Info("Cleanup job completed", "job", job.Name)
+		profiles := []appsv1alpha1.NIMProfile{}
+		for _, profile := range nimCache.Status.Profiles {
+			if !slices.Contains(prunedProfiles, profile.Name) {
+				profiles = append(profiles, profile)
+			}
+		}
+		nimCache.Status.Profiles = profiles
+
+		usage, err := r.getCleanupStorageUsage(ctx, job)
+		if err != nil {
+			return err
+		}
+		if usage != nil {
+			nimCache.Status.StorageUsage = usage
+		}
+
+		if len(prunedProfiles) > 0 {
+			r.GetEventRecorder().Eventf(nimCache, corev1.EventTypeNormal, ReasonProfilesDeleted, "NIMCache %s: profiles deleted by the retention policy: %s", nimCache.Name, strings.Join(prunedProfiles, ", "))
+		}
+
+	case job.Status.Failed > 0:
+		// Retried on the next garbage collection
+		logger.Info("Failed to garbage collect NIM cache, cleanup job failed", "job", job.Name)
+		r.GetEventRecorder().Eventf(nimCache, corev1.EventTypeWarning, ReasonCleanupFailed, "NIMCache %s: the Job to delete cached profiles has failed", nimCache.Name)
+
+	default:
+		// The job status change triggers the next reconciliation
+		return nil
+	}
+
+	nimCache.Status.LastCleanupTime = ptr.To(metav1.Now())
+	if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getCleanupStorageUsage returns the disk usage reported by the cleanup job in its termination message.
+func (r *NIMCacheReconciler) getCleanupStorageUsage(ctx context.Context, job *batchv1.Job) (*apiResource.Quantity, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(job.GetNamespace()), client.MatchingLabels{batchv1.JobNameLabel: job.GetName()}); err != nil {
+		return nil, fmt.Errorf("failed to list pods of job %s: %w", job.Name, err)
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodSucceeded {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name != NIMCacheContainerName || status.State.Terminated == nil {
+				continue
+			}
+			usageKiB, err := strconv.ParseInt(strings.TrimSpace(status.State.Terminated.Message), 10, 64)
+			if err != nil {
+				r.GetLogger().Error(err, "invalid storage usage reported by cleanup job", "pod", pod.Name)
+				return nil, nil
+			}
+			return apiResource.NewQuantity(usageKiB*1024, apiResource.BinarySI), nil
+		}
+	}
+	return nil, nil
+}
+
+// getReferencedProfiles returns the profiles of the NIMCache used by NIMServices and NIMBuilds, or true if
+// a NIMService or NIMBuild may use any of its profiles.
+func (r *NIMCacheReconciler) getReferencedProfiles(ctx context.Context, nimCache *appsv1alpha1.NIMCache) (bool, []string, error) {
+	profiles := []string{}
+
+	// NIMServices may use a NIMCache from another namespace
+	nimServices := &appsv1alpha1.NIMServiceList{}
+	if err := r.List(ctx, nimServices); err != nil {
+		return false, nil, fmt.Errorf("failed to list NIMServices: %w", err)
+	}
+	for _, nimService := range nimServices.Items {
+		// The additional models and the LoRA adapters use NIMCaches in the namespace of the NIMService
+		if nimService.GetNamespace() == nimCache.GetNamespace() {
+			// LoRA adapters are cached regardless of the profiles
+			if slices.Contains(nimService.GetLoRANIMCacheNames(), nimCache.GetName()) {
+				return true, nil, nil
+			}
+			for _, model := range nimService.GetMultiModels() {
+				if model.NIMCache.Name != nimCache.GetName() {
+					continue
+				}
+				// The profile is selected by the NIM at runtime
+				if model.NIMCache.Profile == "" {
+					return true, nil, nil
+				}
+				profiles = append(profiles, model.NIMCache.Profile)
+			}
+		}
+
+		if nimService.GetNIMCacheName() != nimCache.GetName() || nimService.GetNIMCacheNamespace() != nimCache.GetNamespace() {
+			continue
+		}
+		// The profile is selected by the NIM at runtime
+		if nimService.GetNIMCacheProfile() == "" {
+			return true, nil, nil
+		}
+		profiles = append(profiles, nimService.GetNIMCacheProfile())
+		if rollout := nimService.Status.Rollout; rollout != nil {
+			for _, revision := range []*appsv1alpha1.NIMServiceRevision{rollout.StableRevision, rollout.CandidateRevision} {
+				if revision != nil && revision.Profile != "" {
+					profiles = append(profiles, revision.Profile)
+				}
+			}
+		}
+	}
+
+	nimBuilds := &appsv1alpha1.NIMBuildList{}
+	if err := r.List(ctx, nimBuilds, client.InNamespace(nimCache.GetNamespace())); err != nil {
+		return false, nil, fmt.Errorf("failed to list NIMBuilds: %w", err)
+	}
+	for _, nimBuild := range nimBuilds.Items {
+		if nimBuild.Spec.NIMCache.Name != nimCache.GetName() {
+			continue
+		}
+		// The buildable profile is not selected yet
+		if nimBuild.GetProfile() == "" && nimBuild.Status.InputProfile.Name == "" {
+			return true, nil, nil
+		}
+		for _, name := range []string{nimBuild.GetProfile(), nimBuild.Status.InputProfile.Name, nimBuild.Status.OutputProfile.Name} {
+			if name != "" {
+				profiles = append(profiles, name)
+			}
+		}
+	}
+
+	slices.Sort(profiles)
+	return false, slices.Compact(profiles), nil
+}
+
+// constructCleanupJob constructs the job deleting the model files of the pruned profiles from the PVC.
+func (r *NIMCacheReconciler) constructCleanupJob(nimCache *appsv1alpha1.NIMCache, prunedProfiles, prunedPaths []string) (*batchv1.Job, error) {
+	job, err := r.constructCacheVolumeJob(nimCache, getCleanupJobName(nimCache), cleanupScript, []corev1.EnvVar{
+		{
+			Name:  "NIM_CACHE_PRUNE_PATHS",
+			Value: strings.Join(prunedPaths, "\n"),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	job.Annotations = map[string]string{
+		PrunedProfilesAnnotationKey: strings.Join(prunedProfiles, ","),
+	}
+	job.Spec.Template.Spec.Containers[0].TerminationMessagePolicy = corev1.TerminationMessageReadFile
+	return job, nil
+}
+
+// updateProfileReferences records when the profiles were last referenced. Profiles seen unreferenced for
+// the first time start their retention period now.
+func updateProfileReferences(profiles []appsv1alpha1.NIMProfile, isReferenced func(string) bool, now metav1.Time) []appsv1alpha1.NIMProfile {
+	updated := make([]appsv1alpha1.NIMProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		if isReferenced(profile.Name) || profile.LastReferencedTime == nil {
+			profile.LastReferencedTime = ptr.To(now)
+		}
+		updated = append(updated, profile)
+	}
+	return updated
+}
+
+// getPrunedProfiles returns the unreferenced profiles to delete according to the retention policy.
+func getPrunedProfiles(profiles []appsv1alpha1.NIMProfile, retention *appsv1alpha1.NIMCacheRetention, isReferenced func(string) bool, now time.Time) []string {
+	pruned := []string{}
+	if retention == nil {
+		return pruned
+	}
+
+	// Releases of each model, most recent first
+	releases := map[string][]string{}
+	for _, profile := range profiles {
+		if profile.Release != "" && !slices.Contains(releases[profile.Model], profile.Release) {
+			releases[profile.Model] = append(releases[profile.Model], profile.Release)
+		}
+	}
+	for model := range releases {
+		slices.SortFunc(releases[model], func(a, b string) int {
+			return compareReleases(b, a)
+		})
+	}
+
+	for _, profile := range profiles {
+		if isReferenced(profile.Name) {
+			continue
+		}
+		if retention.UnreferencedFor != nil && profile.LastReferencedTime != nil &&
+			now.Sub(profile.LastReferencedTime.Time) >= retention.UnreferencedFor.Duration {
+			pruned = append(pruned, profile.Name)
+			continue
+		}
+		if retention.KeepReleases != nil && profile.Release != "" {
+			modelReleases := releases[profile.Model]
+			if slices.Index(modelReleases, profile.Release) >= int(*retention.KeepReleases) {
+				pruned = append(pruned, profile.Name)
+			}
+		}
+	}
+	slices.Sort(pruned)
+	return pruned
+}
+
+// getPrunedPaths returns the NGC cache snapshots of the pruned profiles which are not shared with the kept profiles.
+func getPrunedPaths(nimManifest nimparser.NIMManifestInterface, profiles []appsv1alpha1.NIMProfile, prunedProfiles []string) []string {
+	keptSources := []string{}
+	for _, profile := range profiles {
+		if !slices.Contains(prunedProfiles, profile.Name) {
+			keptSources = append(keptSources, nimManifest.GetProfileSources(profile.Name)...)
+		}
+	}
+
+	paths := []string{}
+	for _, name := range prunedProfiles {
+		for _, source := range nimManifest.GetProfileSources(name) {
+			if slices.Contains(keptSources, source) {
+				continue
+			}
+			if snapshot := getNGCCacheSnapshotPath(source); snapshot != "" {
+				paths = append(paths, snapshot)
+			}
+		}
+	}
+	slices.Sort(paths)
+	return slices.Compact(paths)
+}
+
+// getNGCCacheSnapshotPath returns the path of an NGC model repository version relative to the NIM cache,
+// e.g. ngc://nim/meta/llama3-8b-instruct:hf-1d54af3 is cached in ngc/hub/models--nim--meta--llama3-8b-instruct/snapshots/hf-1d54af3.
+func getNGCCacheSnapshotPath(source string) string {
+	repo, ok := strings.CutPrefix(source, "ngc://")
+	if !ok {
+		return ""
+	}
+	name, revision, ok := strings.Cut(repo, ":")
+	if !ok || name == "" || revision == "" || strings.Contains(revision, "/") ||
+		slices.Contains(strings.Split(name, "/"), "..") || revision == ".." {
+		return ""
+	}
+	return path.Join("ngc/hub", "models--"+strings.ReplaceAll(name, "/", "--"), "snapshots", revision)
+}
+
+// compareReleases compares two releases as versions, falling back to a lexical comparison.
+func compareReleases(a, b string) int {
+	va, errA := version.ParseGeneric(a)
+	vb, errB := version.ParseGeneric(b)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+	switch {
+	case va.LessThan(vb):
+		return -1
+	case vb.LessThan(va):
+		return 1
+	}
+	return 0
+}
+
+// getNextCleanupTime returns the time of the next garbage collection, the first one runs as soon as the NIMCache is ready.
+func getNextCleanupTime(nimCache *appsv1alpha1.NIMCache) time.Time {
+	if nimCache.Status.LastCleanupTime == nil {
+		return time.Time{}
+	}
+	return nimCache.Status.LastCleanupTime.Add(nimCache.GetRetentionInterval())
+}
+
+func getCleanupJobName(nimCache *appsv1alpha1.NIMCache) string {
+	return fmt.Sprintf("%s-cleanup-job", nimCache.GetName())
+}
@@ -0,0 +1,450 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+	"github.com/NVIDIA/k8s-nim-operator/internal/modelrouter"
+	"github.com/NVIDIA/k8s-nim-operator/internal/render"
+	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
+)
+
+// NIMGatewayReconciler reconciles a NIMGateway object.
+type NIMGatewayReconciler struct {
+	client.Client
+	scheme          *runtime.Scheme
+	log             logr.Logger
+	updater         conditions.Updater
+	discoveryClient discovery.DiscoveryInterface
+	renderer        render.Renderer
+	recorder        record.EventRecorder
+}
+
+// NewNIMGatewayReconciler creates a new reconciler for NIMGateway.
+func NewNIMGatewayReconciler(client client.Client, scheme *runtime.Scheme, updater conditions.Updater, discoveryClient discovery.DiscoveryInterface, renderer render.Renderer, log logr.Logger) *NIMGatewayReconciler {
+	return &NIMGatewayReconciler{
+		Client:          client,
+		scheme:          scheme,
+		updater:         updater,
+		discoveryClient: discoveryClient,
+		renderer:        renderer,
+		log:             log,
+	}
+}
+
+// +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimgateways,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimgateways/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimgateways/finalizers,verbs=update
+// +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimservices,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=serviceaccounts;services;configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
+
+// Reconcile deploys the gateway of the NIMGateway and configures its routes to the selected NIMServices.
+func (r *NIMGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	nimGateway := &appsv1alpha1.NIMGateway{}
+	if err := r.Get(ctx, req.NamespacedName, nimGateway); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "unable to fetch NIMGateway", "name", req.NamespacedName)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The owned objects are garbage collected along with the NIMGateway
+	if !nimGateway.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Reconciling", "NIMGateway", nimGateway.Name)
+	if result, err := r.reconcileNIMGateway(ctx, nimGateway); err != nil {
+		logger.Error(err, "error reconciling NIMGateway", "name", nimGateway.Name)
+		return result, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NIMGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor("nim-gateway-controller")
+	nimGatewayBuilder := ctrl.NewControllerManagedBy(mgr).
+		For(&appsv1alpha1.NIMGateway{}).
+		Owns(&appsv1.Deployment{}).
+		Owns(&corev1.Service{}).
+		Owns(&corev1.ServiceAccount{}).
+		Owns(&corev1.ConfigMap{}).
+		Owns(&networkingv1.Ingress{}).
+		Watches(
+			&appsv1alpha1.NIMService{},
+			handler.EnqueueRequestsFromMapFunc(r.mapNIMServiceToNIMGateway),
+		).
+		WithEventFilter(predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				// Type assert to NIMGateway
+				if oldNIMGateway, ok := e.ObjectOld.(*appsv1alpha1.NIMGateway); ok {
+					newNIMGateway, ok := e.ObjectNew.(*appsv1alpha1.NIMGateway)
+					if ok {
+						// Handle case where object is marked for deletion
+						if !newNIMGateway.ObjectMeta.DeletionTimestamp.IsZero() {
+							return true
+						}
+
+						// Handle only spec updates
+						return !reflect.DeepEqual(oldNIMGateway.Spec, newNIMGateway.Spec)
+					}
+				}
+				// For other types we watch, reconcile them
+				return true
+			},
+		})
+
+	httpRouteCRDExists, err := k8sutil.CRDExists(r.discoveryClient, gatewayv1.SchemeGroupVersion.WithResource("httproutes"))
+	if err != nil {
+		return err
+	}
+	if httpRouteCRDExists {
+		nimGatewayBuilder = nimGatewayBuilder.Owns(&gatewayv1.HTTPRoute{})
+	}
+
+	return nimGatewayBuilder.Complete(r)
+}
+
+// mapNIMServiceToNIMGateway returns the NIMGateways selecting the NIMService.
+func (r *NIMGatewayReconciler) mapNIMServiceToNIMGateway(ctx context.Context, obj client.Object) []ctrl.Request {
+	var nimGateways appsv1alpha1.NIMGatewayList
+	if err := r.List(ctx, &nimGateways, client.InNamespace(obj.GetNamespace())); err != nil {
+		return []ctrl.Request{}
+	}
+
+	requests := []ctrl.Request{}
+	for _, nimGateway := range nimGateways.Items {
+		selector, err := metav1.LabelSelectorAsSelector(&nimGateway.Spec.NIMServiceSelector)
+		if err != nil || !selector.Matches(labels.Set(obj.GetLabels())) {
+			continue
+		}
+		requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Name: nimGateway.Name, Namespace: nimGateway.Namespace}})
+	}
+	return requests
+}
+
+func (r *NIMGatewayReconciler) reconcileNIMGateway(ctx context.Context, nimGateway *appsv1alpha1.NIMGateway) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	var err error
+	defer func() {
+		if err != nil {
+			r.recorder.Eventf(nimGateway, corev1.EventTypeWarning, conditions.Failed,
+				"NIMGateway %s failed, msg: %s", nimGateway.Name, err.Error())
+		}
+	}()
+	namespacedName := types.NamespacedName{Name: nimGateway.GetName(), Namespace: nimGateway.GetNamespace()}
+
+	if image, _ := nimGateway.GetImage(); image == "" {
+		msg := fmt.Sprintf("no gateway image is configured, set spec.image or the %s env of the operator", appsv1alpha1.ModelRouterImageEnv)
+		r.recorder.Eventf(nimGateway, corev1.EventTypeWarning, conditions.Failed, msg)
+		return ctrl.Result{}, r.updater.SetConditionsFailed(ctx, nimGateway, conditions.ReasonGatewayUnavailable, msg)
+	}
+
+	var config *modelrouter.GatewayConfig
+	config, nimGateway.Status.Models, err = r.getGatewayConfig(ctx, nimGateway)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	configData, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Sync serviceaccount
+	err = r.renderAndSyncResource(ctx, nimGateway, &corev1.ServiceAccount{}, func() (client.Object, error) {
+		return r.renderer.ServiceAccount(nimGateway.GetServiceAccountParams())
+	}, "serviceaccount", conditions.ReasonServiceAccountFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Sync gateway config
+	err = r.renderAndSyncResource(ctx, nimGateway, &corev1.ConfigMap{}, func() (client.Object, error) {
+		return r.renderer.ConfigMap(nimGateway.GetConfigMapParams(string(configData)))
+	}, "configmap", conditions.ReasonConfigMapFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Sync service
+	err = r.renderAndSyncResource(ctx, nimGateway, &corev1.Service{}, func() (client.Object, error) {
+		return r.renderer.Service(nimGateway.GetServiceParams())
+	}, "service", conditions.ReasonServiceFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Sync ingress
+	if nimGateway.IsIngressEnabled() {
+		err = r.renderAndSyncResource(ctx, nimGateway, &networkingv1.Ingress{}, func() (client.Object, error) {
+			return r.renderer.Ingress(nimGateway.GetIngressParams())
+		}, "ingress", conditions.ReasonIngressFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		err = k8sutil.CleanupResource(ctx, r.GetClient(), &networkingv1.Ingress{}, namespacedName)
+		if err != nil && !errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Sync gateway routes
+	if nimGateway.IsRouterEnabled() {
+		err = r.renderAndSyncResource(ctx, nimGateway, &gatewayv1.HTTPRoute{}, func() (client.Object, error) {
+			if err := shared.ValidateGatewayRouteResource(r.discoveryClient, "httproutes"); err != nil {
+				return nil, err
+			}
+			return r.renderer.HTTPRoute(nimGateway.GetHTTPRouteParams())
+		}, "httproute", conditions.ReasonHTTPRouteFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		err = k8sutil.CleanupResource(ctx, r.GetClient(), &gatewayv1.HTTPRoute{}, namespacedName)
+		if err != nil && !errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Sync deployment
+	err = r.renderAndSyncResource(ctx, nimGateway, &appsv1.Deployment{}, func() (client.Object, error) {
+		params := nimGateway.GetDeploymentParams()
+		deployment, err := r.renderer.Deployment(params)
+		if err != nil {
+			return nil, err
+		}
+		// The deployment template only renders the command and args of the init containers
+		deployment.Spec.Template.Spec.Containers[0].Command = params.Command
+		deployment.Spec.Template.Spec.Containers[0].Args = params.Args
+		return deployment, nil
+	}, "deployment", conditions.ReasonDeploymentFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Wait for deployment
+	msg, ready, err := k8sutil.IsDeploymentReady(ctx, r.GetClient(), &namespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	switch {
+	case !ready:
+		err = r.updater.SetConditionsNotReady(ctx, nimGateway, conditions.NotReady, msg)
+		r.recorder.Eventf(nimGateway, corev1.EventTypeNormal, conditions.NotReady,
+			"NIMGateway %s not ready yet, msg: %s", nimGateway.Name, msg)
+	case len(nimGateway.Status.Models) == 0:
+		msg = "none of the selected NIMServices serves a model yet"
+		err = r.updater.SetConditionsNotReady(ctx, nimGateway, conditions.ReasonNoModelsRouted, msg)
+		r.recorder.Eventf(nimGateway, corev1.EventTypeNormal, conditions.NotReady,
+			"NIMGateway %s not ready yet, msg: %s", nimGateway.Name, msg)
+	default:
+		err = r.updater.SetConditionsReady(ctx, nimGateway, conditions.Ready, msg)
+		r.recorder.Eventf(nimGateway, corev1.EventTypeNormal, conditions.Ready,
+			"NIMGateway %s ready, msg: %s", nimGateway.Name, msg)
+	}
+	if err != nil {
+		logger.Error(err, "Unable to update status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// getGatewayConfig returns the gateway config routing each model served by the ready NIMServices
+// selected by the NIMGateway, along with the resulting model statuses.
+func (r *NIMGatewayReconciler) getGatewayConfig(ctx context.Context, nimGateway *appsv1alpha1.NIMGateway) (*modelrouter.GatewayConfig, []appsv1alpha1.NIMGatewayModelStatus, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&nimGateway.Spec.NIMServiceSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid NIMService selector: %w", err)
+	}
+	var nimServices appsv1alpha1.NIMServiceList
+	if err := r.List(ctx, &nimServices, client.InNamespace(nimGateway.GetNamespace()), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(nimServices.Items, func(i, j int) bool {
+		return nimServices.Items[i].Name < nimServices.Items[j].Name
+	})
+
+	endpoints := map[string]string{}
+	modelNIMServices := map[string][]string{}
+	for _, nimService := range nimServices.Items {
+		if nimService.Status.State != appsv1alpha1.NIMServiceStatusReady || nimService.Status.Model == nil || nimService.Status.Model.ClusterEndpoint == "" {
+			continue
+		}
+		endpoints[nimService.Name] = fmt.Sprintf("http://%s", nimService.Status.Model.ClusterEndpoint)
+		for _, model := range getServedModelNames(nimService.Status.Model) {
+			modelNIMServices[model] = append(modelNIMServices[model], nimService.Name)
+		}
+	}
+
+	// Order the NIMServices of the routed models, the listed ones first
+	for _, route := range nimGateway.Spec.Routes {
+		served, ok := modelNIMServices[route.Model]
+		if !ok {
+			continue
+		}
+		ordered := make([]string, 0, len(served))
+		for _, name := range route.NIMServices {
+			if slices.Contains(served, name) && !slices.Contains(ordered, name) {
+				ordered = append(ordered, name)
+			}
+		}
+		for _, name := range served {
+			if !slices.Contains(ordered, name) {
+				ordered = append(ordered, name)
+			}
+		}
+		modelNIMServices[route.Model] = ordered
+	}
+
+	models := make([]string, 0, len(modelNIMServices))
+	for model := range modelNIMServices {
+		models = append(models, model)
+	}
+	sort.Strings(models)
+
+	config := &modelrouter.GatewayConfig{Routes: []modelrouter.GatewayRoute{}}
+	var statuses []appsv1alpha1.NIMGatewayModelStatus
+	for _, model := range models {
+		route := modelrouter.GatewayRoute{Model: model}
+		for _, name := range modelNIMServices[model] {
+			route.Backends = append(route.Backends, endpoints[name])
+		}
+		config.Routes = append(config.Routes, route)
+		statuses = append(statuses, appsv1alpha1.NIMGatewayModelStatus{Name: model, NIMServices: modelNIMServices[model]})
+	}
+	if nimGateway.Spec.RateLimit != nil {
+		config.RateLimit = &modelrouter.GatewayRateLimit{
+			RequestsPerMinute: nimGateway.Spec.RateLimit.RequestsPerMinute,
+			Burst:             nimGateway.GetRateLimitBurst(),
+		}
+	}
+	return config, statuses, nil
+}
+
+// getServedModelNames returns the names of the models served by a NIMService, including its LoRA adapters.
+func getServedModelNames(model *appsv1alpha1.ModelStatus) []string {
+	var names []string
+	add := func(name string) {
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	add(model.Name)
+	for _, servedModel := range model.Models {
+		add(servedModel.Name)
+	}
+	for _, adapter := range model.LoRAAdapters {
+		add(adapter)
+	}
+	return names
+}
+
+func (r *NIMGatewayReconciler) renderAndSyncResource(ctx context.Context, nimGateway *appsv1alpha1.NIMGateway, obj client.Object, renderFunc func() (client.Object, error), conditionType string, reason string) error {
+	logger := log.FromContext(ctx)
+
+	resource, err := renderFunc()
+	if err != nil {
+		logger.Error(err, "failed to render", "conditionType", conditionType)
+		statusError := r.updater.SetConditionsFailed(ctx, nimGateway, reason, err.Error())
+		if statusError != nil {
+			logger.Error(statusError, "failed to update status", "NIMGateway", nimGateway.GetName())
+		}
+		return err
+	}
+
+	// Check if the resource is nil
+	if resource == nil {
+		logger.V(2).Info("rendered nil resource")
+		return nil
+	}
+
+	metaAccessor, ok := resource.(metav1.Object)
+	if !ok || metaAccessor.GetName() == "" || metaAccessor.GetNamespace() == "" {
+		logger.V(2).Info("rendered un-initialized resource")
+		return nil
+	}
+
+	namespacedName := types.NamespacedName{Name: metaAccessor.GetName(), Namespace: metaAccessor.GetNamespace()}
+	err = r.Get(ctx, namespacedName, obj)
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(err, fmt.Sprintf("Error is not NotFound for %s: %v", obj.GetObjectKind(), err))
+		return err
+	}
+
+	if err = controllerutil.SetControllerReference(nimGateway, resource, r.scheme); err != nil {
+		logger.Error(err, "failed to set owner", conditionType, namespacedName)
+		statusError := r.updater.SetConditionsFailed(ctx, nimGateway, reason, err.Error())
+		if statusError != nil {
+			logger.Error(statusError, "failed to update status", "NIMGateway", nimGateway.GetName())
+		}
+		return err
+	}
+
+	err = k8sutil.SyncResource(ctx, r.GetClient(), obj, resource)
+	if err != nil {
+		logger.Error(err, "failed to sync", conditionType, namespacedName)
+		statusError := r.updater.SetConditionsFailed(ctx, nimGateway, reason, err.Error())
+		if statusError != nil {
+			logger.Error(statusError, "failed to update status", "NIMGateway", nimGateway.GetName())
+		}
+		return err
+	}
+	return nil
+}
+
+// GetClient returns the client instance.
+func (r *NIMGatewayReconciler) GetClient() client.Client {
+	return r.Client
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/modelrouter"
+	"github.com/NVIDIA/k8s-nim-operator/internal/render"
+)
+
+var _ = Describe("NIMGateway Controller", func() {
+	var (
+		reconciler *NIMGatewayReconciler
+		client     crclient.Client
+		nimGateway *appsv1alpha1.NIMGateway
+	)
+
+	newNIMService := func(name string, labels map[string]string, state, endpoint string, models ...string) *appsv1alpha1.NIMService {
+		nimService := &appsv1alpha1.NIMService{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Status:     appsv1alpha1.NIMServiceStatus{State: state},
+		}
+		if len(models) > 0 {
+			nimService.Status.Model = &appsv1alpha1.ModelStatus{Name: models[0], ClusterEndpoint: endpoint}
+			for _, model := range models {
+				nimService.Status.Model.Models = append(nimService.Status.Model.Models, appsv1alpha1.ServedModelStatus{Name: model})
+			}
+		}
+		return nimService
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(appsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(networkingv1.AddToScheme(scheme)).To(Succeed())
+		Expect(gatewayv1.AddToScheme(scheme)).To(Succeed())
+
+		gatewayLabels := map[string]string{"gateway": "llm"}
+		client = fake.NewClientBuilder().WithScheme(scheme).
+			WithStatusSubresource(&appsv1alpha1.NIMGateway{}).
+			WithStatusSubresource(&appsv1.Deployment{}).
+			WithObjects(
+				newNIMService("llama-a100", gatewayLabels, appsv1alpha1.NIMServiceStatusReady, "10.0.0.1:8000", "meta/llama-3.1-8b-instruct"),
+				newNIMService("llama-h100", gatewayLabels, appsv1alpha1.NIMServiceStatusReady, "10.0.0.2:8000", "meta/llama-3.1-8b-instruct", "meta/llama-3.1-8b-instruct-lora"),
+				newNIMService("mistral", gatewayLabels, appsv1alpha1.NIMServiceStatusReady, "10.0.0.3:8000", "mistralai/mistral-7b-instruct-v0.3"),
+				newNIMService("mistral-pending", gatewayLabels, appsv1alpha1.NIMServiceStatusNotReady, "", "mistralai/mistral-7b-instruct-v0.3"),
+				newNIMService("unselected", nil, appsv1alpha1.NIMServiceStatusReady, "10.0.0.4:8000", "google/gemma-2-9b-it"),
+			).
+			Build()
+
+		manifestsDir, err := filepath.Abs("../../manifests")
+		Expect(err).ToNot(HaveOccurred())
+		reconciler = &NIMGatewayReconciler{
+			Client:   client,
+			scheme:   scheme,
+			updater:  conditions.NewUpdater(client),
+			renderer: render.NewRenderer(manifestsDir),
+			recorder: record.NewFakeRecorder(1000),
+		}
+
+		nimGateway = &appsv1alpha1.NIMGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "llm-gateway", Namespace: "default"},
+			Spec: appsv1alpha1.NIMGatewaySpec{
+				NIMServiceSelector: metav1.LabelSelector{MatchLabels: gatewayLabels},
+				Routes: []appsv1alpha1.NIMGatewayRoute{
+					{Model: "meta/llama-3.1-8b-instruct", NIMServices: []string{"llama-h100"}},
+				},
+				Auth:      &appsv1alpha1.NIMGatewayAuth{APIKeysSecret: "gateway-api-keys"},
+				RateLimit: &appsv1alpha1.NIMGatewayRateLimit{RequestsPerMinute: 600},
+				Image:     &appsv1alpha1.Image{Repository: "nvcr.io/nvidia/k8s-nim-operator", Tag: "v3.0.0", PullSecrets: []string{"ngc-secret"}},
+				Expose: appsv1alpha1.ExposeV1{
+					Service: appsv1alpha1.Service{Type: corev1.ServiceTypeClusterIP, Port: ptr.To[int32](8000)},
+				},
+				Replicas: 2,
+			},
+		}
+		Expect(client.Create(context.TODO(), nimGateway)).To(Succeed())
+	})
+
+	It("should route the models of the selected ready NIMServices in fallback order", func() {
+		namespacedName := types.NamespacedName{Name: nimGateway.Name, Namespace: nimGateway.Namespace}
+		_, err := reconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: namespacedName})
+		Expect(err).ToNot(HaveOccurred())
+
+		configMap := &corev1.ConfigMap{}
+		Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimGateway.GetConfigMapName(), Namespace: "default"}, configMap)).To(Succeed())
+		config := modelrouter.GatewayConfig{}
+		Expect(json.Unmarshal([]byte(configMap.Data[appsv1alpha1.NIMGatewayConfigFile]), &config)).To(Succeed())
+		Expect(config.Routes).To(Equal([]modelrouter.GatewayRoute{
+			{Model: "meta/llama-3.1-8b-instruct", Backends: []string{"http://10.0.0.2:8000", "http://10.0.0.1:8000"}},
+			{Model: "meta/llama-3.1-8b-instruct-lora", Backends: []string{"http://10.0.0.2:8000"}},
+			{Model: "mistralai/mistral-7b-instruct-v0.3", Backends: []string{"http://10.0.0.3:8000"}},
+		}))
+		Expect(config.RateLimit).To(Equal(&modelrouter.GatewayRateLimit{RequestsPerMinute: 600, Burst: 600}))
+
+		deployment := &appsv1.Deployment{}
+		Expect(client.Get(context.TODO(), namespacedName, deployment)).To(Succeed())
+		Expect(*deployment.Spec.Replicas).To(Equal(int32(2)))
+		container := deployment.Spec.Template.Spec.Containers[0]
+		Expect(container.Image).To(Equal("nvcr.io/nvidia/k8s-nim-operator:v3.0.0"))
+		Expect(container.Command).To(Equal([]string{appsv1alpha1.DefaultNIMGatewayCommand}))
+		Expect(container.Args).To(ContainElement("--api-keys-dir=" + appsv1alpha1.NIMGatewayAPIKeysPath))
+		Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Secret.SecretName", "gateway-api-keys")))
+		Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("ConfigMap.Name", nimGateway.GetConfigMapName())))
+
+		service := &corev1.Service{}
+		Expect(client.Get(context.TODO(), namespacedName, service)).To(Succeed())
+		Expect(service.Spec.Ports[0].Port).To(Equal(int32(8000)))
+
+		updated := &appsv1alpha1.NIMGateway{}
+		Expect(client.Get(context.TODO(), namespacedName, updated)).To(Succeed())
+		Expect(updated.Status.Models).To(Equal([]appsv1alpha1.NIMGatewayModelStatus{
+			{Name: "meta/llama-3.1-8b-instruct", NIMServices: []string{"llama-h100", "llama-a100"}},
+			{Name: "meta/llama-3.1-8b-instruct-lora", NIMServices: []string{"llama-h100"}},
+			{Name: "mistralai/mistral-7b-instruct-v0.3", NIMServices: []string{"mistral"}},
+		}))
+		// The gateway deployment is not available yet
+		Expect(updated.Status.State).To(Equal(appsv1alpha1.NIMGatewayStatusNotReady))
+	})
+
+	It("should reconcile the NIMGateways selecting a NIMService", func() {
+		requests := reconciler.mapNIMServiceToNIMGateway(context.TODO(), newNIMService("llama-b200", map[string]string{"gateway": "llm"}, "", ""))
+		Expect(requests).To(ConsistOf(reconcile.Request{NamespacedName: types.NamespacedName{Name: nimGateway.Name, Namespace: "default"}}))
+
+		requests = reconciler.mapNIMServiceToNIMGateway(context.TODO(), newNIMService("unselected", nil, "", ""))
+		Expect(requests).To(BeEmpty())
+	})
+})
@@ -20,9 +20,11 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -57,6 +59,7 @@ const (
 // +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimpipelines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimpipelines/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimservices,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps.nvidia.com,resources=nemoguardrails;nemoevaluators;nemocustomizers;nemodatastores;nemoentitystores,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -123,100 +126,145 @@ func (r *NIMPipelineReconciler) cleanupNIMPipeline(ctx context.Context, nimPipel
 
 func (r *NIMPipelineReconciler) reconcileNIMPipeline(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
-	// Track enabled NIMServices
-	enabledServices := make(map[string]bool)
+
+	// Order services so that upstream services are deployed before their dependents
+	members, err := sortPipelineMembers(nimPipeline.GetMembers())
+	if err != nil {
+		logger.Error(err, "Invalid NIMPipeline dependencies", "name", nimPipeline.Name)
+		return ctrl.Result{}, r.updateFailedStatus(ctx, nimPipeline, appsv1alpha1.NIMPipelineReasonInvalidDependencies, err.Error())
+	}
+	membersByName := getPipelineMembersByName(members)
+
+	// Track services held back by their dependencies
+	pendingServices := make(map[string]bool)
 
 	// Process each service specification in the pipeline
-	for _, service := range nimPipeline.Spec.Services {
-		enabled := service.Enabled != nil && *service.Enabled
-		enabledServices[service.Name] = enabled
+	for _, member := range members {
+		if !member.Enabled {
+			continue
+		}
 
-		if !enabled {
+		ready, err := r.areDependenciesReady(ctx, nimPipeline, member, membersByName)
+		if err != nil {
+			logger.Error(err, "Failed to check service dependencies", "kind", member.Kind, "name", member.Name)
+			continue
+		}
+		if !ready {
+			logger.V(2).Info("Waiting for service dependencies to be ready", "kind", member.Kind, "name", member.Name)
+			pendingServices[member.Name] = true
 			continue
 		}
 
-		if err := r.reconcileNIMService(ctx, nimPipeline, service); err != nil {
-			logger.Error(err, "Failed to reconcile NIMService", "name", service.Name)
+		if err := r.reconcileMember(ctx, nimPipeline, member, membersByName); err != nil {
+			logger.Error(err, "Failed to reconcile service", "kind", member.Kind, "name", member.Name)
 			continue
 		}
 	}
 
-	// Update status of NIMPipeline based on the status of related NIMServices
-	if err := r.updateStatus(ctx, nimPipeline, enabledServices); err != nil {
+	// Update status of NIMPipeline based on the status of related services
+	if err := r.updateStatus(ctx, nimPipeline, members, pendingServices); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	// Clean up disabled NIMServices
-	if err := r.cleanupDisabledNIMs(ctx, nimPipeline, enabledServices); err != nil {
+	// Clean up disabled services
+	if err := r.cleanupDisabledMembers(ctx, nimPipeline, members); err != nil {
 		return ctrl.Result{}, err
 	}
 
+	// Requeue for the next verification of a ready pipeline
+	if nimPipeline.Spec.Verification != nil && nimPipeline.Status.State == appsv1alpha1.NIMServiceStatusReady {
+		_, requeueAfter := nextPipelineVerification(nimPipeline, time.Now())
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
-func (r *NIMPipelineReconciler) injectDependencies(nimService *appsv1alpha1.NIMService, dependencies []appsv1alpha1.ServiceDependency) {
+func (r *NIMPipelineReconciler) injectDependencies(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, obj pipelineObject, dependencies []appsv1alpha1.ServiceDependency, members map[string]appsv1alpha1.NIMPipelineMember) error {
+	// Endpoints of the first upstream service of each kind in the pipeline
+	endpoints := make(map[string]string)
+
 	for _, dep := range dependencies {
-		var serviceEnvVars []corev1.EnvVar
-
-		// Check if custom environment variable names and values are provided and inject them
-		if dep.EnvName != "" && dep.EnvValue != "" {
-			// Use custom environment variables
-			serviceEnvVars = []corev1.EnvVar{
-				{
-					Name:  dep.EnvName,
-					Value: dep.EnvValue,
-				},
+		if upstream, ok := members[dep.Name]; ok && endpoints[upstream.Kind] == "" {
+			serviceDep := dep
+			serviceDep.EnvValue = ""
+			endpoint, err := r.getDependencyEndpoint(ctx, nimPipeline, serviceDep, members)
+			if err != nil {
+				return err
 			}
-			// Merge and inject the environment variables
-			nimService.Spec.Env = utils.MergeEnvVars(nimService.Spec.Env, serviceEnvVars)
+			endpoints[upstream.Kind] = endpoint
+		}
+
+		// Dependencies without an environment variable only order the deployment
+		if dep.EnvName == "" {
+			continue
+		}
+
+		// Use the custom endpoint value if provided, or generate it from the upstream service
+		endpoint, err := r.getDependencyEndpoint(ctx, nimPipeline, dep, members)
+		if err != nil {
+			return err
 		}
+		serviceEnvVars := []corev1.EnvVar{
+			{
+				Name:  dep.EnvName,
+				Value: endpoint,
+			},
+		}
+		// Merge and inject the environment variables
+		mergePipelineObjectEnv(obj, serviceEnvVars)
 	}
+
+	// Fill unset upstream endpoints of NeMo microservices
+	wirePipelineEndpoints(obj, endpoints)
+	return nil
 }
 
-func (r *NIMPipelineReconciler) reconcileNIMService(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, service appsv1alpha1.NIMServicePipelineSpec) error {
+func (r *NIMPipelineReconciler) reconcileMember(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, member appsv1alpha1.NIMPipelineMember, members map[string]appsv1alpha1.NIMPipelineMember) error {
 	logger := log.FromContext(ctx)
 
-	nimService := &appsv1alpha1.NIMService{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      service.Name,
-			Namespace: nimPipeline.Namespace,
-		},
-		Spec: service.Spec,
+	desired, err := buildPipelineObject(nimPipeline, member)
+	if err != nil {
+		return err
 	}
 
 	// Inject service dependencies
-	r.injectDependencies(nimService, service.Dependencies)
+	if err := r.injectDependencies(ctx, nimPipeline, desired, member.Dependencies, members); err != nil {
+		return err
+	}
 
-	// Set NIMPipeline as the owner and controller of the NIMService
-	if err := controllerutil.SetControllerReference(nimPipeline, nimService, r.Scheme); err != nil {
+	// Set NIMPipeline as the owner and controller of the service
+	if err := controllerutil.SetControllerReference(nimPipeline, desired, r.Scheme); err != nil {
 		return err
 	}
 
-	// Sync NIMService with the desired spec
-	namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
-	err := r.syncResource(ctx, namespacedName, nimPipeline, nimService)
+	// Sync the service with the desired spec
+	err = r.syncResource(ctx, nimPipeline, member.Kind, desired)
 	if err != nil {
-		logger.Error(err, "Failed to sync NIMService", "name", nimService.Name)
+		logger.Error(err, "Failed to sync service", "kind", member.Kind, "name", desired.GetName())
 		return err
 	}
 
 	return nil
 }
 
-func (r *NIMPipelineReconciler) syncResource(ctx context.Context, currentNamespacedName types.NamespacedName, nimPipeline *appsv1alpha1.NIMPipeline, desired *appsv1alpha1.NIMService) error {
+func (r *NIMPipelineReconciler) syncResource(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, kind string, desired pipelineObject) error {
 	logger := log.FromContext(ctx)
 
-	current := &appsv1alpha1.NIMService{}
-	err := r.Get(ctx, currentNamespacedName, current)
+	current, err := newPipelineObject(kind)
+	if err != nil {
+		return err
+	}
+	err = r.Get(ctx, client.ObjectKeyFromObject(desired), current)
 	if err != nil && !errors.IsNotFound(err) {
 		return err
 	}
 
 	if !utils.IsSpecChanged(current, desired) {
-		logger.V(2).Info("NIMService spec has not changed, skipping update", "obj", current)
+		logger.V(2).Info("Service spec has not changed, skipping update", "kind", kind, "obj", current)
 		return nil
 	}
-	logger.V(2).Info("NIMService spec has changed, updating")
+	logger.V(2).Info("Service spec has changed, updating", "kind", kind)
 
 	if errors.IsNotFound(err) {
 		// Resource doesn't exist, so create it
@@ -225,13 +273,13 @@ func (r *NIMPipelineReconciler) syncResource(ctx context.Context, currentNamespa
 			return err
 		}
 	} else {
-		// Resource exists, so update it only if the current nimservice is owned by the pipeline
+		// Resource exists, so update it only if the current service is owned by the pipeline
 		if owned, _ := controllerutil.HasOwnerReference(current.GetOwnerReferences(), nimPipeline, r.Scheme); !owned {
-			return fmt.Errorf("NIMservice %s already exists and is not owned by the NIMPipeline %s", current.Name, nimPipeline.Name)
+			return fmt.Errorf("%s %s already exists and is not owned by the NIMPipeline %s", kind, current.GetName(), nimPipeline.Name)
 		}
 
 		// Ensure the resource version is carried over to the desired object
-		desired.ResourceVersion = current.ResourceVersion
+		desired.SetResourceVersion(current.GetResourceVersion())
 
 		err = r.Update(ctx, desired)
 		if err != nil {
@@ -241,28 +289,48 @@ func (r *NIMPipelineReconciler) syncResource(ctx context.Context, currentNamespa
 	return nil
 }
 
-func (r *NIMPipelineReconciler) cleanupDisabledNIMs(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, enabledServices map[string]bool) error {
+func (r *NIMPipelineReconciler) cleanupDisabledMembers(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, members []appsv1alpha1.NIMPipelineMember) error {
 	logger := log.FromContext(ctx)
 
-	serviceList := &appsv1alpha1.NIMServiceList{}
-	if err := r.List(ctx, serviceList, client.InNamespace(nimPipeline.Namespace)); err != nil {
-		logger.Error(err, "Failed to list NIMServices")
-		return err
+	enabledServices := make(map[string]bool)
+	for _, member := range members {
+		if member.Enabled {
+			enabledServices[member.Kind+"/"+member.Name] = true
+		}
 	}
 
 	var allErrors []error
 
-	for _, svc := range serviceList.Items {
-		// Ignore NIM services not owned by the NIM pipeline
-		if owned, _ := controllerutil.HasOwnerReference(svc.GetOwnerReferences(), nimPipeline, r.Scheme); !owned {
-			continue
+	for _, kind := range pipelineMemberKinds {
+		list, err := newPipelineObjectList(kind)
+		if err != nil {
+			return err
+		}
+		if err := r.List(ctx, list, client.InNamespace(nimPipeline.Namespace)); err != nil {
+			logger.Error(err, "Failed to list services", "kind", kind)
+			return err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return err
 		}
 
-		// Cleanup any stale NIM services if they were previously part of the pipeline but are removed/disabled
-		if enabled, exists := enabledServices[svc.Name]; !exists || !enabled {
-			if err := r.deleteService(ctx, &svc); err != nil {
-				logger.Error(err, "Unable to delete disabled NIM service", "Name", svc.Name)
-				allErrors = append(allErrors, fmt.Errorf("failed to delete service %s: %w", svc.Name, err))
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+			// Ignore services not owned by the NIM pipeline
+			if owned, _ := controllerutil.HasOwnerReference(obj.GetOwnerReferences(), nimPipeline, r.Scheme); !owned {
+				continue
+			}
+
+			// Cleanup any stale services if they were previously part of the pipeline but are removed/disabled
+			if !enabledServices[kind+"/"+obj.GetName()] {
+				if err := r.deleteService(ctx, kind, obj); err != nil {
+					logger.Error(err, "Unable to delete disabled service", "kind", kind, "Name", obj.GetName())
+					allErrors = append(allErrors, fmt.Errorf("failed to delete %s %s: %w", kind, obj.GetName(), err))
+				}
 			}
 		}
 	}
@@ -275,39 +343,40 @@ func (r *NIMPipelineReconciler) cleanupDisabledNIMs(ctx context.Context, nimPipe
 	return nil
 }
 
-func (r *NIMPipelineReconciler) updateStatus(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, enabledServices map[string]bool) error {
+func (r *NIMPipelineReconciler) updateStatus(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, members []appsv1alpha1.NIMPipelineMember, pendingServices map[string]bool) error {
 	logger := log.FromContext(ctx)
 
-	// List NIMServices in the pipeline's namespace
-	serviceList := &appsv1alpha1.NIMServiceList{}
-	if err := r.List(ctx, serviceList, client.InNamespace(nimPipeline.Namespace)); err != nil {
-		logger.Error(err, "Failed to list NIMServices")
-		return err
-	}
-
 	// Default overall state to "NotReady"
 	overallState := appsv1alpha1.NIMServiceStatusNotReady
 	serviceStates := make(map[string]string)
 	allServicesReady := true
 
-	// Track which enabled services are found
-	foundServices := make(map[string]bool)
-
-	for _, svc := range serviceList.Items {
-		if enabled, exists := enabledServices[svc.Name]; !exists || !enabled {
+	for _, member := range members {
+		if !member.Enabled {
 			continue
 		}
-		if owned, _ := controllerutil.HasOwnerReference(svc.GetOwnerReferences(), nimPipeline, r.Scheme); !owned {
+
+		obj, err := r.getPipelineObject(ctx, nimPipeline, member)
+		if err != nil {
+			logger.Error(err, "Failed to get service", "kind", member.Kind, "name", member.Name)
+			return err
+		}
+		if obj == nil {
+			// A required service is missing, mark as "Pending" if held back by its dependencies or "NotReady"
+			allServicesReady = false
+			if pendingServices[member.Name] {
+				serviceStates[member.Name] = appsv1alpha1.NIMServiceStatusPending
+			} else {
+				serviceStates[member.Name] = appsv1alpha1.NIMServiceStatusNotReady
+			}
 			continue
 		}
 
-		// Mark the service as found
-		foundServices[svc.Name] = true
-
 		// Update service states
-		serviceStates[svc.Name] = svc.Status.State
+		state := getPipelineObjectState(obj)
+		serviceStates[member.Name] = state
 
-		switch svc.Status.State {
+		switch state {
 		case appsv1alpha1.NIMServiceStatusReady:
 			// Leave the overall status as is
 		case appsv1alpha1.NIMServiceStatusFailed:
@@ -320,15 +389,6 @@ func (r *NIMPipelineReconciler) updateStatus(ctx context.Context, nimPipeline *a
 		}
 	}
 
-	// Check if any enabled services are missing
-	for serviceName, enabled := range enabledServices {
-		if enabled && !foundServices[serviceName] {
-			// A required service is missing, mark as "NotReady"
-			allServicesReady = false
-			serviceStates[serviceName] = appsv1alpha1.NIMServiceStatusNotReady
-		}
-	}
-
 	// If all services are ready and no failures were detected, set the overall state to "Ready"
 	if allServicesReady && overallState != appsv1alpha1.NIMServiceStatusFailed {
 		overallState = appsv1alpha1.NIMServiceStatusReady
@@ -337,6 +397,10 @@ func (r *NIMPipelineReconciler) updateStatus(ctx context.Context, nimPipeline *a
 	// Update the NIMPipeline status
 	nimPipeline.Status.State = overallState
 	nimPipeline.Status.States = serviceStates
+	meta.RemoveStatusCondition(&nimPipeline.Status.Conditions, appsv1alpha1.NIMPipelineConditionFailed)
+
+	// Verify the pipeline end to end once all services are ready
+	r.verifyPipeline(ctx, nimPipeline, overallState == appsv1alpha1.NIMServiceStatusReady)
 
 	r.GetEventRecorder().Eventf(nimPipeline, corev1.EventTypeNormal, overallState,
 		"NIMPipeline %s status %s, service states %v", nimPipeline.Name, overallState, serviceStates)
@@ -357,11 +421,40 @@ func (r *NIMPipelineReconciler) updateStatus(ctx context.Context, nimPipeline *a
 	})
 }
 
-func (r *NIMPipelineReconciler) deleteService(ctx context.Context, svc *appsv1alpha1.NIMService) error {
+// updateFailedStatus marks the NIMPipeline as failed without reconciling its services.
+func (r *NIMPipelineReconciler) updateFailedStatus(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, reason, message string) error {
+	logger := log.FromContext(ctx)
+
+	r.GetEventRecorder().Eventf(nimPipeline, corev1.EventTypeWarning, reason, "NIMPipeline %s failed: %s", nimPipeline.Name, message)
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		obj := &appsv1alpha1.NIMPipeline{}
+		errGet := r.Get(ctx, types.NamespacedName{Name: nimPipeline.Name, Namespace: nimPipeline.GetNamespace()}, obj)
+		if errGet != nil {
+			logger.Error(errGet, "error getting NIMPipeline", "name", nimPipeline.Name)
+			return errGet
+		}
+		obj.Status.State = appsv1alpha1.NIMPipelineStatusFailed
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:    appsv1alpha1.NIMPipelineConditionFailed,
+			Status:  metav1.ConditionTrue,
+			Reason:  reason,
+			Message: message,
+		})
+		if err := r.Status().Update(ctx, obj); err != nil {
+			logger.Error(err, "Failed to update status", "NIMPipeline", nimPipeline.Name)
+			return err
+		}
+		nimPipeline.Status = obj.Status
+		return nil
+	})
+}
+
+func (r *NIMPipelineReconciler) deleteService(ctx context.Context, kind string, svc client.Object) error {
 	logger := log.FromContext(ctx)
-	logger.Info("Deleting NIMService", "name", svc.Name, "namespace", svc.Namespace)
+	logger.Info("Deleting service", "kind", kind, "name", svc.GetName(), "namespace", svc.GetNamespace())
 	if err := r.Delete(ctx, svc); err != nil && !errors.IsNotFound(err) {
-		logger.Error(err, "Failed to delete NIMService", "name", svc.Name)
+		logger.Error(err, "Failed to delete service", "kind", kind, "name", svc.GetName())
 		return err
 	}
 	return nil
@@ -378,6 +471,11 @@ func (r *NIMPipelineReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&appsv1alpha1.NIMPipeline{}).
 		Owns(&appsv1alpha1.NIMService{}).
+		Owns(&appsv1alpha1.NemoGuardrail{}).
+		Owns(&appsv1alpha1.NemoEvaluator{}).
+		Owns(&appsv1alpha1.NemoCustomizer{}).
+		Owns(&appsv1alpha1.NemoDatastore{}).
+		Owns(&appsv1alpha1.NemoEntitystore{}).
 		WithEventFilter(predicate.Funcs{
 			UpdateFunc: func(e event.UpdateEvent) bool {
 				// Type assert to NIMPipeline
@@ -18,12 +18,16 @@ package controller
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -54,6 +58,9 @@ var _ = Describe("NIMPipeline Controller", func() {
 		client = fake.NewClientBuilder().WithScheme(scheme).
 			WithStatusSubresource(&appsv1alpha1.NIMPipeline{}).
 			WithStatusSubresource(&appsv1alpha1.NIMService{}).
+			WithStatusSubresource(&appsv1alpha1.NemoGuardrail{}).
+			WithStatusSubresource(&appsv1alpha1.NemoDatastore{}).
+			WithStatusSubresource(&appsv1alpha1.NemoEntitystore{}).
 			Build()
 		reconciler = &NIMPipelineReconciler{
 			Client:   client,
@@ -292,5 +299,422 @@ var _ = Describe("NIMPipeline Controller", func() {
 			}
 			validateEnvVars("nim-llm-service", expectedEnvVarsForLLM)
 		})
+
+		It("Should hold back dependent NIMServices until their upstream services are ready", func() {
+			ctx := context.TODO()
+			nimPipeline := &appsv1alpha1.NIMPipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-pipeline",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMPipelineSpec{
+					Services: []appsv1alpha1.NIMServicePipelineSpec{
+						{
+							Name:    "nim-llm-service",
+							Enabled: ptr.To(true),
+							Spec: appsv1alpha1.NIMServiceSpec{
+								Image: appsv1alpha1.Image{
+									Repository: "llm-nim-container",
+									Tag:        "latest",
+								},
+								Replicas: 1,
+							},
+							Dependencies: []appsv1alpha1.ServiceDependency{
+								{Name: "nim-embedding-service", EnvName: "EMBEDDING_ENDPOINT"},
+								{Name: "nim-reranking-service"},
+							},
+						},
+						{
+							Name:    "nim-embedding-service",
+							Enabled: ptr.To(true),
+							Spec: appsv1alpha1.NIMServiceSpec{
+								Image: appsv1alpha1.Image{
+									Repository: "llm-embedding-container",
+									Tag:        "latest",
+								},
+								Replicas: 1,
+								Expose: appsv1alpha1.Expose{
+									Service: appsv1alpha1.Service{Port: ptr.To[int32](9000)},
+								},
+							},
+						},
+						{
+							Name:    "nim-reranking-service",
+							Enabled: ptr.To(true),
+							Spec: appsv1alpha1.NIMServiceSpec{
+								Image: appsv1alpha1.Image{
+									Repository: "llm-reranking-container",
+									Tag:        "latest",
+								},
+								Replicas: 1,
+							},
+							Dependencies: []appsv1alpha1.ServiceDependency{
+								{Name: "nim-embedding-service"},
+							},
+						},
+					},
+				},
+			}
+			Expect(client.Create(ctx, nimPipeline)).To(Succeed())
+
+			setReady := func(name string) {
+				nimService := &appsv1alpha1.NIMService{}
+				Expect(client.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, nimService)).To(Succeed())
+				nimService.Status.State = appsv1alpha1.NIMServiceStatusReady
+				Expect(client.Status().Update(ctx, nimService)).To(Succeed())
+			}
+			exists := func(name string) bool {
+				err := client.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, &appsv1alpha1.NIMService{})
+				return err == nil
+			}
+
+			By("Deploying only the upstream service first")
+			_, err := reconciler.reconcileNIMPipeline(ctx, nimPipeline)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists("nim-embedding-service")).To(BeTrue())
+			Expect(exists("nim-reranking-service")).To(BeFalse())
+			Expect(exists("nim-llm-service")).To(BeFalse())
+			Expect(nimPipeline.Status.States).To(HaveKeyWithValue("nim-llm-service", appsv1alpha1.NIMServiceStatusPending))
+
+			By("Deploying the next tier once the upstream service is ready")
+			setReady("nim-embedding-service")
+			_, err = reconciler.reconcileNIMPipeline(ctx, nimPipeline)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists("nim-reranking-service")).To(BeTrue())
+			Expect(exists("nim-llm-service")).To(BeFalse())
+
+			By("Wiring the upstream endpoint into the dependent service")
+			setReady("nim-reranking-service")
+			_, err = reconciler.reconcileNIMPipeline(ctx, nimPipeline)
+			Expect(err).ToNot(HaveOccurred())
+			nimService := &appsv1alpha1.NIMService{}
+			Expect(client.Get(ctx, types.NamespacedName{Name: "nim-llm-service", Namespace: "default"}, nimService)).To(Succeed())
+			Expect(nimService.Spec.Env).To(ContainElement(corev1.EnvVar{
+				Name:  "EMBEDDING_ENDPOINT",
+				Value: "nim-embedding-service.default.svc.cluster.local:9000",
+			}))
+		})
+
+		It("Should reject pipelines with dependency cycles", func() {
+			ctx := context.TODO()
+			newService := func(name, dependency string) appsv1alpha1.NIMServicePipelineSpec {
+				return appsv1alpha1.NIMServicePipelineSpec{
+					Name:    name,
+					Enabled: ptr.To(true),
+					Spec: appsv1alpha1.NIMServiceSpec{
+						Image: appsv1alpha1.Image{
+							Repository: "llm-nim-container",
+							Tag:        "latest",
+						},
+						Replicas: 1,
+					},
+					Dependencies: []appsv1alpha1.ServiceDependency{{Name: dependency}},
+				}
+			}
+			nimPipeline := &appsv1alpha1.NIMPipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-pipeline",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMPipelineSpec{
+					Services: []appsv1alpha1.NIMServicePipelineSpec{
+						newService("nim-a", "nim-b"),
+						newService("nim-b", "nim-c"),
+						newService("nim-c", "nim-a"),
+					},
+				},
+			}
+			Expect(client.Create(ctx, nimPipeline)).To(Succeed())
+
+			_, err := reconciler.reconcileNIMPipeline(ctx, nimPipeline)
+			Expect(err).ToNot(HaveOccurred())
+
+			obj := &appsv1alpha1.NIMPipeline{}
+			Expect(client.Get(ctx, types.NamespacedName{Name: "test-pipeline", Namespace: "default"}, obj)).To(Succeed())
+			Expect(obj.Status.State).To(Equal(appsv1alpha1.NIMPipelineStatusFailed))
+			Expect(obj.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", appsv1alpha1.NIMPipelineConditionFailed),
+				HaveField("Reason", appsv1alpha1.NIMPipelineReasonInvalidDependencies),
+			)))
+			for _, name := range []string{"nim-a", "nim-b", "nim-c"} {
+				err := client.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, &appsv1alpha1.NIMService{})
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			}
+		})
+		It("Should merge pipeline defaults into the NIMService specs with service precedence", func() {
+			ctx := context.TODO()
+			nimPipeline := &appsv1alpha1.NIMPipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-pipeline",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMPipelineSpec{
+					Defaults: &appsv1alpha1.NIMServicePipelineDefaults{
+						Image:        &appsv1alpha1.NIMServicePipelineImageDefaults{PullSecrets: []string{"ngc-secret"}},
+						NodeSelector: map[string]string{"nvidia.com/gpu.present": "true", "zone": "a"},
+						Env: []corev1.EnvVar{
+							{Name: "NIM_LOG_LEVEL", Value: "INFO"},
+							{Name: "NIM_CACHE_PATH", Value: "/model-store"},
+						},
+						SchedulerName: "gpu-scheduler",
+					},
+					Services: []appsv1alpha1.NIMServicePipelineSpec{
+						{
+							Name:    "nim-llm-service",
+							Enabled: ptr.To(true),
+							Spec: appsv1alpha1.NIMServiceSpec{
+								Image: appsv1alpha1.Image{
+									Repository: "llm-nim-container",
+									Tag:        "latest",
+								},
+								AuthSecret:   "ngc-api-secret",
+								NodeSelector: map[string]string{"zone": "b"},
+								Env: []corev1.EnvVar{
+									{Name: "NIM_LOG_LEVEL", Value: "DEBUG"},
+								},
+								SchedulerName: "llm-scheduler",
+							},
+						},
+						{
+							Name:    "nim-embedding-service",
+							Enabled: ptr.To(true),
+							Spec: appsv1alpha1.NIMServiceSpec{
+								Image: appsv1alpha1.Image{
+									Repository: "llm-embedding-container",
+									Tag:        "latest",
+								},
+								AuthSecret: "embedding-secret",
+							},
+						},
+					},
+				},
+			}
+			Expect(client.Create(ctx, nimPipeline)).To(Succeed())
+
+			_, err := reconciler.reconcileNIMPipeline(ctx, nimPipeline)
+			Expect(err).ToNot(HaveOccurred())
+
+			llm := &appsv1alpha1.NIMService{}
+			Expect(client.Get(ctx, types.NamespacedName{Name: "nim-llm-service", Namespace: "default"}, llm)).To(Succeed())
+			Expect(llm.Spec.Image.PullSecrets).To(Equal([]string{"ngc-secret"}))
+			Expect(llm.Spec.SchedulerName).To(Equal("llm-scheduler"))
+			Expect(llm.Spec.NodeSelector).To(Equal(map[string]string{"nvidia.com/gpu.present": "true", "zone": "b"}))
+			Expect(llm.Spec.Env).To(ConsistOf(
+				corev1.EnvVar{Name: "NIM_LOG_LEVEL", Value: "DEBUG"},
+				corev1.EnvVar{Name: "NIM_CACHE_PATH", Value: "/model-store"},
+			))
+
+			embedding := &appsv1alpha1.NIMService{}
+			Expect(client.Get(ctx, types.NamespacedName{Name: "nim-embedding-service", Namespace: "default"}, embedding)).To(Succeed())
+			Expect(embedding.Spec.AuthSecret).To(Equal("embedding-secret"))
+			Expect(embedding.Spec.SchedulerName).To(Equal("gpu-scheduler"))
+			Expect(embedding.Spec.Image.Repository).To(Equal("llm-embedding-container"))
+			Expect(embedding.Spec.Env).To(HaveLen(2))
+
+			Expect(nimPipeline.Spec.Defaults.NodeSelector).To(HaveKeyWithValue("zone", "a"))
+		})
+		It("Should verify the pipeline end to end once all services are ready", func() {
+			ctx := context.TODO()
+			llmStatusCode := http.StatusInternalServerError
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/v1/embeddings":
+					w.WriteHeader(http.StatusOK)
+				case "/v1/chat/completions":
+					w.WriteHeader(llmStatusCode)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			newService := func(name string) appsv1alpha1.NIMServicePipelineSpec {
+				return appsv1alpha1.NIMServicePipelineSpec{
+					Name:    name,
+					Enabled: ptr.To(true),
+					Spec: appsv1alpha1.NIMServiceSpec{
+						Image: appsv1alpha1.Image{
+							Repository: "llm-nim-container",
+							Tag:        "latest",
+						},
+						Replicas: 1,
+					},
+				}
+			}
+			nimPipeline := &appsv1alpha1.NIMPipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-pipeline",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMPipelineSpec{
+					Services: []appsv1alpha1.NIMServicePipelineSpec{
+						newService("nim-embedding-service"),
+						newService("nim-llm-service"),
+					},
+					Verification: &appsv1alpha1.NIMPipelineVerification{
+						Steps: []appsv1alpha1.NIMPipelineVerificationStep{
+							{Name: "embed", Service: "nim-embedding-service", Path: "/v1/embeddings", Body: `{"input": ["hello"]}`},
+							{Name: "chat", Service: "nim-llm-service", Path: "/v1/chat/completions", Body: `{"messages": []}`},
+						},
+						Interval: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			}
+			Expect(client.Create(ctx, nimPipeline)).To(Succeed())
+
+			By("Waiting for all services to be ready")
+			_, err := reconciler.reconcileNIMPipeline(ctx, nimPipeline)
+			Expect(err).ToNot(HaveOccurred())
+			condition := meta.FindStatusCondition(nimPipeline.Status.Conditions, appsv1alpha1.NIMPipelineConditionVerified)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Reason).To(Equal(appsv1alpha1.NIMPipelineReasonServicesNotReady))
+			Expect(nimPipeline.Status.Verification).To(BeNil())
+
+			for _, name := range []string{"nim-embedding-service", "nim-llm-service"} {
+				nimService := &appsv1alpha1.NIMService{}
+				Expect(client.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, nimService)).To(Succeed())
+				nimService.Status.State = appsv1alpha1.NIMServiceStatusReady
+				nimService.Status.Model = &appsv1alpha1.ModelStatus{ClusterEndpoint: strings.TrimPrefix(server.URL, "http://")}
+				Expect(client.Status().Update(ctx, nimService)).To(Succeed())
+			}
+
+			By("Recording the failed step and skipping the rest of the chain")
+			result, err := reconciler.reconcileNIMPipeline(ctx, nimPipeline)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", nimPipelineVerificationRetryInterval, time.Second))
+			condition = meta.FindStatusCondition(nimPipeline.Status.Conditions, appsv1alpha1.NIMPipelineConditionVerified)
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(appsv1alpha1.NIMPipelineReasonVerificationFailed))
+			Expect(nimPipeline.Status.Verification.Steps).To(HaveLen(2))
+			Expect(nimPipeline.Status.Verification.Steps[0].Passed).To(BeTrue())
+			Expect(nimPipeline.Status.Verification.Steps[0].StatusCode).To(Equal(int32(http.StatusOK)))
+			Expect(nimPipeline.Status.Verification.Steps[1].Passed).To(BeFalse())
+			Expect(nimPipeline.Status.Verification.Steps[1].StatusCode).To(Equal(int32(http.StatusInternalServerError)))
+
+			By("Retrying the verification once the retry interval elapsed")
+			llmStatusCode = http.StatusOK
+			nimPipeline.Status.Verification.LastVerificationTime = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+			result, err = reconciler.reconcileNIMPipeline(ctx, nimPipeline)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", time.Hour, time.Second))
+			condition = meta.FindStatusCondition(nimPipeline.Status.Conditions, appsv1alpha1.NIMPipelineConditionVerified)
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(appsv1alpha1.NIMPipelineReasonVerificationPassed))
+			for _, step := range nimPipeline.Status.Verification.Steps {
+				Expect(step.Passed).To(BeTrue())
+			}
+
+			obj := &appsv1alpha1.NIMPipeline{}
+			Expect(client.Get(ctx, types.NamespacedName{Name: "test-pipeline", Namespace: "default"}, obj)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(obj.Status.Conditions, appsv1alpha1.NIMPipelineConditionVerified)).To(BeTrue())
+		})
+		It("Should deploy NeMo microservices with endpoints wired from their upstream services", func() {
+			ctx := context.TODO()
+			nimPipeline := &appsv1alpha1.NIMPipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-pipeline",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMPipelineSpec{
+					Services: []appsv1alpha1.NIMServicePipelineSpec{
+						{
+							Name:    "nim-llm-service",
+							Enabled: ptr.To(true),
+							Spec: appsv1alpha1.NIMServiceSpec{
+								Image: appsv1alpha1.Image{
+									Repository: "llm-nim-container",
+									Tag:        "latest",
+								},
+								Replicas: 1,
+							},
+						},
+					},
+					Guardrails: []appsv1alpha1.NemoGuardrailPipelineSpec{
+						{
+							Name:    "guardrail",
+							Enabled: ptr.To(true),
+							Spec: appsv1alpha1.NemoGuardrailSpec{
+								Image: appsv1alpha1.Image{
+									Repository: "guardrail-container",
+									Tag:        "latest",
+								},
+								Replicas: 1,
+							},
+							Dependencies: []appsv1alpha1.ServiceDependency{
+								{Name: "nim-llm-service", EnvName: "LLM_ENDPOINT"},
+							},
+						},
+					},
+					Datastores: []appsv1alpha1.NemoDatastorePipelineSpec{
+						{
+							Name:    "datastore",
+							Enabled: ptr.To(true),
+							Spec: appsv1alpha1.NemoDatastoreSpec{
+								Image: appsv1alpha1.Image{
+									Repository: "datastore-container",
+									Tag:        "latest",
+								},
+							},
+						},
+					},
+					Entitystores: []appsv1alpha1.NemoEntitystorePipelineSpec{
+						{
+							Name:    "entitystore",
+							Enabled: ptr.To(true),
+							Spec: appsv1alpha1.NemoEntitystoreSpec{
+								Image: appsv1alpha1.Image{
+									Repository: "entitystore-container",
+									Tag:        "latest",
+								},
+							},
+							Dependencies: []appsv1alpha1.ServiceDependency{
+								{Name: "datastore"},
+							},
+						},
+					},
+				},
+			}
+			Expect(client.Create(ctx, nimPipeline)).To(Succeed())
+
+			By("Holding back the NeMo microservices until their upstream services are ready")
+			_, err := reconciler.reconcileNIMPipeline(ctx, nimPipeline)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(client.Get(ctx, types.NamespacedName{Name: "guardrail", Namespace: "default"}, &appsv1alpha1.NemoGuardrail{})).ToNot(Succeed())
+			Expect(client.Get(ctx, types.NamespacedName{Name: "entitystore", Namespace: "default"}, &appsv1alpha1.NemoEntitystore{})).ToNot(Succeed())
+			Expect(nimPipeline.Status.States).To(HaveKeyWithValue("guardrail", appsv1alpha1.NIMServiceStatusPending))
+
+			nimService := &appsv1alpha1.NIMService{}
+			Expect(client.Get(ctx, types.NamespacedName{Name: "nim-llm-service", Namespace: "default"}, nimService)).To(Succeed())
+			nimService.Status.State = appsv1alpha1.NIMServiceStatusReady
+			Expect(client.Status().Update(ctx, nimService)).To(Succeed())
+			datastore := &appsv1alpha1.NemoDatastore{}
+			Expect(client.Get(ctx, types.NamespacedName{Name: "datastore", Namespace: "default"}, datastore)).To(Succeed())
+			datastore.Status.State = appsv1alpha1.NemoDatastoreStatusReady
+			Expect(client.Status().Update(ctx, datastore)).To(Succeed())
+
+			By("Wiring the upstream endpoints into the NeMo microservices")
+			_, err = reconciler.reconcileNIMPipeline(ctx, nimPipeline)
+			Expect(err).ToNot(HaveOccurred())
+			guardrail := &appsv1alpha1.NemoGuardrail{}
+			Expect(client.Get(ctx, types.NamespacedName{Name: "guardrail", Namespace: "default"}, guardrail)).To(Succeed())
+			Expect(guardrail.Spec.NIMEndpoint).ToNot(BeNil())
+			Expect(guardrail.Spec.NIMEndpoint.BaseURL).To(Equal("http://nim-llm-service.default.svc.cluster.local:8000/v1"))
+			Expect(guardrail.Spec.Env).To(ContainElement(corev1.EnvVar{
+				Name:  "LLM_ENDPOINT",
+				Value: "nim-llm-service.default.svc.cluster.local:8000",
+			}))
+			entitystore := &appsv1alpha1.NemoEntitystore{}
+			Expect(client.Get(ctx, types.NamespacedName{Name: "entitystore", Namespace: "default"}, entitystore)).To(Succeed())
+			Expect(entitystore.Spec.Datastore.Endpoint).To(Equal("http://datastore.default.svc.cluster.local:8000"))
+			Expect(nimPipeline.Status.States).To(HaveKeyWithValue("datastore", appsv1alpha1.NemoDatastoreStatusReady))
+
+			By("Deleting the NeMo microservices once disabled")
+			nimPipeline.Spec.Guardrails[0].Enabled = ptr.To(false)
+			_, err = reconciler.reconcileNIMPipeline(ctx, nimPipeline)
+			Expect(err).ToNot(HaveOccurred())
+			err = client.Get(ctx, types.NamespacedName{Name: "guardrail", Namespace: "default"}, &appsv1alpha1.NemoGuardrail{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(nimPipeline.Status.States).ToNot(HaveKey("guardrail"))
+		})
 	})
 })
@@ -0,0 +1,174 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+)
+
+// sortPipelineMembers returns the pipeline services in dependency order, upstream services first.
+// Dependencies on services outside of the pipeline are not part of the graph.
+// An error is returned for duplicate service names or when dependencies form a cycle.
+func sortPipelineMembers(members []appsv1alpha1.NIMPipelineMember) ([]appsv1alpha1.NIMPipelineMember, error) {
+	index := make(map[string]int, len(members))
+	for i, member := range members {
+		if _, ok := index[member.Name]; ok {
+			return nil, fmt.Errorf("duplicate service %q in pipeline", member.Name)
+		}
+		index[member.Name] = i
+	}
+
+	inDegree := make([]int, len(members))
+	dependents := make([][]int, len(members))
+	for i, member := range members {
+		for _, dep := range member.Dependencies {
+			j, ok := index[dep.Name]
+			if !ok {
+				continue
+			}
+			inDegree[i]++
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+
+	// Kahn's algorithm, keeping the spec order among services with satisfied dependencies
+	queue := []int{}
+	for i := range members {
+		if inDegree[i] == 0 {
+			queue = append(queue, i)
+		}
+	}
+	sorted := make([]appsv1alpha1.NIMPipelineMember, 0, len(members))
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		sorted = append(sorted, members[i])
+		for _, j := range dependents[i] {
+			inDegree[j]--
+			if inDegree[j] == 0 {
+				queue = append(queue, j)
+			}
+		}
+	}
+
+	if len(sorted) != len(members) {
+		var blocked []string
+		for i, member := range members {
+			if inDegree[i] > 0 {
+				blocked = append(blocked, member.Name)
+			}
+		}
+		return nil, fmt.Errorf("dependency cycle detected between services %s", strings.Join(blocked, ", "))
+	}
+	return sorted, nil
+}
+
+// getPipelineObject returns the resource deployed by the pipeline for the given member, or nil if not found.
+func (r *NIMPipelineReconciler) getPipelineObject(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, member appsv1alpha1.NIMPipelineMember) (pipelineObject, error) {
+	obj, err := newPipelineObject(member.Kind)
+	if err != nil {
+		return nil, err
+	}
+	err = r.Get(ctx, types.NamespacedName{Name: member.Name, Namespace: nimPipeline.Namespace}, obj)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if owned, _ := controllerutil.HasOwnerReference(obj.GetOwnerReferences(), nimPipeline, r.Scheme); !owned {
+		return nil, nil
+	}
+	return obj, nil
+}
+
+// areDependenciesReady returns true if all upstream services of the pipeline the service depends on are ready.
+func (r *NIMPipelineReconciler) areDependenciesReady(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, member appsv1alpha1.NIMPipelineMember, members map[string]appsv1alpha1.NIMPipelineMember) (bool, error) {
+	for _, dep := range member.Dependencies {
+		upstreamMember, inPipeline := members[dep.Name]
+		if !inPipeline {
+			continue
+		}
+		if !upstreamMember.Enabled {
+			return false, nil
+		}
+		upstream, err := r.getPipelineObject(ctx, nimPipeline, upstreamMember)
+		if err != nil {
+			return false, err
+		}
+		if upstream == nil || getPipelineObjectState(upstream) != appsv1alpha1.NIMServiceStatusReady {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// getDependencyEndpoint returns the endpoint of an upstream service. An explicit value is used as is, otherwise
+// the endpoint of an upstream service of the pipeline is generated from its rendered Service name and port, while
+// an upstream NIMService outside of the pipeline is reached through its model cluster endpoint.
+func (r *NIMPipelineReconciler) getDependencyEndpoint(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, dep appsv1alpha1.ServiceDependency, members map[string]appsv1alpha1.NIMPipelineMember) (string, error) {
+	if dep.EnvValue != "" {
+		return dep.EnvValue, nil
+	}
+
+	if upstreamMember, ok := members[dep.Name]; ok {
+		port := dep.Port
+		if port == 0 {
+			upstream, err := buildPipelineObject(nimPipeline, upstreamMember)
+			if err != nil {
+				return "", err
+			}
+			port = upstream.GetServicePort()
+		}
+		return getServiceDNSEndpoint(dep.Name, nimPipeline.Namespace, port), nil
+	}
+
+	if dep.Port != 0 {
+		return getServiceDNSEndpoint(dep.Name, nimPipeline.Namespace, dep.Port), nil
+	}
+	upstream := &appsv1alpha1.NIMService{}
+	err := r.Get(ctx, types.NamespacedName{Name: dep.Name, Namespace: nimPipeline.Namespace}, upstream)
+	if err != nil && !errors.IsNotFound(err) {
+		return "", err
+	}
+	if err == nil && upstream.Status.Model != nil && upstream.Status.Model.ClusterEndpoint != "" {
+		return upstream.Status.Model.ClusterEndpoint, nil
+	}
+	return "", fmt.Errorf("unable to resolve the endpoint of dependency %s, please set its port or envValue", dep.Name)
+}
+
+func getServiceDNSEndpoint(name, namespace string, port int32) string {
+	return utils.FormatEndpoint(fmt.Sprintf("%s.%s.svc.cluster.local", name, namespace), port)
+}
+
+// getPipelineMembersByName returns the services of the pipeline by name.
+func getPipelineMembersByName(members []appsv1alpha1.NIMPipelineMember) map[string]appsv1alpha1.NIMPipelineMember {
+	byName := make(map[string]appsv1alpha1.NIMPipelineMember, len(members))
+	for _, member := range members {
+		byName[member.Name] = member
+	}
+	return byName
+}
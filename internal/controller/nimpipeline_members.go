@@ -0,0 +1,208 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+)
+
+// pipelineMemberKinds lists the kinds of resources deployed by a NIMPipeline.
+var pipelineMemberKinds = []string{
+	appsv1alpha1.NIMServiceKind,
+	appsv1alpha1.NemoGuardrailKind,
+	appsv1alpha1.NemoEvaluatorKind,
+	appsv1alpha1.NemoCustomizerKind,
+	appsv1alpha1.NemoDatastoreKind,
+	appsv1alpha1.NemoEntitystoreKind,
+}
+
+// pipelineObject is implemented by the resources deployed by a NIMPipeline.
+type pipelineObject interface {
+	client.Object
+	GetServicePort() int32
+}
+
+// newPipelineObject returns an empty resource of the given pipeline member kind.
+func newPipelineObject(kind string) (pipelineObject, error) {
+	switch kind {
+	case appsv1alpha1.NIMServiceKind:
+		return &appsv1alpha1.NIMService{}, nil
+	case appsv1alpha1.NemoGuardrailKind:
+		return &appsv1alpha1.NemoGuardrail{}, nil
+	case appsv1alpha1.NemoEvaluatorKind:
+		return &appsv1alpha1.NemoEvaluator{}, nil
+	case appsv1alpha1.NemoCustomizerKind:
+		return &appsv1alpha1.NemoCustomizer{}, nil
+	case appsv1alpha1.NemoDatastoreKind:
+		return &appsv1alpha1.NemoDatastore{}, nil
+	case appsv1alpha1.NemoEntitystoreKind:
+		return &appsv1alpha1.NemoEntitystore{}, nil
+	}
+	return nil, fmt.Errorf("unsupported pipeline member kind %s", kind)
+}
+
+// newPipelineObjectList returns an empty list of resources of the given pipeline member kind.
+func newPipelineObjectList(kind string) (client.ObjectList, error) {
+	switch kind {
+	case appsv1alpha1.NIMServiceKind:
+		return &appsv1alpha1.NIMServiceList{}, nil
+	case appsv1alpha1.NemoGuardrailKind:
+		return &appsv1alpha1.NemoGuardrailList{}, nil
+	case appsv1alpha1.NemoEvaluatorKind:
+		return &appsv1alpha1.NemoEvaluatorList{}, nil
+	case appsv1alpha1.NemoCustomizerKind:
+		return &appsv1alpha1.NemoCustomizerList{}, nil
+	case appsv1alpha1.NemoDatastoreKind:
+		return &appsv1alpha1.NemoDatastoreList{}, nil
+	case appsv1alpha1.NemoEntitystoreKind:
+		return &appsv1alpha1.NemoEntitystoreList{}, nil
+	}
+	return nil, fmt.Errorf("unsupported pipeline member kind %s", kind)
+}
+
+// buildPipelineObject returns the desired resource of a pipeline member, before its dependencies are wired.
+func buildPipelineObject(nimPipeline *appsv1alpha1.NIMPipeline, member appsv1alpha1.NIMPipelineMember) (pipelineObject, error) {
+	objectMeta := metav1.ObjectMeta{
+		Name:      member.Name,
+		Namespace: nimPipeline.Namespace,
+	}
+
+	switch member.Kind {
+	case appsv1alpha1.NIMServiceKind:
+		for i := range nimPipeline.Spec.Services {
+			if nimPipeline.Spec.Services[i].Name != member.Name {
+				continue
+			}
+			// Merge the service spec over the pipeline defaults
+			spec, err := nimPipeline.GetServiceSpec(&nimPipeline.Spec.Services[i])
+			if err != nil {
+				return nil, err
+			}
+			return &appsv1alpha1.NIMService{ObjectMeta: objectMeta, Spec: *spec}, nil
+		}
+	case appsv1alpha1.NemoGuardrailKind:
+		for _, service := range nimPipeline.Spec.Guardrails {
+			if service.Name == member.Name {
+				return &appsv1alpha1.NemoGuardrail{ObjectMeta: objectMeta, Spec: *service.Spec.DeepCopy()}, nil
+			}
+		}
+	case appsv1alpha1.NemoEvaluatorKind:
+		for _, service := range nimPipeline.Spec.Evaluators {
+			if service.Name == member.Name {
+				return &appsv1alpha1.NemoEvaluator{ObjectMeta: objectMeta, Spec: *service.Spec.DeepCopy()}, nil
+			}
+		}
+	case appsv1alpha1.NemoCustomizerKind:
+		for _, service := range nimPipeline.Spec.Customizers {
+			if service.Name == member.Name {
+				return &appsv1alpha1.NemoCustomizer{ObjectMeta: objectMeta, Spec: *service.Spec.DeepCopy()}, nil
+			}
+		}
+	case appsv1alpha1.NemoDatastoreKind:
+		for _, service := range nimPipeline.Spec.Datastores {
+			if service.Name == member.Name {
+				return &appsv1alpha1.NemoDatastore{ObjectMeta: objectMeta, Spec: *service.Spec.DeepCopy()}, nil
+			}
+		}
+	case appsv1alpha1.NemoEntitystoreKind:
+		for _, service := range nimPipeline.Spec.Entitystores {
+			if service.Name == member.Name {
+				return &appsv1alpha1.NemoEntitystore{ObjectMeta: objectMeta, Spec: *service.Spec.DeepCopy()}, nil
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported pipeline member kind %s", member.Kind)
+	}
+	return nil, fmt.Errorf("%s %s not found in the pipeline", member.Kind, member.Name)
+}
+
+// getPipelineObjectState returns the state of a resource deployed by a NIMPipeline.
+func getPipelineObjectState(obj pipelineObject) string {
+	switch o := obj.(type) {
+	case *appsv1alpha1.NIMService:
+		return o.Status.State
+	case *appsv1alpha1.NemoGuardrail:
+		return o.Status.State
+	case *appsv1alpha1.NemoEvaluator:
+		return o.Status.State
+	case *appsv1alpha1.NemoCustomizer:
+		return o.Status.State
+	case *appsv1alpha1.NemoDatastore:
+		return o.Status.State
+	case *appsv1alpha1.NemoEntitystore:
+		return o.Status.State
+	}
+	return ""
+}
+
+// mergePipelineObjectEnv merges environment variables into the spec of a resource deployed by a NIMPipeline.
+func mergePipelineObjectEnv(obj pipelineObject, envVars []corev1.EnvVar) {
+	switch o := obj.(type) {
+	case *appsv1alpha1.NIMService:
+		o.Spec.Env = utils.MergeEnvVars(o.Spec.Env, envVars)
+	case *appsv1alpha1.NemoGuardrail:
+		o.Spec.Env = utils.MergeEnvVars(o.Spec.Env, envVars)
+	case *appsv1alpha1.NemoEvaluator:
+		o.Spec.Env = utils.MergeEnvVars(o.Spec.Env, envVars)
+	case *appsv1alpha1.NemoCustomizer:
+		o.Spec.Env = utils.MergeEnvVars(o.Spec.Env, envVars)
+	case *appsv1alpha1.NemoDatastore:
+		o.Spec.Env = utils.MergeEnvVars(o.Spec.Env, envVars)
+	case *appsv1alpha1.NemoEntitystore:
+		o.Spec.Env = utils.MergeEnvVars(o.Spec.Env, envVars)
+	}
+}
+
+// wirePipelineEndpoints fills the unset upstream endpoints of a NeMo microservice from the endpoints of its
+// upstream services in the pipeline, keyed by kind.
+func wirePipelineEndpoints(obj pipelineObject, endpoints map[string]string) {
+	nimEndpoint := endpoints[appsv1alpha1.NIMServiceKind]
+	datastoreEndpoint := endpoints[appsv1alpha1.NemoDatastoreKind]
+	entitystoreEndpoint := endpoints[appsv1alpha1.NemoEntitystoreKind]
+
+	switch o := obj.(type) {
+	case *appsv1alpha1.NemoGuardrail:
+		if o.Spec.NIMEndpoint == nil && nimEndpoint != "" {
+			o.Spec.NIMEndpoint = &appsv1alpha1.NIMEndpoint{BaseURL: fmt.Sprintf("http://%s/v1", nimEndpoint)}
+		}
+	case *appsv1alpha1.NemoEvaluator:
+		if o.Spec.Datastore.Endpoint == "" && datastoreEndpoint != "" {
+			o.Spec.Datastore.Endpoint = fmt.Sprintf("http://%s/v1/hf", datastoreEndpoint)
+		}
+		if o.Spec.Entitystore.Endpoint == "" && entitystoreEndpoint != "" {
+			o.Spec.Entitystore.Endpoint = fmt.Sprintf("http://%s", entitystoreEndpoint)
+		}
+	case *appsv1alpha1.NemoCustomizer:
+		if o.Spec.Datastore.Endpoint == "" && datastoreEndpoint != "" {
+			o.Spec.Datastore.Endpoint = fmt.Sprintf("http://%s", datastoreEndpoint)
+		}
+		if o.Spec.Entitystore.Endpoint == "" && entitystoreEndpoint != "" {
+			o.Spec.Entitystore.Endpoint = fmt.Sprintf("http://%s", entitystoreEndpoint)
+		}
+	case *appsv1alpha1.NemoEntitystore:
+		if o.Spec.Datastore.Endpoint == "" && datastoreEndpoint != "" {
+			o.Spec.Datastore.Endpoint = fmt.Sprintf("http://%s", datastoreEndpoint)
+		}
+	}
+}
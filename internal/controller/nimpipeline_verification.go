@@ -0,0 +1,179 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/nimmodels"
+)
+
+const (
+	// nimPipelineVerificationRetryInterval is the interval to retry a failed pipeline verification.
+	nimPipelineVerificationRetryInterval = 30 * time.Second
+)
+
+// verifyPipeline runs the end-to-end verification of the pipeline when it is due and records the result in its status.
+// The verification only runs once all services of the pipeline are ready.
+func (r *NIMPipelineReconciler) verifyPipeline(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, ready bool) {
+	logger := log.FromContext(ctx)
+
+	verification := nimPipeline.Spec.Verification
+	if verification == nil {
+		nimPipeline.Status.Verification = nil
+		meta.RemoveStatusCondition(&nimPipeline.Status.Conditions, appsv1alpha1.NIMPipelineConditionVerified)
+		return
+	}
+
+	if !ready {
+		meta.SetStatusCondition(&nimPipeline.Status.Conditions, metav1.Condition{
+			Type:    appsv1alpha1.NIMPipelineConditionVerified,
+			Status:  metav1.ConditionFalse,
+			Reason:  appsv1alpha1.NIMPipelineReasonServicesNotReady,
+			Message: "Waiting for all services of the pipeline to be ready",
+		})
+		return
+	}
+
+	if due, _ := nextPipelineVerification(nimPipeline, time.Now()); !due {
+		return
+	}
+
+	logger.Info("Verifying NIMPipeline", "name", nimPipeline.Name)
+	status := &appsv1alpha1.NIMPipelineVerificationStatus{
+		ObservedGeneration:   nimPipeline.Generation,
+		LastVerificationTime: ptr.To(metav1.Now()),
+	}
+	condition := metav1.Condition{
+		Type:    appsv1alpha1.NIMPipelineConditionVerified,
+		Status:  metav1.ConditionTrue,
+		Reason:  appsv1alpha1.NIMPipelineReasonVerificationPassed,
+		Message: fmt.Sprintf("All %d verification steps passed", len(verification.Steps)),
+	}
+	for _, step := range verification.Steps {
+		// The chain stops at the first failed step
+		if condition.Status == metav1.ConditionFalse {
+			status.Steps = append(status.Steps, appsv1alpha1.NIMPipelineVerificationStepStatus{
+				Name:    step.Name,
+				Service: step.Service,
+				Message: "Skipped, a previous step failed",
+			})
+			continue
+		}
+
+		result := r.runVerificationStep(ctx, nimPipeline, step, verification.GetTimeout())
+		status.Steps = append(status.Steps, result)
+		if !result.Passed {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = appsv1alpha1.NIMPipelineReasonVerificationFailed
+			condition.Message = fmt.Sprintf("Verification step %s failed: %s", step.Name, result.Message)
+		}
+	}
+
+	nimPipeline.Status.Verification = status
+	meta.SetStatusCondition(&nimPipeline.Status.Conditions, condition)
+
+	if condition.Status == metav1.ConditionFalse {
+		r.GetEventRecorder().Event(nimPipeline, corev1.EventTypeWarning, condition.Reason, condition.Message)
+	} else {
+		r.GetEventRecorder().Event(nimPipeline, corev1.EventTypeNormal, condition.Reason, condition.Message)
+	}
+}
+
+// runVerificationStep sends the request of a verification step to its pipeline service and returns the result.
+func (r *NIMPipelineReconciler) runVerificationStep(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, step appsv1alpha1.NIMPipelineVerificationStep, timeout time.Duration) appsv1alpha1.NIMPipelineVerificationStepStatus {
+	result := appsv1alpha1.NIMPipelineVerificationStepStatus{
+		Name:    step.Name,
+		Service: step.Service,
+	}
+
+	endpoint, err := r.getVerificationEndpoint(ctx, nimPipeline, step.Service)
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+
+	start := time.Now()
+	statusCode, err := nimmodels.InvokeV1(ctx, endpoint, "http", step.GetMethod(), step.Path, []byte(step.Body), timeout)
+	result.LatencyMilliseconds = time.Since(start).Milliseconds()
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+
+	result.StatusCode = int32(statusCode)
+	if result.StatusCode != step.GetExpectedStatusCode() {
+		result.Message = fmt.Sprintf("unexpected status code %d, expected %d", result.StatusCode, step.GetExpectedStatusCode())
+		return result
+	}
+	result.Passed = true
+	return result
+}
+
+// getVerificationEndpoint returns the cluster endpoint of a pipeline service, falling back to its Service DNS name.
+func (r *NIMPipelineReconciler) getVerificationEndpoint(ctx context.Context, nimPipeline *appsv1alpha1.NIMPipeline, name string) (string, error) {
+	member, ok := getPipelineMembersByName(nimPipeline.GetMembers())[name]
+	if !ok {
+		return "", fmt.Errorf("service %s is not part of the pipeline", name)
+	}
+	obj, err := r.getPipelineObject(ctx, nimPipeline, member)
+	if err != nil {
+		return "", err
+	}
+	if obj == nil {
+		return "", fmt.Errorf("service %s is not deployed by the pipeline", name)
+	}
+	if nimService, ok := obj.(*appsv1alpha1.NIMService); ok && nimService.Status.Model != nil && nimService.Status.Model.ClusterEndpoint != "" {
+		return nimService.Status.Model.ClusterEndpoint, nil
+	}
+	return getServiceDNSEndpoint(obj.GetName(), obj.GetNamespace(), obj.GetServicePort()), nil
+}
+
+// nextPipelineVerification returns whether the verification of the pipeline is due, or else the time until it is.
+// A verification is due when it never ran against the current generation or when the services became ready again,
+// then after the configured interval once it passed, or after the retry interval once it failed.
+func nextPipelineVerification(nimPipeline *appsv1alpha1.NIMPipeline, now time.Time) (bool, time.Duration) {
+	status := nimPipeline.Status.Verification
+	condition := meta.FindStatusCondition(nimPipeline.Status.Conditions, appsv1alpha1.NIMPipelineConditionVerified)
+	if status == nil || status.LastVerificationTime == nil || status.ObservedGeneration != nimPipeline.Generation ||
+		condition == nil || condition.Reason == appsv1alpha1.NIMPipelineReasonServicesNotReady {
+		return true, 0
+	}
+
+	interval := nimPipelineVerificationRetryInterval
+	if condition.Status == metav1.ConditionTrue {
+		if nimPipeline.Spec.Verification.Interval == nil || nimPipeline.Spec.Verification.Interval.Duration <= 0 {
+			return false, 0
+		}
+		interval = nimPipeline.Spec.Verification.Interval.Duration
+	}
+
+	elapsed := now.Sub(status.LastVerificationTime.Time)
+	if elapsed >= interval {
+		return true, 0
+	}
+	return false, interval - elapsed
+}
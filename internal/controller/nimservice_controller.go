@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 
 	"github.com/go-logr/logr"
 	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
@@ -42,6 +43,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	lwsv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
@@ -88,20 +90,28 @@ func NewNIMServiceReconciler(client client.Client, scheme *runtime.Scheme, updat
 // +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimservices/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimservices/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimcaches,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimcachegrants,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps.nvidia.com,resources=nimbuilds,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps.nvidia.com,resources=nemocustomizers,verbs=get;list;watch
 // +kubebuilder:rbac:groups=config.openshift.io,resources=clusterversions;proxies,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,verbs=use,resourceNames=nonroot
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=resource.k8s.io,resources=resourceclaims;resourceclaimtemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=resource.k8s.io,resources=resourceclaims;resourceclaimtemplates,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups="",resources=serviceaccounts;pods;pods/eviction;services;services/finalizers;endpoints,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims;configmaps;secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors;prometheusrules,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=scheduling.k8s.io,resources=priorityclasses,verbs=get;list;watch;create
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes;grpcroutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch
+// +kubebuilder:rbac:groups=inference.networking.x-k8s.io,resources=inferencepools;inferencemodels,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=keda.sh,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;update;patch
 // +kubebuilder:rbac:groups=leaderworkerset.x-k8s.io,resources=leaderworkersets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list
@@ -256,6 +266,66 @@ func (r *NIMServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if err != nil {
 		return err
 	}
+	err = mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&appsv1alpha1.NIMService{},
+		"spec.storage.nimCache.namespace",
+		func(rawObj client.Object) []string {
+			nimService, ok := rawObj.(*appsv1alpha1.NIMService)
+			if !ok || !nimService.IsCrossNamespaceNIMCache() {
+				return []string{}
+			}
+			return []string{nimService.GetNIMCacheNamespace()}
+		},
+	)
+	if err != nil {
+		return err
+	}
+	err = mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&appsv1alpha1.NIMService{},
+		"spec.lora.adapters.nimCache.name",
+		func(rawObj client.Object) []string {
+			nimService, ok := rawObj.(*appsv1alpha1.NIMService)
+			if !ok {
+				return []string{}
+			}
+			return nimService.GetLoRANIMCacheNames()
+		},
+	)
+	if err != nil {
+		return err
+	}
+	err = mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&appsv1alpha1.NIMService{},
+		"spec.multiModel.models.nimCache.name",
+		func(rawObj client.Object) []string {
+			nimService, ok := rawObj.(*appsv1alpha1.NIMService)
+			if !ok {
+				return []string{}
+			}
+			return nimService.GetMultiModelNIMCacheNames()
+		},
+	)
+	if err != nil {
+		return err
+	}
+	err = mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&appsv1alpha1.NIMService{},
+		"spec.storage.nimCache.nimBuild",
+		func(rawObj client.Object) []string {
+			nimService, ok := rawObj.(*appsv1alpha1.NIMService)
+			if !ok || nimService.GetNIMBuildName() == "" {
+				return []string{}
+			}
+			return []string{nimService.GetNIMBuildName()}
+		},
+	)
+	if err != nil {
+		return err
+	}
 
 	nimServiceBuilder := ctrl.NewControllerManagedBy(mgr).
 		For(&appsv1alpha1.NIMService{}).
@@ -290,6 +360,16 @@ func (r *NIMServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&appsv1alpha1.NIMCache{},
 			handler.EnqueueRequestsFromMapFunc(r.mapNIMCacheToNIMService),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Watches(
+			&appsv1alpha1.NIMCacheGrant{},
+			handler.EnqueueRequestsFromMapFunc(r.mapNIMCacheGrantToNIMService),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&appsv1alpha1.NIMBuild{},
+			handler.EnqueueRequestsFromMapFunc(r.mapNIMBuildToNIMService),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
 		)
 
 	resourceClaimCRDExists, err := k8sutil.CRDExists(r.discoveryClient, resourcev1beta2.SchemeGroupVersion.WithResource("resourceclaims"))
@@ -320,6 +400,30 @@ func (r *NIMServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		nimServiceBuilder = nimServiceBuilder.Owns(&resourcev1beta2.ResourceClaimTemplate{})
 	}
 
+	httpRouteCRDExists, err := k8sutil.CRDExists(r.discoveryClient, gatewayv1.SchemeGroupVersion.WithResource("httproutes"))
+	if err != nil {
+		return err
+	}
+	if httpRouteCRDExists {
+		nimServiceBuilder = nimServiceBuilder.Owns(&gatewayv1.HTTPRoute{})
+	}
+
+	grpcRouteCRDExists, err := k8sutil.CRDExists(r.discoveryClient, gatewayv1.SchemeGroupVersion.WithResource("grpcroutes"))
+	if err != nil {
+		return err
+	}
+	if grpcRouteCRDExists {
+		nimServiceBuilder = nimServiceBuilder.Owns(&gatewayv1.GRPCRoute{})
+	}
+
+	scaledObjectCRDExists, err := k8sutil.CRDExists(r.discoveryClient, shared.KEDAScaledObjectGVK.GroupVersion().WithResource("scaledobjects"))
+	if err != nil {
+		return err
+	}
+	if scaledObjectCRDExists {
+		nimServiceBuilder = nimServiceBuilder.Owns(shared.NewKEDAScaledObject())
+	}
+
 	isvcCRDExists, err := k8sutil.CRDExists(r.discoveryClient, kservev1beta1.SchemeGroupVersion.WithResource("inferenceservices"))
 	if err != nil {
 		return err
@@ -337,21 +441,100 @@ func (r *NIMServiceReconciler) mapNIMCacheToNIMService(ctx context.Context, obj
 		return []ctrl.Request{}
 	}
 
-	// Get all NIMServices that reference this NIMCache
+	// Get all NIMServices that reference this NIMCache, either as model store, for LoRA adapters or for additional models
 	var nimServices appsv1alpha1.NIMServiceList
 	if err := r.List(ctx, &nimServices, client.MatchingFields{"spec.storage.nimCache.name": nimCache.GetName()}, client.InNamespace(nimCache.GetNamespace())); err != nil {
 		return []ctrl.Request{}
 	}
+	var loraNIMServices appsv1alpha1.NIMServiceList
+	if err := r.List(ctx, &loraNIMServices, client.MatchingFields{"spec.lora.adapters.nimCache.name": nimCache.GetName()}, client.InNamespace(nimCache.GetNamespace())); err != nil {
+		return []ctrl.Request{}
+	}
+	var multiModelNIMServices appsv1alpha1.NIMServiceList
+	if err := r.List(ctx, &multiModelNIMServices, client.MatchingFields{"spec.multiModel.models.nimCache.name": nimCache.GetName()}, client.InNamespace(nimCache.GetNamespace())); err != nil {
+		return []ctrl.Request{}
+	}
+	// Get all NIMServices in other namespaces that reference this NIMCache as model store
+	var crossNamespaceNIMServices appsv1alpha1.NIMServiceList
+	if err := r.List(ctx, &crossNamespaceNIMServices, client.MatchingFields{"spec.storage.nimCache.namespace": nimCache.GetNamespace()}); err != nil {
+		return []ctrl.Request{}
+	}
+	for _, item := range crossNamespaceNIMServices.Items {
+		if item.Spec.Storage.NIMCache.Name == nimCache.GetName() {
+			nimServices.Items = append(nimServices.Items, item)
+		}
+	}
 
 	// Enqueue reconciliation for each matching NIMService
-	requests := make([]ctrl.Request, len(nimServices.Items))
-	for i, item := range nimServices.Items {
-		requests[i] = ctrl.Request{
+	requests := make([]ctrl.Request, 0, len(nimServices.Items)+len(loraNIMServices.Items)+len(multiModelNIMServices.Items))
+	seen := map[types.NamespacedName]bool{}
+	for _, item := range slices.Concat(nimServices.Items, loraNIMServices.Items, multiModelNIMServices.Items) {
+		namespacedName := types.NamespacedName{Name: item.Name, Namespace: item.Namespace}
+		if seen[namespacedName] {
+			continue
+		}
+		seen[namespacedName] = true
+		requests = append(requests, ctrl.Request{NamespacedName: namespacedName})
+	}
+	return requests
+}
+
+func (r *NIMServiceReconciler) mapNIMCacheGrantToNIMService(ctx context.Context, obj client.Object) []ctrl.Request {
+	grant, ok := obj.(*appsv1alpha1.NIMCacheGrant)
+	if !ok {
+		return []ctrl.Request{}
+	}
+
+	// Get all NIMServices referencing a NIMCache in the namespace of the grant
+	var nimServices appsv1alpha1.NIMServiceList
+	if err := r.List(ctx, &nimServices, client.MatchingFields{"spec.storage.nimCache.namespace": grant.GetNamespace()}); err != nil {
+		return []ctrl.Request{}
+	}
+
+	// Enqueue reconciliation for each NIMService in a namespace listed by the grant,
+	// so that both newly granted and revoked references are handled
+	fromNamespaces := map[string]bool{}
+	for _, from := range grant.Spec.From {
+		fromNamespaces[from.Namespace] = true
+	}
+	requests := []ctrl.Request{}
+	for _, item := range nimServices.Items {
+		if !fromNamespaces[item.Namespace] {
+			continue
+		}
+		requests = append(requests, ctrl.Request{
 			NamespacedName: types.NamespacedName{
 				Name:      item.Name,
 				Namespace: item.Namespace,
 			},
+		})
+	}
+	return requests
+}
+
+func (r *NIMServiceReconciler) mapNIMBuildToNIMService(ctx context.Context, obj client.Object) []ctrl.Request {
+	nimBuild, ok := obj.(*appsv1alpha1.NIMBuild)
+	if !ok {
+		return []ctrl.Request{}
+	}
+
+	// Get all NIMServices tracking this NIMBuild, which lives in the namespace of their NIMCache
+	var nimServices appsv1alpha1.NIMServiceList
+	if err := r.List(ctx, &nimServices, client.MatchingFields{"spec.storage.nimCache.nimBuild": nimBuild.GetName()}); err != nil {
+		return []ctrl.Request{}
+	}
+
+	requests := []ctrl.Request{}
+	for _, item := range nimServices.Items {
+		if item.GetNIMCacheNamespace() != nimBuild.GetNamespace() {
+			continue
 		}
+		requests = append(requests, ctrl.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.Name,
+				Namespace: item.Namespace,
+			},
+		})
 	}
 	return requests
 }
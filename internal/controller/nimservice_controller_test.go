@@ -65,6 +65,27 @@ var _ = Describe("NIMService Controller", func() {
 				}
 				return []string{nimService.Spec.Storage.NIMCache.Name}
 			}).
+			WithIndex(&appsv1alpha1.NIMService{}, "spec.storage.nimCache.namespace", func(obj client.Object) []string {
+				nimService, ok := obj.(*appsv1alpha1.NIMService)
+				if !ok || !nimService.IsCrossNamespaceNIMCache() {
+					return []string{}
+				}
+				return []string{nimService.GetNIMCacheNamespace()}
+			}).
+			WithIndex(&appsv1alpha1.NIMService{}, "spec.lora.adapters.nimCache.name", func(obj client.Object) []string {
+				nimService, ok := obj.(*appsv1alpha1.NIMService)
+				if !ok {
+					return []string{}
+				}
+				return nimService.GetLoRANIMCacheNames()
+			}).
+			WithIndex(&appsv1alpha1.NIMService{}, "spec.multiModel.models.nimCache.name", func(obj client.Object) []string {
+				nimService, ok := obj.(*appsv1alpha1.NIMService)
+				if !ok {
+					return []string{}
+				}
+				return nimService.GetMultiModelNIMCacheNames()
+			}).
 			Build()
 		reconciler = &NIMServiceReconciler{
 			Client:   testClient,
@@ -205,6 +226,77 @@ var _ = Describe("NIMService Controller", func() {
 				))
 			})
 
+			It("should return reconcile requests for NIMServices using the NIMCache for LoRA adapters", func() {
+				nimCache := &appsv1alpha1.NIMCache{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-lora-nimcache",
+						Namespace: "default",
+					},
+				}
+				Expect(testClient.Create(ctx, nimCache)).To(Succeed())
+
+				nimService := &appsv1alpha1.NIMService{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-service",
+						Namespace: "default",
+					},
+					Spec: appsv1alpha1.NIMServiceSpec{
+						Storage: appsv1alpha1.NIMServiceStorage{
+							NIMCache: appsv1alpha1.NIMCacheVolSpec{
+								Name: "test-nimcache",
+							},
+						},
+						LoRA: &appsv1alpha1.LoRASpec{
+							Adapters: []appsv1alpha1.LoRAAdapter{
+								{Name: "adapter-1", NIMCache: &appsv1alpha1.LoRANIMCacheSource{Name: "test-lora-nimcache"}},
+								{Name: "adapter-2", NIMCache: &appsv1alpha1.LoRANIMCacheSource{Name: "test-lora-nimcache", Path: "adapter-2"}},
+							},
+						},
+					},
+				}
+				Expect(testClient.Create(ctx, nimService)).To(Succeed())
+
+				requests := reconciler.mapNIMCacheToNIMService(ctx, nimCache)
+				Expect(requests).To(ConsistOf(
+					ctrl.Request{NamespacedName: types.NamespacedName{Name: "test-service", Namespace: "default"}},
+				))
+			})
+
+			It("should return reconcile requests for NIMServices using the NIMCache for additional models", func() {
+				nimCache := &appsv1alpha1.NIMCache{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-mistral-nimcache",
+						Namespace: "default",
+					},
+				}
+				Expect(testClient.Create(ctx, nimCache)).To(Succeed())
+
+				nimService := &appsv1alpha1.NIMService{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-service",
+						Namespace: "default",
+					},
+					Spec: appsv1alpha1.NIMServiceSpec{
+						Storage: appsv1alpha1.NIMServiceStorage{
+							NIMCache: appsv1alpha1.NIMCacheVolSpec{
+								Name: "test-nimcache",
+							},
+						},
+						MultiModel: &appsv1alpha1.MultiModelSpec{
+							Models: []appsv1alpha1.NIMServiceModelSpec{
+								{Name: "mistral", NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: "test-mistral-nimcache"}},
+							},
+						},
+					},
+				}
+				Expect(testClient.Create(ctx, nimService)).To(Succeed())
+
+				requests := reconciler.mapNIMCacheToNIMService(ctx, nimCache)
+				Expect(requests).To(ConsistOf(
+					ctrl.Request{NamespacedName: types.NamespacedName{Name: "test-service", Namespace: "default"}},
+				))
+			})
+
 			It("should return empty requests when no NIMServices reference the cache", func() {
 				nimCache := &appsv1alpha1.NIMCache{
 					ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,135 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kserve
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
+)
+
+// reconcileMultiNodeResources syncs the MPI resources shared by the leader and the workers of a multi-node predictor:
+// the MPI start script, the SSH key pair and the headless service resolving the predictor pods.
+func (r *NIMServiceReconciler) reconcileMultiNodeResources(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	if err := r.createMultiNodeConfigMap(ctx, nimService, nimService.GetDefaultMPIScriptConfigParams()); err != nil {
+		return fmt.Errorf("failed to create MPI script configmap for %s: %v", nimService.Name, err)
+	}
+
+	if err := r.createMultiNodeSSHPK(ctx, nimService); err != nil {
+		return fmt.Errorf("failed to create MPI SSH secret for %s: %v", nimService.Name, err)
+	}
+
+	return r.renderAndSyncResource(ctx, nimService, &corev1.Service{}, func() (client.Object, error) {
+		return r.renderer.Service(nimService.GetKServeMPIServiceParams())
+	}, "service", conditions.ReasonServiceFailed)
+}
+
+func (r *NIMServiceReconciler) createMultiNodeSSHPK(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	secretParams, err := nimService.GetMPISSHSecretParams()
+	if err != nil {
+		return err
+	}
+
+	// The key pair is generated once, the leader and the workers must share it.
+	secret := &corev1.Secret{}
+	err = r.Get(ctx, types.NamespacedName{Name: secretParams.Name, Namespace: secretParams.Namespace}, secret)
+	if err == nil || client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	return r.renderAndSyncResource(ctx, nimService, &corev1.Secret{}, func() (client.Object, error) {
+		return r.renderer.Secret(secretParams)
+	}, "secret", conditions.ReasonSecretFailed)
+}
+
+func (r *NIMServiceReconciler) createMultiNodeConfigMap(ctx context.Context, nimService *appsv1alpha1.NIMService, cmParams *rendertypes.ConfigMapParams) error {
+	cm := &corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{Name: cmParams.Name, Namespace: cmParams.Namespace}, cm)
+	if err == nil || client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	return r.renderAndSyncResource(ctx, nimService, &corev1.ConfigMap{}, func() (client.Object, error) {
+		return r.renderer.ConfigMap(cmParams)
+	}, "configmap", conditions.ReasonConfigMapFailed)
+}
+
+// getMPILeaderVolumes returns the volumes of the leader of a multi-node predictor. The MPI hostfile is written to
+// an emptyDir by the hostfile init container, as the KServe worker pods have no stable hostnames.
+func getMPILeaderVolumes(nimService *appsv1alpha1.NIMService, modelPVC appsv1alpha1.PersistentVolumeClaim) []corev1.Volume {
+	volumes := nimService.GetLeaderVolumes(modelPVC)
+	for i := range volumes {
+		if volumes[i].Name == "mpi-config" {
+			volumes[i].VolumeSource = corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+		}
+	}
+	return volumes
+}
+
+// setMultiNodeWorkerSpec configures the rendered predictor as the MPI leader and adds the worker spec, derived
+// from the leader pod spec, so that both get the same scheduling constraints, init containers and DRA claims.
+func setMultiNodeWorkerSpec(isvc *kservev1beta1.InferenceService, nimService *appsv1alpha1.NIMService,
+	modelPVC appsv1alpha1.PersistentVolumeClaim, workerEnv []corev1.EnvVar) {
+	predictor := &isvc.Spec.Predictor
+	leader := &predictor.Containers[0]
+	// The leader is resolved by the workers through the MPI headless service.
+	predictor.Hostname = nimService.GetKServeMPILeaderHostname()
+	predictor.Subdomain = nimService.GetKServeMPIServiceName()
+
+	workerPodSpec := predictor.PodSpec.DeepCopy()
+	workerPodSpec.Hostname = ""
+	workerPodSpec.InitContainers = slices.Clone(predictor.InitContainers)
+	workerPodSpec.Volumes = nimService.GetWorkerVolumes(modelPVC)
+	worker := leader.DeepCopy()
+	worker.Env = workerEnv
+	worker.VolumeMounts = nimService.GetWorkerVolumeMounts(modelPVC)
+	// Workers do not serve the API, the leader dispatches the work through MPI.
+	worker.Ports = nil
+	worker.LivenessProbe = nil
+	worker.ReadinessProbe = nil
+	worker.StartupProbe = nil
+
+	// Keep the additional volumes of the leader, e.g. the LoRA adapter store.
+	for _, volume := range predictor.Volumes {
+		if volume.Name != "mpi-config" && !slices.ContainsFunc(workerPodSpec.Volumes, func(v corev1.Volume) bool { return v.Name == volume.Name }) {
+			workerPodSpec.Volumes = append(workerPodSpec.Volumes, volume)
+		}
+	}
+	for _, mount := range leader.VolumeMounts {
+		if mount.Name != "mpi-config" && !slices.ContainsFunc(worker.VolumeMounts, func(m corev1.VolumeMount) bool { return m.Name == mount.Name }) {
+			worker.VolumeMounts = append(worker.VolumeMounts, mount)
+		}
+	}
+	workerPodSpec.Containers = []corev1.Container{*worker}
+
+	predictor.InitContainers = append(predictor.InitContainers, nimService.GetKServeMPIHostfileInitContainer())
+	predictor.WorkerSpec = &kservev1beta1.WorkerSpec{
+		PodSpec:              *workerPodSpec,
+		PipelineParallelSize: ptr.To(int(nimService.GetMultiNodePipelineParallelism())),
+		TensorParallelSize:   ptr.To(int(nimService.GetMultiNodeTensorParallelism())),
+	}
+}
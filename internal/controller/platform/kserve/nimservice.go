@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -62,6 +63,10 @@ import (
 const (
 	// ManifestsDir is the directory to render k8s resource manifests.
 	ManifestsDir = "/manifests"
+
+	// kserveStatusRefreshInterval is the interval at which the predictor replicas of a NIMService
+	// scaling to zero are observed.
+	kserveStatusRefreshInterval = 30 * time.Second
 )
 
 // NIMServiceReconciler represents the NIMService reconciler instance for KServe platform.
@@ -99,7 +104,8 @@ func (r *NIMServiceReconciler) GetRenderer() render.Renderer {
 
 func (r *NIMServiceReconciler) cleanupNIMService(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
 	// All dependent (owned) objects will be automatically garbage collected.
-	return nil
+	// The cluster scoped PV sharing a NIMCache from another namespace is not owned by the NIMService.
+	return shared.DeleteSharedNIMCachePV(ctx, r.Client, nimService)
 }
 
 func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimService *appsv1alpha1.NIMService) (ctrl.Result, error) {
@@ -157,12 +163,27 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 	}
 
 	var deploymentMode kserveconstants.DeploymentModeType
-	// Check KServe deployment mode
-	deploymentMode, err = r.getKServeDeploymentMode(ctx, nimService.Spec.Annotations)
+	// Check KServe deployment mode, as requested by the NIMService or defaulted by KServe
+	deploymentMode, err = r.getKServeDeploymentMode(ctx, utils.MergeMaps(nimService.GetKServeAnnotations(), nimService.Spec.Annotations))
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	if nimService.Spec.MultiNode != nil {
+		// KServe only supports multi-node predictors in RawDeployment mode
+		if deploymentMode != kserveconstants.RawDeployment {
+			msg := fmt.Sprintf("multi-node NIMService %s requires the KServe %s mode, got %s", nimService.Name, kserveconstants.RawDeployment, deploymentMode)
+			err = r.updater.SetConditionsFailed(ctx, nimService, conditions.ReasonMultiNodeUnsupported, msg)
+			r.recorder.Eventf(nimService, corev1.EventTypeWarning, conditions.Failed, msg)
+			return ctrl.Result{}, err
+		}
+
+		err = r.reconcileMultiNodeResources(ctx, nimService)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	if deploymentMode == kserveconstants.RawDeployment {
 		// Sync Service Monitor
 		if nimService.IsServiceMonitorEnabled() {
@@ -262,11 +283,39 @@ func (r *NIMServiceReconciler) renderAndSyncCache(ctx context.Context,
 	var modelPVC *appsv1alpha1.PersistentVolumeClaim
 	modelProfile := ""
 
+	// Delete the mirror of a NIMCache volume from another namespace no longer referenced
+	if !nimService.IsCrossNamespaceNIMCache() {
+		if err := shared.DeleteSharedNIMCachePV(ctx, r.Client, nimService); err != nil {
+			return nil, "", nil, err
+		}
+	}
+
 	// Select PVC for model store
 	nimCacheName := nimService.GetNIMCacheName()
+	nimCacheNamespace := nimService.GetNIMCacheNamespace()
 	nimCache := &appsv1alpha1.NIMCache{}
 	if nimCacheName != "" { // nolint:gocritic
-		if err := r.Get(ctx, types.NamespacedName{Name: nimCacheName, Namespace: nimService.GetNamespace()}, nimCache); err != nil {
+		// Fail the NIMService if the NIMCache in another namespace is not granted to it
+		granted, err := shared.IsNIMCacheGranted(ctx, r.Client, nimService.GetNamespace(), types.NamespacedName{Name: nimCacheName, Namespace: nimCacheNamespace})
+		if err != nil {
+			return nil, "", nil, err
+		}
+		if !granted {
+			if err := shared.DeleteSharedNIMCachePV(ctx, r.Client, nimService); err != nil {
+				return nil, "", nil, err
+			}
+			msg := fmt.Sprintf("NIMCache %s/%s is not granted to namespace %s", nimCacheNamespace, nimCacheName, nimService.GetNamespace())
+			statusUpdateErr := r.updater.SetConditionsFailed(ctx, nimService, conditions.ReasonNIMCacheNotGranted, msg)
+			r.recorder.Eventf(nimService, corev1.EventTypeWarning, conditions.Failed, msg)
+			logger.Info(msg, "nimcache", nimCacheName, "nimservice", nimService.Name)
+			if statusUpdateErr != nil {
+				logger.Error(statusUpdateErr, "failed to update status", "nimservice", nimService.Name)
+				return nil, "", nil, statusUpdateErr
+			}
+			return nil, "", nil, nil
+		}
+
+		if err := r.Get(ctx, types.NamespacedName{Name: nimCacheName, Namespace: nimCacheNamespace}, nimCache); err != nil {
 			// Fail the NIMService if the NIMCache is not found
 			if k8serrors.IsNotFound(err) {
 				msg := fmt.Sprintf("NIMCache %s not found", nimCacheName)
@@ -312,18 +361,49 @@ func (r *NIMServiceReconciler) renderAndSyncCache(ctx context.Context,
 			return nil, "", nil, err
 		}
 
-		// Fetch PVC for the associated NIMCache instance and mount it
-		if nimCache.Status.PVC == "" {
-			err := fmt.Errorf("missing PVC for the nimcache instance %s", nimCache.GetName())
-			logger.Error(err, "unable to obtain pvc backing the nimcache instance")
-			return nil, "", nil, err
+		if nimCache.IsObjectStoreEnabled() && nimService.IsCrossNamespaceNIMCache() {
+			// Fail the NIMService as the object store credentials only exist in the NIMCache namespace
+			msg := fmt.Sprintf("NIMCache %s/%s is cached in an object store whose credentials are not shared with namespace %s", nimCacheNamespace, nimCacheName, nimService.GetNamespace())
+			statusUpdateErr := r.updater.SetConditionsFailed(ctx, nimService, conditions.ReasonNIMCacheObjectStoreNotShared, msg)
+			r.recorder.Eventf(nimService, corev1.EventTypeWarning, conditions.Failed, msg)
+			logger.Info(msg, "nimcache", nimCacheName, "nimservice", nimService.Name)
+			if statusUpdateErr != nil {
+				logger.Error(statusUpdateErr, "failed to update status", "nimservice", nimService.Name)
+				return nil, "", nil, statusUpdateErr
+			}
+			return nil, "", nil, nil
 		}
-		if nimCache.Spec.Storage.PVC.Name == "" {
-			nimCache.Spec.Storage.PVC.Name = nimCache.Status.PVC
+
+		if nimCache.IsObjectStoreEnabled() {
+			// Hydrate the model store from the object store backing the NIMCache instance
+			var err error
+			modelPVC, err = r.getObjectStoreModelPVC(ctx, nimService)
+			if err != nil {
+				logger.Error(err, "unable to obtain pvc to hydrate the nimcache instance")
+				return nil, "", nil, err
+			}
+		} else if nimService.IsCrossNamespaceNIMCache() {
+			// Mirror the PVC of the NIMCache instance in another namespace and mount it
+			var err error
+			modelPVC, err = shared.ReconcileSharedNIMCachePVC(ctx, r.Client, r.scheme, nimService, nimCache)
+			if err != nil {
+				logger.Error(err, "unable to share pvc backing the nimcache instance", "nimcache", nimCacheName, "namespace", nimCacheNamespace)
+				return nil, "", nil, err
+			}
+		} else {
+			// Fetch PVC for the associated NIMCache instance and mount it
+			if nimCache.Status.PVC == "" {
+				err := fmt.Errorf("missing PVC for the nimcache instance %s", nimCache.GetName())
+				logger.Error(err, "unable to obtain pvc backing the nimcache instance")
+				return nil, "", nil, err
+			}
+			if nimCache.Spec.Storage.PVC.Name == "" {
+				nimCache.Spec.Storage.PVC.Name = nimCache.Status.PVC
+			}
+			// Get the underlying PVC for the NIMCache instance
+			modelPVC = &nimCache.Spec.Storage.PVC
+			logger.V(2).Info("obtained the backing pvc for nimcache instance", "pvc", modelPVC)
 		}
-		// Get the underlying PVC for the NIMCache instance
-		modelPVC = &nimCache.Spec.Storage.PVC
-		logger.V(2).Info("obtained the backing pvc for nimcache instance", "pvc", modelPVC)
 
 		if profile := nimService.GetNIMCacheProfile(); profile != "" {
 			logger.Info("overriding model profile", "profile", profile)
@@ -398,6 +478,18 @@ func (r *NIMServiceReconciler) reconcilePVC(ctx context.Context, nimService *app
 	return &nimService.Spec.Storage.PVC, nil
 }
 
+// getObjectStoreModelPVC returns the PVC the model store is hydrated into from an object store NIMCache.
+// An empty PVC is returned when no PVC is configured on the NIMService, to hydrate the model store into an emptyDir.
+func (r *NIMServiceReconciler) getObjectStoreModelPVC(ctx context.Context, nimService *appsv1alpha1.NIMService) (*appsv1alpha1.PersistentVolumeClaim, error) {
+	if nimService.Spec.Storage.PVC.Create != nil && *nimService.Spec.Storage.PVC.Create {
+		return r.reconcilePVC(ctx, nimService)
+	}
+	if nimService.Spec.Storage.PVC.Name != "" {
+		return &nimService.Spec.Storage.PVC, nil
+	}
+	return &appsv1alpha1.PersistentVolumeClaim{}, nil
+}
+
 func (r *NIMServiceReconciler) renderAndSyncInferenceService(ctx context.Context,
 	nimService *appsv1alpha1.NIMService, modelPVC *appsv1alpha1.PersistentVolumeClaim, modelProfile string,
 	nimCache *appsv1alpha1.NIMCache, deploymentMode kserveconstants.DeploymentModeType) error {
@@ -444,9 +536,19 @@ func (r *NIMServiceReconciler) renderAndSyncInferenceService(ctx context.Context
 		// TODO: assign GPU resources and node selector that is required for the selected profile
 	}
 
-	initContainers = nimService.GetInitContainers()
+	loraAdapters, err := shared.ResolveLoRAAdapters(ctx, r.Client, nimService)
+	if err != nil {
+		logger.Error(err, "Failed to resolve LoRA adapters")
+		if statusErr := r.updater.SetConditionsNotReady(ctx, nimService, conditions.ReasonLoRAAdaptersNotReady, err.Error()); statusErr != nil {
+			logger.Error(statusErr, "failed to update status", "nimservice", nimService.Name)
+		}
+		return err
+	}
+
+	initContainers = append(nimService.GetInitContainers(), shared.GetObjectStoreInitContainers(nimService, nimCache, *modelPVC)...)
+	initContainers = append(initContainers, shared.GetLoRAInitContainers(nimService, loraAdapters)...)
 	namedDraResources := shared.GenerateNamedDRAResources(nimService)
-	err := r.reconcileDRAResources(ctx, nimService, namedDraResources)
+	err = r.reconcileDRAResources(ctx, nimService, namedDraResources)
 	if err != nil {
 		logger.Error(err, "Failed to reconcile DRAResources")
 		return err
@@ -467,19 +569,45 @@ func (r *NIMServiceReconciler) renderAndSyncInferenceService(ctx context.Context
 	isvcParams.OrchestratorType = string(r.orchestratorType)
 
 	isvcParams.PodResourceClaims = shared.GetPodResourceClaims(namedDraResources)
+
+	// Setup the MPI leader and workers of a multi-node predictor
+	var workerEnv []corev1.EnvVar
+	if nimService.Spec.MultiNode != nil {
+		isvcParams.Annotations[kserveconstants.AutoscalerClass] = string(kserveconstants.AutoscalerClassExternal)
+		isvcParams.Env = nimService.GetKServeMultiNodeLeaderEnv()
+		workerEnv = nimService.GetKServeMultiNodeWorkerEnv()
+	}
+
 	if nimCache.IsUniversalNIM() {
-		isvcParams.Env = utils.MergeEnvVars([]corev1.EnvVar{
+		universalEnv := []corev1.EnvVar{
 			{
 				Name:  "NIM_MODEL_NAME",
 				Value: utils.DefaultModelStorePath,
 			},
-		}, isvcParams.Env)
+		}
+		isvcParams.Env = utils.MergeEnvVars(universalEnv, isvcParams.Env)
+		workerEnv = utils.MergeEnvVars(universalEnv, workerEnv)
 	}
 	// Setup volume mounts with model store
-	isvcParams.Volumes = nimService.GetVolumes(*modelPVC)
-	isvcParams.VolumeMounts = nimService.GetVolumeMounts(*modelPVC)
+	if nimService.Spec.MultiNode != nil {
+		isvcParams.Volumes = getMPILeaderVolumes(nimService, *modelPVC)
+		isvcParams.VolumeMounts = nimService.GetLeaderVolumeMounts(*modelPVC)
+	} else {
+		isvcParams.Volumes = nimService.GetVolumes(*modelPVC)
+		isvcParams.VolumeMounts = nimService.GetVolumeMounts(*modelPVC)
+	}
+	if nimCache.IsObjectStoreEnabled() {
+		isvcParams.ImagePullSecrets = append(slices.Clone(isvcParams.ImagePullSecrets), shared.GetObjectStoreImagePullSecrets(nimCache)...)
+	}
+	// Setup the LoRA adapter store
+	if len(loraAdapters) > 0 {
+		isvcParams.Volumes = append(isvcParams.Volumes, shared.GetLoRAVolumes(loraAdapters)...)
+		isvcParams.VolumeMounts = append(isvcParams.VolumeMounts, shared.GetLoRAVolumeMounts(loraAdapters)...)
+		isvcParams.ImagePullSecrets = append(slices.Clone(isvcParams.ImagePullSecrets), shared.GetLoRAImagePullSecrets(loraAdapters)...)
+	}
 	if profileEnv != nil {
 		isvcParams.Env = utils.MergeEnvVars(*profileEnv, isvcParams.Env)
+		workerEnv = utils.MergeEnvVars(*profileEnv, workerEnv)
 	}
 	// Auto assign GPU resources in case of the optimized profile
 	if gpuResources != nil {
@@ -493,8 +621,14 @@ func (r *NIMServiceReconciler) renderAndSyncInferenceService(ctx context.Context
 		if len(initContainers) > 0 {
 			result.Spec.Predictor.InitContainers = initContainers
 		}
+		if nimService.Spec.MultiNode != nil {
+			setMultiNodeWorkerSpec(result, nimService, *modelPVC, workerEnv)
+		}
 		// Update Container resources with DRA resource claims.
 		shared.UpdateContainerResourceClaims(result.Spec.Predictor.Containers, namedDraResources)
+		if result.Spec.Predictor.WorkerSpec != nil {
+			shared.UpdateContainerResourceClaims(result.Spec.Predictor.WorkerSpec.Containers, namedDraResources)
+		}
 		return result, nil
 	}
 	conType = "InferenceService"
@@ -518,9 +652,9 @@ func (r *NIMServiceReconciler) getNIMCacheProfile(ctx context.Context, nimServic
 		return nil, nil
 	}
 
-	// Lookup NIMCache instance in the same namespace as the NIMService instance
+	// Lookup NIMCache instance in the namespace it is referenced in, defaulting to the NIMService namespace
 	nimCache := &appsv1alpha1.NIMCache{}
-	if err := r.Get(ctx, types.NamespacedName{Name: nimService.GetNIMCacheName(), Namespace: nimService.Namespace}, nimCache); err != nil {
+	if err := r.Get(ctx, types.NamespacedName{Name: nimService.GetNIMCacheName(), Namespace: nimService.GetNIMCacheNamespace()}, nimCache); err != nil {
 		logger.Error(err, "unable to fetch nimcache", "nimcache", nimService.GetNIMCacheName(), "nimservice", nimService.Name)
 		return nil, err
 	}
@@ -635,6 +769,12 @@ func (r *NIMServiceReconciler) checkInferenceServiceStatus(ctx context.Context,
 		}
 	}
 
+	// Update NIMServiceStatus with the observed InferenceService state.
+	if err = r.updateKServeStatus(ctx, nimService, deploymentMode); err != nil {
+		logger.Error(err, "failed to update kserve status", "nimservice", nimService.Name)
+		return &ctrl.Result{}, err
+	}
+
 	// TODO: Rework NIMService Status to split into MODIFY and APPLY phases for better readability
 	// (Currently we're using `updater.SetConditions*` to implicitly take all previous changes and
 	// apply them along with the conditions.)
@@ -662,6 +802,10 @@ func (r *NIMServiceReconciler) checkInferenceServiceStatus(ctx context.Context,
 		return &ctrl.Result{}, err
 	}
 
+	// Knative scales the predictor without updating the InferenceService, poll to observe cold starts.
+	if deploymentMode == kserveconstants.Serverless && nimService.IsKServeScaleToZeroEnabled() {
+		return &ctrl.Result{RequeueAfter: kserveStatusRefreshInterval}, nil
+	}
 	return &ctrl.Result{}, nil
 }
 
@@ -704,15 +848,94 @@ func (r *NIMServiceReconciler) updateModelStatus(ctx context.Context, nimService
 	if err != nil {
 		return err
 	}
+	var loraAdapters []string
+	if nimService.IsLoRAEnabled() {
+		loraAdapters, err = r.getNIMLoRAAdapters(ctx, clusterEndpoint)
+		if err != nil {
+			return err
+		}
+	}
 	nimService.Status.Model = &appsv1alpha1.ModelStatus{
 		Name:             modelName,
 		ClusterEndpoint:  clusterEndpoint,
 		ExternalEndpoint: externalEndpoint,
+		LoRAAdapters:     loraAdapters,
+	}
+
+	return nil
+}
+
+// updateKServeStatus records the observed deployment mode, revision and predictor replicas of the InferenceService,
+// along with the timings of the latest predictor replica start, e.g. a cold start after scaling from zero.
+func (r *NIMServiceReconciler) updateKServeStatus(ctx context.Context, nimService *appsv1alpha1.NIMService,
+	deploymentMode kserveconstants.DeploymentModeType) error {
+	status := &appsv1alpha1.KServeStatus{
+		DeploymentMode: appsv1alpha1.KServeDeploymentMode(deploymentMode),
+	}
+	if nimService.Status.KServe != nil {
+		status.LastColdStart = nimService.Status.KServe.LastColdStart
+	}
+
+	isvc := &kservev1beta1.InferenceService{}
+	err := r.Get(ctx, client.ObjectKey{Name: nimService.Name, Namespace: nimService.Namespace}, isvc)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	if predictor, ok := isvc.Status.Components[kservev1beta1.PredictorComponent]; ok {
+		status.LatestReadyRevision = predictor.LatestReadyRevision
+	}
+
+	podList := &corev1.PodList{}
+	err = r.List(ctx, podList, client.InNamespace(nimService.Namespace),
+		client.MatchingLabels{kserveconstants.InferenceServicePodLabelKey: nimService.Name})
+	if err != nil {
+		return err
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		readyCond := getPodReadyCondition(pod)
+		if readyCond == nil || readyCond.Status != corev1.ConditionTrue {
+			continue
+		}
+		status.ReadyReplicas++
+		if status.LastColdStart != nil && !status.LastColdStart.ReadyTime.Before(&readyCond.LastTransitionTime) {
+			continue
+		}
+		status.LastColdStart = &appsv1alpha1.KServeColdStartStatus{
+			PodName:         pod.Name,
+			StartTime:       pod.CreationTimestamp,
+			ReadyTime:       readyCond.LastTransitionTime,
+			DurationSeconds: int64(readyCond.LastTransitionTime.Sub(pod.CreationTimestamp.Time).Seconds()),
+		}
 	}
 
+	nimService.Status.KServe = status
 	return nil
 }
 
+func getPodReadyCondition(pod *corev1.Pod) *corev1.PodCondition {
+	for i := range pod.Status.Conditions {
+		if pod.Status.Conditions[i].Type == corev1.PodReady {
+			return &pod.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func (r *NIMServiceReconciler) getNIMLoRAAdapters(ctx context.Context, nimServiceEndpoint string) ([]string, error) {
+	logger := log.FromContext(ctx)
+
+	modelsList, err := nimmodels.ListModelsV1(ctx, nimServiceEndpoint, "")
+	if err != nil {
+		logger.Error(err, "Failed to list LoRA adapters", "endpoint", nimServiceEndpoint)
+		return nil, err
+	}
+	return nimmodels.GetLoRAAdapterIDs(modelsList), nil
+}
+
 func (r *NIMServiceReconciler) getNIMModelEndpoints(ctx context.Context, nimService *appsv1alpha1.NIMService,
 	deploymentMode kserveconstants.DeploymentModeType) (string, string, error) {
 	logger := r.log
@@ -967,16 +1190,11 @@ func (r *NIMServiceReconciler) reconcileDRAResources(ctx context.Context, nimSer
 				ClaimAnnotations: claimAnnotations,
 			}
 			for _, device := range namedDraResource.ClaimCreationSpec.Devices {
-				exprs, err := shared.GetDRADeviceCELExpressions(device)
+				deviceParams, err := shared.GetDRADeviceParams(device)
 				if err != nil {
 					return nil, err
 				}
-				resourceClaimTemplateParams.Devices = append(resourceClaimTemplateParams.Devices, rendertypes.DRADeviceParams{
-					Name:            device.Name,
-					Count:           device.Count,
-					DeviceClassName: device.DeviceClassName,
-					CELExpressions:  exprs,
-				})
+				resourceClaimTemplateParams.Devices = append(resourceClaimTemplateParams.Devices, deviceParams)
 			}
 			return r.renderer.ResourceClaimTemplate(resourceClaimTemplateParams)
 		}, "resourceclaimtemplate", conditions.ReasonResourceClaimTemplateFailed)
@@ -28,6 +28,7 @@ import (
 	"path"
 	"sort"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -613,6 +614,115 @@ var _ = Describe("NIMServiceReconciler for a KServe platform", func() {
 			}
 		})
 
+		Context("spec reconciliation with MultiNode", func() {
+			BeforeEach(func() {
+				nimService.Spec.Scale.Enabled = ptr.To(false)
+				nimService.Spec.MultiNode = &appsv1alpha1.NimServiceMultiNodeConfig{
+					BackendType: appsv1alpha1.NIMBackendTypeLWS,
+					Parallelism: &appsv1alpha1.ParallelismSpec{
+						Pipeline: ptr.To(uint32(2)),
+						Tensor:   ptr.To(uint32(4)),
+					},
+				}
+			})
+
+			getEnvValue := func(env []corev1.EnvVar, name string) string {
+				for _, e := range env {
+					if e.Name == name {
+						return e.Value
+					}
+				}
+				return ""
+			}
+
+			It("should render the MPI leader and workers of the predictor", func() {
+				nimService.Spec.DRAResources = []appsv1alpha1.DRAResource{
+					{
+						ResourceClaimName: ptr.To("test-resource-claim"),
+					},
+				}
+				nimServiceKey := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+				err := client.Create(context.TODO(), nimService)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+				Expect(err).NotTo(HaveOccurred())
+
+				// MPI resources shared by the leader and the workers
+				svc := &corev1.Service{}
+				err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.GetKServeMPIServiceName(), Namespace: nimService.Namespace}, svc)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(svc.Spec.ClusterIP).To(Equal(corev1.ClusterIPNone))
+				Expect(svc.Spec.PublishNotReadyAddresses).To(BeTrue())
+				Expect(svc.Spec.Selector).To(Equal(map[string]string{kserveconstants.InferenceServicePodLabelKey: nimService.Name}))
+				err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name + "-ssh-pk", Namespace: nimService.Namespace}, &corev1.Secret{})
+				Expect(err).NotTo(HaveOccurred())
+				err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name + "-mpi-start-script", Namespace: nimService.Namespace}, &corev1.ConfigMap{})
+				Expect(err).NotTo(HaveOccurred())
+
+				isvc := &kservev1beta1.InferenceService{}
+				err = client.Get(context.TODO(), nimServiceKey, isvc)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(isvc.Annotations).To(HaveKeyWithValue(kserveconstants.AutoscalerClass, string(kserveconstants.AutoscalerClassExternal)))
+
+				// Leader
+				predictor := isvc.Spec.Predictor
+				Expect(predictor.Hostname).To(Equal(nimService.GetKServeMPILeaderHostname()))
+				Expect(predictor.Subdomain).To(Equal(nimService.GetKServeMPIServiceName()))
+				Expect(predictor.InitContainers).NotTo(BeEmpty())
+				Expect(predictor.InitContainers[len(predictor.InitContainers)-1].Name).To(Equal("mpi-hostfile"))
+				leader := predictor.Containers[0]
+				Expect(getEnvValue(leader.Env, "NIM_LEADER_ROLE")).To(Equal("1"))
+				Expect(getEnvValue(leader.Env, "CLUSTER_SIZE")).To(Equal("2"))
+				Expect(getEnvValue(leader.Env, "GPUS_PER_NODE")).To(Equal("4"))
+				Expect(leader.VolumeMounts).To(ContainElement(HaveField("Name", "mpi-config")))
+				Expect(predictor.Volumes).To(ContainElement(And(HaveField("Name", "mpi-config"), HaveField("EmptyDir", Not(BeNil())))))
+				Expect(leader.Resources.Claims).To(HaveLen(1))
+
+				// Workers
+				Expect(predictor.WorkerSpec).NotTo(BeNil())
+				Expect(*predictor.WorkerSpec.PipelineParallelSize).To(Equal(2))
+				Expect(*predictor.WorkerSpec.TensorParallelSize).To(Equal(4))
+				Expect(predictor.WorkerSpec.Hostname).To(BeEmpty())
+				Expect(predictor.WorkerSpec.Subdomain).To(Equal(nimService.GetKServeMPIServiceName()))
+				Expect(predictor.WorkerSpec.Containers).To(HaveLen(1))
+				worker := predictor.WorkerSpec.Containers[0]
+				Expect(worker.Image).To(Equal(leader.Image))
+				Expect(worker.Ports).To(BeEmpty())
+				Expect(worker.ReadinessProbe).To(BeNil())
+				Expect(getEnvValue(worker.Env, "NIM_LEADER_ROLE")).To(Equal("0"))
+				Expect(getEnvValue(worker.Env, "LEADER_NAME")).To(Equal(nimService.GetKServeMPILeaderHostname()))
+				Expect(getEnvValue(worker.Env, "LWS_NAME")).To(Equal(nimService.GetKServeMPIServiceName()))
+				Expect(worker.VolumeMounts).To(ContainElement(HaveField("Name", "ssh-pk")))
+				Expect(worker.VolumeMounts).NotTo(ContainElement(HaveField("Name", "mpi-config")))
+				Expect(predictor.WorkerSpec.InitContainers).NotTo(ContainElement(HaveField("Name", "mpi-hostfile")))
+
+				// DRA claims are injected in the leader and the workers alike
+				Expect(predictor.WorkerSpec.ResourceClaims).To(Equal(predictor.ResourceClaims))
+				Expect(worker.Resources.Claims).To(Equal(leader.Resources.Claims))
+			})
+
+			It("should mark NIMService as failed in Serverless mode", func() {
+				nimService.Spec.KServe = &appsv1alpha1.KServeSpec{DeploymentMode: appsv1alpha1.KServeDeploymentModeServerless}
+				err := client.Create(context.TODO(), nimService)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+				Expect(err).NotTo(HaveOccurred())
+
+				obj := &appsv1alpha1.NIMService{}
+				err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, obj)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(obj.Status.State).To(Equal(appsv1alpha1.NIMServiceStatusFailed))
+				failedCondition := getCondition(obj, conditions.Failed)
+				Expect(failedCondition).NotTo(BeNil())
+				Expect(failedCondition.Reason).To(Equal(conditions.ReasonMultiNodeUnsupported))
+
+				err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, &kservev1beta1.InferenceService{})
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
 		Context("spec reconciliation with DRAResources", func() {
 			It("should request resource claims", func() {
 				nimService.Spec.DRAResources = []appsv1alpha1.DRAResource{
@@ -856,6 +966,50 @@ var _ = Describe("NIMServiceReconciler for a KServe platform", func() {
 			Expect(found).Should(BeTrue())
 			Expect(visibility).Should(Equal(kserveconstants.ClusterLocalVisibility))
 		})
+
+		It("should configure Knative autoscaling when Serverless mode is requested", func() {
+			namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			nimService.Spec.Scale.Enabled = ptr.To(false)
+			nimService.Spec.Metrics.Enabled = ptr.To(false)
+			nimService.Spec.KServe = &appsv1alpha1.KServeSpec{
+				DeploymentMode: appsv1alpha1.KServeDeploymentModeServerless,
+				Serverless: &appsv1alpha1.KServeServerlessSpec{
+					MinScale:               ptr.To[int32](0),
+					MaxScale:               ptr.To[int32](4),
+					Metric:                 appsv1alpha1.KServeScaleMetricConcurrency,
+					Target:                 ptr.To[int32](8),
+					ContainerConcurrency:   ptr.To[int64](16),
+					ScaleToZeroGracePeriod: &metav1.Duration{Duration: 5 * time.Minute},
+				},
+			}
+			err := client.Create(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{RequeueAfter: kserveStatusRefreshInterval}))
+
+			isvc := &kservev1beta1.InferenceService{}
+			err = client.Get(context.TODO(), namespacedName, isvc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isvc.Annotations).To(HaveKeyWithValue(kserveconstants.DeploymentMode, string(kserveconstants.Serverless)))
+			Expect(isvc.Spec.Predictor.Annotations).To(HaveKeyWithValue(appsv1alpha1.KnativeScaleToZeroPodRetentionPeriodAnnotationKey, "5m0s"))
+			Expect(*isvc.Spec.Predictor.MinReplicas).To(Equal(int32(0)))
+			Expect(isvc.Spec.Predictor.MaxReplicas).To(Equal(int32(4)))
+			Expect(*isvc.Spec.Predictor.ScaleMetric).To(Equal(kservev1beta1.MetricConcurrency))
+			Expect(*isvc.Spec.Predictor.ScaleTarget).To(Equal(int32(8)))
+			Expect(*isvc.Spec.Predictor.ContainerConcurrency).To(Equal(int64(16)))
+			Expect(isvc.Spec.Predictor.ScaleMetricType).To(BeNil())
+			Expect(isvc.Spec.Predictor.DeploymentStrategy).To(BeNil())
+
+			// Service Monitor is not supported in Serverless mode
+			sm := &monitoringv1.ServiceMonitor{}
+			err = client.Get(context.TODO(), namespacedName, sm)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			Expect(nimService.Status.KServe).NotTo(BeNil())
+			Expect(nimService.Status.KServe.DeploymentMode).To(Equal(appsv1alpha1.KServeDeploymentModeServerless))
+		})
 	})
 
 	It("should be NotReady when nimcache is not ready", func() {
@@ -987,6 +1141,67 @@ var _ = Describe("NIMServiceReconciler for a KServe platform", func() {
 		})
 	})
 
+	Describe("update kserve status on NIMService", func() {
+		newPredictorPod := func(name string, created, ready time.Time) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         nimService.Namespace,
+					Labels:            map[string]string{kserveconstants.InferenceServicePodLabelKey: nimService.Name},
+					CreationTimestamp: metav1.NewTime(created),
+				},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{
+						{Type: corev1.PodReady, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(ready)},
+					},
+				},
+			}
+		}
+
+		It("should report no ready replicas when scaled to zero", func() {
+			err := reconciler.updateKServeStatus(context.TODO(), nimService, kserveconstants.Serverless)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nimService.Status.KServe).To(Equal(&appsv1alpha1.KServeStatus{
+				DeploymentMode: appsv1alpha1.KServeDeploymentModeServerless,
+			}))
+		})
+
+		It("should report the revision and the latest cold start timings", func() {
+			now := time.Now().Truncate(time.Second)
+			isvc := &kservev1beta1.InferenceService{
+				ObjectMeta: metav1.ObjectMeta{Name: nimService.Name, Namespace: nimService.Namespace},
+				Status: kservev1beta1.InferenceServiceStatus{
+					Components: map[kservev1beta1.ComponentType]kservev1beta1.ComponentStatusSpec{
+						kservev1beta1.PredictorComponent: {LatestReadyRevision: "test-nimservice-predictor-00002"},
+					},
+				},
+			}
+			Expect(client.Create(context.TODO(), isvc)).To(Succeed())
+			Expect(client.Create(context.TODO(), newPredictorPod("predictor-a", now.Add(-10*time.Minute), now.Add(-8*time.Minute)))).To(Succeed())
+			Expect(client.Create(context.TODO(), newPredictorPod("predictor-b", now.Add(-2*time.Minute), now.Add(-30*time.Second)))).To(Succeed())
+
+			err := reconciler.updateKServeStatus(context.TODO(), nimService, kserveconstants.Serverless)
+			Expect(err).ToNot(HaveOccurred())
+			status := nimService.Status.KServe
+			Expect(status).NotTo(BeNil())
+			Expect(status.LatestReadyRevision).To(Equal("test-nimservice-predictor-00002"))
+			Expect(status.ReadyReplicas).To(Equal(int32(2)))
+			Expect(status.LastColdStart).NotTo(BeNil())
+			Expect(status.LastColdStart.PodName).To(Equal("predictor-b"))
+			Expect(status.LastColdStart.DurationSeconds).To(Equal(int64(90)))
+
+			// The latest cold start is kept once scaled back to zero
+			for _, name := range []string{"predictor-a", "predictor-b"} {
+				pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: nimService.Namespace}}
+				Expect(client.Delete(context.TODO(), pod)).To(Succeed())
+			}
+			err = reconciler.updateKServeStatus(context.TODO(), nimService, kserveconstants.Serverless)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nimService.Status.KServe.ReadyReplicas).To(Equal(int32(0)))
+			Expect(nimService.Status.KServe.LastColdStart.PodName).To(Equal("predictor-b"))
+		})
+	})
+
 	Describe("update model status on NIMService", func() {
 		var isvc *kservev1beta1.InferenceService
 		BeforeEach(func() {
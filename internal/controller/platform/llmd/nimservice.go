@@ -0,0 +1,661 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package llmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	resourcev1beta2 "k8s.io/api/resource/v1beta2"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+	"github.com/NVIDIA/k8s-nim-operator/internal/nimmodels"
+	"github.com/NVIDIA/k8s-nim-operator/internal/render"
+	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
+	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+)
+
+const (
+	// ManifestsDir is the directory to render k8s resource manifests.
+	ManifestsDir = "/manifests"
+)
+
+// llmdRoles are the roles of an llm-d deployment, in the order they are synced.
+var llmdRoles = []appsv1alpha1.LLMDRole{appsv1alpha1.LLMDRolePrefill, appsv1alpha1.LLMDRoleDecode}
+
+// NIMServiceReconciler represents the NIMService reconciler instance for llm-d platform.
+type NIMServiceReconciler struct {
+	client.Client
+	scheme          *runtime.Scheme
+	log             logr.Logger
+	discoveryClient discovery.DiscoveryInterface
+
+	updater          conditions.Updater
+	renderer         render.Renderer
+	recorder         record.EventRecorder
+	orchestratorType k8sutil.OrchestratorType
+}
+
+// NewNIMServiceReconciler returns NIMServiceReconciler for llm-d platform.
+func NewNIMServiceReconciler(ctx context.Context, r shared.Reconciler) *NIMServiceReconciler {
+	orchestratorType, _ := r.GetOrchestratorType(ctx)
+
+	return &NIMServiceReconciler{
+		Client:           r.GetClient(),
+		scheme:           r.GetScheme(),
+		log:              r.GetLogger(),
+		discoveryClient:  r.GetDiscoveryClient(),
+		updater:          r.GetUpdater(),
+		renderer:         render.NewRenderer(ManifestsDir),
+		recorder:         r.GetEventRecorder(),
+		orchestratorType: orchestratorType,
+	}
+}
+
+func (r *NIMServiceReconciler) cleanupNIMService(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	// All dependent (owned) objects will be automatically garbage collected.
+	// The cluster scoped PV sharing a NIMCache from another namespace is not owned by the NIMService.
+	return shared.DeleteSharedNIMCachePV(ctx, r.Client, nimService)
+}
+
+func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimService *appsv1alpha1.NIMService) (ctrl.Result, error) {
+	var err error
+	defer func() {
+		if err != nil {
+			r.recorder.Eventf(nimService, corev1.EventTypeWarning, conditions.Failed,
+				"NIMService %s failed, msg: %s", nimService.Name, err.Error())
+		}
+	}()
+	namespacedName := types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}
+
+	// Sync serviceaccount
+	err = r.renderAndSyncResource(ctx, nimService, &corev1.ServiceAccount{}, func() (client.Object, error) {
+		return r.renderer.ServiceAccount(nimService.GetServiceAccountParams())
+	}, "serviceaccount", conditions.ReasonServiceAccountFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Sync role
+	err = r.renderAndSyncResource(ctx, nimService, &rbacv1.Role{}, func() (client.Object, error) {
+		return r.renderer.Role(nimService.GetRoleParams())
+	}, "role", conditions.ReasonRoleFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Sync rolebinding
+	err = r.renderAndSyncResource(ctx, nimService, &rbacv1.RoleBinding{}, func() (client.Object, error) {
+		return r.renderer.RoleBinding(nimService.GetRoleBindingParams())
+	}, "rolebinding", conditions.ReasonRoleBindingFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Sync router service, routing to the decode pods
+	err = r.renderAndSyncResource(ctx, nimService, &corev1.Service{}, func() (client.Object, error) {
+		return r.renderer.Service(nimService.GetLLMDServiceParams())
+	}, "service", conditions.ReasonServiceFailed)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Sync ingress
+	if nimService.IsIngressEnabled() {
+		err = r.renderAndSyncResource(ctx, nimService, &networkingv1.Ingress{}, func() (client.Object, error) {
+			return r.renderer.Ingress(nimService.GetIngressParams())
+		}, "ingress", conditions.ReasonIngressFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		err = k8sutil.CleanupResource(ctx, r.Client, &networkingv1.Ingress{}, namespacedName)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Sync gateway route
+	if nimService.IsRouterEnabled() {
+		err = r.renderAndSyncResource(ctx, nimService, &gatewayv1.HTTPRoute{}, func() (client.Object, error) {
+			if err := shared.ValidateGatewayRouteResource(r.discoveryClient, "httproutes"); err != nil {
+				return nil, err
+			}
+			return r.renderer.HTTPRoute(nimService.GetHTTPRouteParams())
+		}, "httproute", conditions.ReasonHTTPRouteFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		err = k8sutil.CleanupResource(ctx, r.Client, &gatewayv1.HTTPRoute{}, namespacedName)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+
+	var modelPVC *appsv1alpha1.PersistentVolumeClaim
+	var modelProfile string
+	var nimCache *appsv1alpha1.NIMCache
+	modelPVC, modelProfile, nimCache, err = r.getModelStore(ctx, nimService)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if modelPVC == nil {
+		return ctrl.Result{}, nil
+	}
+
+	var profile *appsv1alpha1.NIMProfile
+	if modelProfile != "" && nimCache.IsOptimizedNIM() {
+		profile = getNIMCacheProfile(nimCache, modelProfile)
+	}
+
+	initContainers := append(nimService.GetInitContainers(), shared.GetObjectStoreInitContainers(nimService, nimCache, *modelPVC)...)
+	namedDraResourcesByRole := make(map[appsv1alpha1.LLMDRole][]shared.NamedDRAResource, len(llmdRoles))
+	for _, role := range llmdRoles {
+		namedDraResources := shared.GenerateNamedDRAResourcesForWorkload(nimService.GetLLMDDeploymentName(role), nimService.GetLLMDRoleSpec(role).DRAResources)
+		err = r.reconcileDRAResources(ctx, nimService, namedDraResources)
+		if err != nil {
+			r.log.Error(err, "Failed to reconcile DRAResources", "role", role)
+			return ctrl.Result{}, err
+		}
+		namedDraResourcesByRole[role] = namedDraResources
+
+		params := nimService.GetLLMDDeploymentParams(role)
+		params.OrchestratorType = string(r.orchestratorType)
+		params.Volumes = nimService.GetVolumes(*modelPVC)
+		params.VolumeMounts = nimService.GetVolumeMounts(*modelPVC)
+		if nimCache.IsObjectStoreEnabled() {
+			params.ImagePullSecrets = append(slices.Clone(params.ImagePullSecrets), shared.GetObjectStoreImagePullSecrets(nimCache)...)
+		}
+		if nimCache.IsUniversalNIM() {
+			params.Env = utils.MergeEnvVars([]corev1.EnvVar{{
+				Name:  "NIM_MODEL_NAME",
+				Value: utils.DefaultModelStorePath,
+			}}, params.Env)
+		}
+		if modelProfile != "" {
+			params.Env = utils.MergeEnvVars([]corev1.EnvVar{{
+				Name:  "NIM_MODEL_PROFILE",
+				Value: modelProfile,
+			}}, params.Env)
+		}
+		params.PodResourceClaims = shared.GetPodResourceClaims(namedDraResources)
+		// The GPUs of the workers are allocated through their DRA resource claims, if any.
+		if profile != nil && len(namedDraResources) == 0 {
+			params.Resources, err = shared.GetProfileGPUResources(params.Resources, profile)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		if role == appsv1alpha1.LLMDRoleDecode {
+			params.ImagePullSecrets = append(slices.Clone(params.ImagePullSecrets), nimService.GetLLMDImagePullSecrets()...)
+		}
+
+		err = r.renderAndSyncResource(ctx, nimService, &appsv1.Deployment{}, func() (client.Object, error) {
+			result, err := r.renderer.Deployment(params)
+			if err != nil {
+				return nil, err
+			}
+			if len(initContainers) > 0 {
+				result.Spec.Template.Spec.InitContainers = initContainers
+			}
+			// Update NIM container resources with DRA resource claims.
+			shared.UpdateContainerResourceClaims(result.Spec.Template.Spec.Containers, namedDraResources)
+			if role == appsv1alpha1.LLMDRoleDecode {
+				// The routing sidecar fronts NIM in the decode pods.
+				result.Spec.Template.Spec.Containers = append(result.Spec.Template.Spec.Containers, nimService.GetLLMDRoutingSidecarContainer())
+			}
+			return result, nil
+		}, fmt.Sprintf("%s deployment", role), conditions.ReasonDeploymentFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	var msg string
+	var ready bool
+	msg, ready, err = r.isLLMDReady(ctx, nimService)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Update the status of each role with its resource claims.
+	updateErr := r.updateResourceClaimStatus(ctx, nimService, namedDraResourcesByRole)
+	if updateErr != nil {
+		r.log.Info("WARN: Resource claim status update failed, will retry in 5 seconds", "error", updateErr.Error())
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+
+	if !ready {
+		// Update status as NotReady
+		err = r.updater.SetConditionsNotReady(ctx, nimService, conditions.NotReady, msg)
+		r.recorder.Eventf(nimService, corev1.EventTypeNormal, conditions.NotReady,
+			"NIMService %s not ready yet, msg: %s", nimService.Name, msg)
+	} else {
+		// Update NIMServiceStatus with model config.
+		updateErr := r.updateModelStatus(ctx, nimService)
+		if updateErr != nil {
+			r.log.Info("WARN: Model status update failed, will retry in 5 seconds", "error", updateErr.Error())
+			return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		}
+
+		// Update status as ready
+		err = r.updater.SetConditionsReady(ctx, nimService, conditions.Ready, msg)
+		r.recorder.Eventf(nimService, corev1.EventTypeNormal, conditions.Ready,
+			"NIMService %s ready, msg: %s", nimService.Name, msg)
+	}
+	if err != nil {
+		r.log.Error(err, "failed to update status", "nimservice", nimService.Name)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *NIMServiceReconciler) renderAndSyncResource(ctx context.Context, nimService *appsv1alpha1.NIMService,
+	obj client.Object, renderFunc func() (client.Object, error), conditionType string, reason string) error {
+	logger := r.log
+
+	resource, err := renderFunc()
+	if err != nil {
+		logger.Error(err, "failed to render", "conditionType", conditionType)
+		statusError := r.updater.SetConditionsFailed(ctx, nimService, reason, err.Error())
+		if statusError != nil {
+			logger.Error(statusError, "failed to update status", "nimservice", nimService.Name)
+		}
+		return err
+	}
+
+	// Check if the resource is nil
+	if resource == nil {
+		logger.V(2).Info("rendered nil resource")
+		return nil
+	}
+
+	metaAccessor, ok := resource.(metav1.Object)
+	if !ok || metaAccessor.GetName() == "" || metaAccessor.GetNamespace() == "" {
+		logger.V(2).Info("rendered un-initialized resource")
+		return nil
+	}
+
+	namespacedName := types.NamespacedName{Name: resource.GetName(), Namespace: resource.GetNamespace()}
+
+	err = r.Get(ctx, namespacedName, obj)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		logger.Error(err, fmt.Sprintf("Error is not NotFound for %s: %v", obj.GetObjectKind(), err))
+		return err
+	}
+	// Don't do anything if CR is unchanged.
+	if err == nil && !utils.IsParentSpecChanged(obj, utils.DeepHashObject(nimService.Spec)) {
+		return nil
+	}
+
+	if err = controllerutil.SetControllerReference(nimService, resource, r.scheme); err != nil {
+		logger.Error(err, "failed to set owner", conditionType, namespacedName)
+		statusError := r.updater.SetConditionsFailed(ctx, nimService, reason, err.Error())
+		if statusError != nil {
+			logger.Error(statusError, "failed to update status", "nimservice", nimService.Name)
+		}
+		return err
+	}
+
+	err = k8sutil.SyncResource(ctx, r.Client, obj, resource)
+	if err != nil {
+		logger.Error(err, "failed to sync", conditionType, namespacedName)
+		statusError := r.updater.SetConditionsFailed(ctx, nimService, reason, err.Error())
+		if statusError != nil {
+			logger.Error(statusError, "failed to update status", "nimservice", nimService.Name)
+		}
+		return err
+	}
+	return nil
+}
+
+// getModelStore returns the PVC backing the model store of the NIMService, along with the model profile and the NIMCache to use.
+// A nil PVC is returned when the NIMService is not ready to be deployed yet, the NIMService status being updated accordingly.
+func (r *NIMServiceReconciler) getModelStore(ctx context.Context, nimService *appsv1alpha1.NIMService) (*appsv1alpha1.PersistentVolumeClaim, string, *appsv1alpha1.NIMCache, error) {
+	logger := r.log
+
+	// Delete the mirror of a NIMCache volume from another namespace no longer referenced
+	if !nimService.IsCrossNamespaceNIMCache() {
+		if err := shared.DeleteSharedNIMCachePV(ctx, r.Client, nimService); err != nil {
+			return nil, "", nil, err
+		}
+	}
+
+	nimCache := &appsv1alpha1.NIMCache{}
+	nimCacheName := nimService.GetNIMCacheName()
+	nimCacheNamespace := nimService.GetNIMCacheNamespace()
+	if nimCacheName == "" {
+		if nimService.Spec.Storage.PVC.Create != nil && *nimService.Spec.Storage.PVC.Create {
+			// Create a new PVC
+			modelPVC, err := r.reconcilePVC(ctx, nimService)
+			if err != nil {
+				logger.Error(err, "unable to create pvc")
+				return nil, "", nil, err
+			}
+			return modelPVC, "", nimCache, nil
+		}
+		if nimService.Spec.Storage.PVC.Name != "" {
+			// Use an existing PVC
+			return &nimService.Spec.Storage.PVC, "", nimCache, nil
+		}
+		err := fmt.Errorf("neither external PVC name or NIMCache volume is provided")
+		logger.Error(err, "failed to determine PVC for model-store")
+		return nil, "", nil, err
+	}
+
+	// Fail the NIMService if the NIMCache in another namespace is not granted to it
+	granted, err := shared.IsNIMCacheGranted(ctx, r.Client, nimService.GetNamespace(), types.NamespacedName{Name: nimCacheName, Namespace: nimCacheNamespace})
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if !granted {
+		if err := shared.DeleteSharedNIMCachePV(ctx, r.Client, nimService); err != nil {
+			return nil, "", nil, err
+		}
+		msg := fmt.Sprintf("NIMCache %s/%s is not granted to namespace %s", nimCacheNamespace, nimCacheName, nimService.GetNamespace())
+		return nil, "", nil, r.setNotDeployable(ctx, nimService, conditions.ReasonNIMCacheNotGranted, msg, true)
+	}
+
+	if err := r.Get(ctx, types.NamespacedName{Name: nimCacheName, Namespace: nimCacheNamespace}, nimCache); err != nil {
+		// Fail the NIMService if the NIMCache is not found
+		if k8serrors.IsNotFound(err) {
+			msg := fmt.Sprintf("NIMCache %s not found", nimCacheName)
+			return nil, "", nil, r.setNotDeployable(ctx, nimService, conditions.ReasonNIMCacheNotFound, msg, true)
+		}
+		return nil, "", nil, err
+	}
+
+	switch nimCache.Status.State {
+	case appsv1alpha1.NimCacheStatusReady:
+		logger.V(4).Info("NIMCache is ready", "nimcache", nimCacheName, "nimservice", nimService.Name)
+	case appsv1alpha1.NimCacheStatusFailed:
+		var msg string
+		cond := meta.FindStatusCondition(nimCache.Status.Conditions, conditions.Failed)
+		if cond != nil && cond.Status == metav1.ConditionTrue {
+			msg = cond.Message
+		}
+		return nil, "", nil, r.setNotDeployable(ctx, nimService, conditions.ReasonNIMCacheFailed, msg, true)
+	default:
+		msg := fmt.Sprintf("NIMCache %s not ready", nimCacheName)
+		return nil, "", nil, r.setNotDeployable(ctx, nimService, conditions.ReasonNIMCacheNotReady, msg, false)
+	}
+
+	if nimCache.IsObjectStoreEnabled() && nimService.IsCrossNamespaceNIMCache() {
+		// Fail the NIMService as the object store credentials only exist in the NIMCache namespace
+		msg := fmt.Sprintf("NIMCache %s/%s is cached in an object store whose credentials are not shared with namespace %s", nimCacheNamespace, nimCacheName, nimService.GetNamespace())
+		return nil, "", nil, r.setNotDeployable(ctx, nimService, conditions.ReasonNIMCacheObjectStoreNotShared, msg, true)
+	}
+
+	var modelPVC *appsv1alpha1.PersistentVolumeClaim
+	if nimCache.IsObjectStoreEnabled() {
+		// Hydrate the model store from the object store backing the NIMCache instance
+		if nimService.Spec.Storage.PVC.Create != nil && *nimService.Spec.Storage.PVC.Create {
+			modelPVC, err = r.reconcilePVC(ctx, nimService)
+		} else if nimService.Spec.Storage.PVC.Name != "" {
+			modelPVC = &nimService.Spec.Storage.PVC
+		} else {
+			modelPVC = &appsv1alpha1.PersistentVolumeClaim{}
+		}
+		if err != nil {
+			logger.Error(err, "unable to obtain pvc to hydrate the nimcache instance")
+			return nil, "", nil, err
+		}
+	} else if nimService.IsCrossNamespaceNIMCache() {
+		// Mirror the PVC of the NIMCache instance in another namespace and mount it
+		modelPVC, err = shared.ReconcileSharedNIMCachePVC(ctx, r.Client, r.scheme, nimService, nimCache)
+		if err != nil {
+			logger.Error(err, "unable to share pvc backing the nimcache instance", "nimcache", nimCacheName, "namespace", nimCacheNamespace)
+			return nil, "", nil, err
+		}
+	} else {
+		// Fetch PVC for the associated NIMCache instance and mount it
+		if nimCache.Status.PVC == "" {
+			err = fmt.Errorf("missing PVC for the nimcache instance %s", nimCache.GetName())
+			logger.Error(err, "unable to obtain pvc backing the nimcache instance")
+			return nil, "", nil, err
+		}
+		if nimCache.Spec.Storage.PVC.Name == "" {
+			nimCache.Spec.Storage.PVC.Name = nimCache.Status.PVC
+		}
+		modelPVC = &nimCache.Spec.Storage.PVC
+	}
+
+	return modelPVC, nimService.GetNIMCacheProfile(), nimCache, nil
+}
+
+// setNotDeployable updates the NIMService status when its NIMCache cannot be used (yet).
+func (r *NIMServiceReconciler) setNotDeployable(ctx context.Context, nimService *appsv1alpha1.NIMService, reason, msg string, failed bool) error {
+	var err error
+	if failed {
+		err = r.updater.SetConditionsFailed(ctx, nimService, reason, msg)
+		r.recorder.Eventf(nimService, corev1.EventTypeWarning, conditions.Failed, msg)
+	} else {
+		err = r.updater.SetConditionsNotReady(ctx, nimService, reason, msg)
+		r.recorder.Eventf(nimService, corev1.EventTypeNormal, conditions.NotReady,
+			"NIMService %s not ready yet, msg: %s", nimService.Name, msg)
+	}
+	r.log.Info(msg, "nimservice", nimService.Name)
+	if err != nil {
+		r.log.Error(err, "failed to update status", "nimservice", nimService.Name)
+	}
+	return err
+}
+
+func (r *NIMServiceReconciler) reconcilePVC(ctx context.Context, nimService *appsv1alpha1.NIMService) (*appsv1alpha1.PersistentVolumeClaim, error) {
+	logger := r.log
+
+	pvcName := nimService.GetPVCName(nimService.Spec.Storage.PVC)
+	pvcNamespacedName := types.NamespacedName{Name: pvcName, Namespace: nimService.GetNamespace()}
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := r.Get(ctx, pvcNamespacedName, pvc)
+	if err != nil && client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+
+	// If PVC does not exist, create a new one
+	if err != nil {
+		pvc, err = shared.ConstructPVC(nimService.Spec.Storage.PVC, metav1.ObjectMeta{Name: pvcName, Namespace: nimService.GetNamespace()})
+		if err != nil {
+			logger.Error(err, "Failed to construct pvc", "name", pvcName)
+			return nil, err
+		}
+		if err := controllerutil.SetControllerReference(nimService, pvc, r.scheme); err != nil {
+			return nil, err
+		}
+		err = r.Create(ctx, pvc)
+		if err != nil {
+			logger.Error(err, "Failed to create pvc", "name", pvc.Name)
+			return nil, err
+		}
+		logger.Info("Created PVC for NIM Service", "pvc", pvcName)
+	}
+
+	// If explicit name is not provided in the spec, update it with the one created
+	if nimService.Spec.Storage.PVC.Name == "" {
+		nimService.Spec.Storage.PVC.Name = pvc.Name
+	}
+	return &nimService.Spec.Storage.PVC, nil
+}
+
+// getNIMCacheProfile returns the given model profile cached by the NIMCache, or nil if it is not cached.
+func getNIMCacheProfile(nimCache *appsv1alpha1.NIMCache, profile string) *appsv1alpha1.NIMProfile {
+	for i := range nimCache.Status.Profiles {
+		if nimCache.Status.Profiles[i].Name == profile {
+			return &nimCache.Status.Profiles[i]
+		}
+	}
+	return nil
+}
+
+// isLLMDReady checks if the deployments of all the llm-d roles are rolled out, recording the observed state of each role.
+func (r *NIMServiceReconciler) isLLMDReady(ctx context.Context, nimService *appsv1alpha1.NIMService) (string, bool, error) {
+	var msg string
+	var availableReplicas int32
+	roleStatuses := make([]appsv1alpha1.LLMDRoleStatus, 0, len(llmdRoles))
+	for _, role := range llmdRoles {
+		roleStatus := appsv1alpha1.LLMDRoleStatus{
+			Role:       role,
+			Deployment: nimService.GetLLMDDeploymentName(role),
+			Replicas:   int32(nimService.GetLLMDReplicas(role)),
+		}
+		deployment := &appsv1.Deployment{}
+		err := r.Get(ctx, types.NamespacedName{Name: roleStatus.Deployment, Namespace: nimService.GetNamespace()}, deployment)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return "", false, err
+		}
+
+		var roleMsg string
+		if err != nil {
+			roleMsg = fmt.Sprintf("%s deployment %q is not created yet", role, roleStatus.Deployment)
+		} else {
+			if deployment.Spec.Replicas != nil {
+				roleStatus.Replicas = *deployment.Spec.Replicas
+			}
+			roleStatus.UpdatedReplicas = deployment.Status.UpdatedReplicas
+			roleStatus.AvailableReplicas = deployment.Status.AvailableReplicas
+			if roleStatus.UpdatedReplicas < roleStatus.Replicas {
+				roleMsg = fmt.Sprintf("Waiting for %s deployment %q rollout to finish: %d out of %d new replicas have been updated", role, deployment.Name, roleStatus.UpdatedReplicas, roleStatus.Replicas)
+			} else if roleStatus.AvailableReplicas < roleStatus.UpdatedReplicas {
+				roleMsg = fmt.Sprintf("Waiting for %s deployment %q rollout to finish: %d of %d updated replicas are available", role, deployment.Name, roleStatus.AvailableReplicas, roleStatus.UpdatedReplicas)
+			}
+		}
+		if msg == "" {
+			msg = roleMsg
+		}
+		if role == appsv1alpha1.LLMDRoleDecode {
+			availableReplicas = roleStatus.AvailableReplicas
+		}
+		roleStatuses = append(roleStatuses, roleStatus)
+	}
+
+	nimService.Status.LLMD = &appsv1alpha1.LLMDStatus{Roles: roleStatuses}
+	nimService.Status.AvailableReplicas = availableReplicas
+	if msg != "" {
+		return msg, false, nil
+	}
+	return "prefill and decode deployments successfully rolled out", true, nil
+}
+
+// updateResourceClaimStatus sets the status of the DRA resources of each llm-d role.
+func (r *NIMServiceReconciler) updateResourceClaimStatus(ctx context.Context, nimService *appsv1alpha1.NIMService, namedDraResourcesByRole map[appsv1alpha1.LLMDRole][]shared.NamedDRAResource) error {
+	for i := range nimService.Status.LLMD.Roles {
+		roleStatus := &nimService.Status.LLMD.Roles[i]
+		namedDraResources := namedDraResourcesByRole[roleStatus.Role]
+		if len(namedDraResources) == 0 {
+			continue
+		}
+		draResourceStatuses, err := shared.GenerateDRAResourceStatuses(ctx, r.Client, nimService.GetNamespace(), namedDraResources)
+		if err != nil {
+			r.log.Error(err, "Failed to generate DRA resource statuses", "nimservice", nimService.Name, "role", roleStatus.Role)
+			return err
+		}
+		roleStatus.DRAResourceStatuses = draResourceStatuses
+	}
+	return nil
+}
+
+func (r *NIMServiceReconciler) reconcileDRAResources(ctx context.Context, nimService *appsv1alpha1.NIMService, namedDraResources []shared.NamedDRAResource) error {
+	for _, namedDraResource := range namedDraResources {
+		if !shared.ShouldCreateDRAResource(namedDraResource.DRAResource) {
+			continue
+		}
+
+		labels := nimService.GetServiceLabels()
+		annotations := nimService.GetNIMServiceAnnotations()
+		claimAnnotations := nimService.GetNIMServiceAnnotations()
+		delete(claimAnnotations, utils.NvidiaAnnotationParentSpecHashKey)
+		// Sync ResourceClaimTemplate
+		err := r.renderAndSyncResource(ctx, nimService, &resourcev1beta2.ResourceClaimTemplate{}, func() (client.Object, error) {
+			resourceClaimTemplateParams := &rendertypes.ResourceClaimTemplateParams{
+				Name:             namedDraResource.ResourceName,
+				Namespace:        nimService.GetNamespace(),
+				Labels:           labels,
+				Annotations:      annotations,
+				ClaimAnnotations: claimAnnotations,
+			}
+			for _, device := range namedDraResource.ClaimCreationSpec.Devices {
+				deviceParams, err := shared.GetDRADeviceParams(device)
+				if err != nil {
+					return nil, err
+				}
+				resourceClaimTemplateParams.Devices = append(resourceClaimTemplateParams.Devices, deviceParams)
+			}
+			return r.renderer.ResourceClaimTemplate(resourceClaimTemplateParams)
+		}, "resourceclaimtemplate", conditions.ReasonResourceClaimTemplateFailed)
+
+		if err != nil {
+			return fmt.Errorf("failed to reconcile DRAResource %s: %w", namedDraResource.ResourceName, err)
+		}
+	}
+	return nil
+}
+
+// updateModelStatus sets the model served by the decode pods through the NIMService service.
+func (r *NIMServiceReconciler) updateModelStatus(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	svc := &corev1.Service{}
+	if err := r.Get(ctx, types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}, svc); err != nil {
+		return err
+	}
+	clusterEndpoint := utils.FormatEndpoint(svc.Spec.ClusterIP, nimService.GetServicePort())
+
+	modelsList, err := nimmodels.ListModelsV1(ctx, clusterEndpoint, "http")
+	if err != nil {
+		return err
+	}
+	modelName := ""
+	if len(modelsList.Data) > 0 {
+		modelName = modelsList.Data[0].Id
+		for _, model := range modelsList.Data {
+			if model.Root != nil && *model.Root == model.Id {
+				modelName = model.Id
+				break
+			}
+		}
+	}
+
+	var externalEndpoint string
+	if svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		externalEndpoint = utils.FormatEndpoint(svc.Spec.LoadBalancerIP, nimService.GetServicePort())
+	}
+	nimService.Status.Model = &appsv1alpha1.ModelStatus{
+		Name:             modelName,
+		ClusterEndpoint:  clusterEndpoint,
+		ExternalEndpoint: externalEndpoint,
+	}
+	return nil
+}
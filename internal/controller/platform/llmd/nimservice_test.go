@@ -0,0 +1,287 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package llmd
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	resourcev1beta2 "k8s.io/api/resource/v1beta2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/render"
+)
+
+func getContainer(containers []corev1.Container, name string) *corev1.Container {
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
+func getEnvVar(envVars []corev1.EnvVar, name string) *corev1.EnvVar {
+	for i := range envVars {
+		if envVars[i].Name == name {
+			return &envVars[i]
+		}
+	}
+	return nil
+}
+
+var _ = Describe("NIMServiceReconciler for an llm-d platform", func() {
+	var (
+		k8sClient  client.Client
+		reconciler *NIMServiceReconciler
+		nimService *appsv1alpha1.NIMService
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(appsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+		Expect(rbacv1.AddToScheme(scheme)).To(Succeed())
+		Expect(networkingv1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(gatewayv1.AddToScheme(scheme)).To(Succeed())
+		Expect(resourcev1beta2.AddToScheme(scheme)).To(Succeed())
+
+		k8sClient = fake.NewClientBuilder().WithScheme(scheme).
+			WithStatusSubresource(&appsv1alpha1.NIMService{}).
+			WithStatusSubresource(&appsv1alpha1.NIMCache{}).
+			Build()
+
+		cwd, err := os.Getwd()
+		Expect(err).NotTo(HaveOccurred())
+
+		reconciler = &NIMServiceReconciler{
+			Client:          k8sClient,
+			scheme:          scheme,
+			updater:         conditions.NewUpdater(k8sClient),
+			renderer:        render.NewRenderer(path.Join(strings.TrimSuffix(cwd, "internal/controller/platform/llmd"), "manifests")),
+			recorder:        record.NewFakeRecorder(1000),
+			discoveryClient: &discoveryfake.FakeDiscovery{Fake: &testing.Fake{}},
+		}
+
+		nimService = &appsv1alpha1.NIMService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-nimservice",
+				Namespace: "default",
+			},
+			Spec: appsv1alpha1.NIMServiceSpec{
+				Image:      appsv1alpha1.Image{Repository: "nvcr.io/nim/meta/llama-3.1-8b-instruct", Tag: "1.13.1", PullSecrets: []string{"ngc-secret"}},
+				AuthSecret: "ngc-api-secret",
+				Storage: appsv1alpha1.NIMServiceStorage{
+					PVC: appsv1alpha1.PersistentVolumeClaim{Name: "test-pvc"},
+				},
+				Resources: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{
+						"nvidia.com/gpu": resource.MustParse("1"),
+					},
+				},
+				Expose: appsv1alpha1.Expose{
+					Service: appsv1alpha1.Service{Type: corev1.ServiceTypeClusterIP, Port: ptr.To[int32](8000)},
+				},
+				ReadinessProbe: appsv1alpha1.Probe{
+					Enabled: ptr.To(true),
+				},
+				Replicas:          1,
+				InferencePlatform: appsv1alpha1.PlatformTypeLLMD,
+				LLMD: &appsv1alpha1.LLMDSpec{
+					Prefill: appsv1alpha1.LLMDRoleSpec{
+						Replicas: ptr.To[int32](2),
+						Resources: &corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								"nvidia.com/gpu": resource.MustParse("2"),
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nimService)).To(Succeed())
+	})
+
+	It("should render the prefill and decode deployments", func() {
+		_, err := reconciler.reconcileNIMService(ctx, nimService)
+		Expect(err).NotTo(HaveOccurred())
+
+		prefill := &appsv1.Deployment{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-nimservice-prefill", Namespace: "default"}, prefill)).To(Succeed())
+		Expect(*prefill.Spec.Replicas).To(Equal(int32(2)))
+		Expect(prefill.Spec.Template.Labels).To(HaveKeyWithValue(appsv1alpha1.LLMDRoleLabelKey, "prefill"))
+		Expect(prefill.Spec.Template.Labels).To(HaveKeyWithValue(appsv1alpha1.LLMDInferenceServingLabelKey, "true"))
+		Expect(prefill.Spec.Template.Spec.Containers).To(HaveLen(1))
+		prefillContainer := prefill.Spec.Template.Spec.Containers[0]
+		Expect(prefillContainer.Resources.Limits).To(HaveKeyWithValue(corev1.ResourceName("nvidia.com/gpu"), resource.MustParse("2")))
+		Expect(prefillContainer.Ports[0].Name).To(Equal(appsv1alpha1.DefaultNamedPortAPI))
+		Expect(getEnvVar(prefillContainer.Env, "NIM_SERVER_PORT").Value).To(Equal("8000"))
+		Expect(getEnvVar(prefillContainer.Env, "NIM_PASSTHROUGH_ARGS").Value).To(ContainSubstring(`"kv_connector":"NixlConnector"`))
+
+		decode := &appsv1.Deployment{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-nimservice-decode", Namespace: "default"}, decode)).To(Succeed())
+		Expect(*decode.Spec.Replicas).To(Equal(int32(1)))
+		Expect(decode.Spec.Template.Labels).To(HaveKeyWithValue(appsv1alpha1.LLMDRoleLabelKey, "decode"))
+		Expect(decode.Spec.Template.Spec.Containers).To(HaveLen(2))
+		decodeContainer := decode.Spec.Template.Spec.Containers[0]
+		Expect(decodeContainer.Resources.Limits).To(HaveKeyWithValue(corev1.ResourceName("nvidia.com/gpu"), resource.MustParse("1")))
+		Expect(decodeContainer.Ports[0].Name).To(Equal(appsv1alpha1.DefaultLLMDNamedPortDecode))
+		Expect(decodeContainer.Ports[0].ContainerPort).To(Equal(int32(appsv1alpha1.DefaultLLMDDecodeTargetPort)))
+		Expect(getEnvVar(decodeContainer.Env, "NIM_SERVER_PORT").Value).To(Equal("8200"))
+		Expect(decodeContainer.ReadinessProbe.HTTPGet.Port).To(Equal(intstr.FromString(appsv1alpha1.DefaultLLMDNamedPortDecode)))
+
+		sidecar := getContainer(decode.Spec.Template.Spec.Containers, "routing-proxy")
+		Expect(sidecar).NotTo(BeNil())
+		Expect(sidecar.Image).To(Equal(appsv1alpha1.DefaultLLMDRoutingSidecarImage))
+		Expect(sidecar.Args).To(ContainElements("--port=8000", "--vllm-port=8200", "--connector=nixlv2"))
+		Expect(sidecar.Ports[0].Name).To(Equal(appsv1alpha1.DefaultNamedPortAPI))
+
+		service := &corev1.Service{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-nimservice", Namespace: "default"}, service)).To(Succeed())
+		Expect(service.Spec.Selector).To(Equal(map[string]string{"app": "test-nimservice-decode"}))
+	})
+
+	It("should be not ready until both roles are rolled out", func() {
+		_, err := reconciler.reconcileNIMService(ctx, nimService)
+		Expect(err).NotTo(HaveOccurred())
+
+		obj := &appsv1alpha1.NIMService{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(nimService), obj)).To(Succeed())
+		Expect(obj.Status.State).To(Equal(appsv1alpha1.NIMServiceStatusNotReady))
+		cond := meta.FindStatusCondition(obj.Status.Conditions, conditions.Ready)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Message).To(ContainSubstring("prefill deployment"))
+
+		prefill := &appsv1.Deployment{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-nimservice-prefill", Namespace: "default"}, prefill)).To(Succeed())
+		prefill.Status.UpdatedReplicas = 2
+		prefill.Status.AvailableReplicas = 2
+		Expect(k8sClient.Status().Update(ctx, prefill)).To(Succeed())
+
+		msg, ready, err := reconciler.isLLMDReady(ctx, nimService)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ready).To(BeFalse())
+		Expect(msg).To(ContainSubstring("decode deployment"))
+	})
+
+	It("should use the routing sidecar options", func() {
+		nimService.Spec.LLMD.KVConnector = appsv1alpha1.LLMDKVConnectorLMCache
+		nimService.Spec.LLMD.RoutingSidecar = appsv1alpha1.LLMDRoutingSidecarSpec{
+			Image:      &appsv1alpha1.Image{Repository: "registry.local/routing-sidecar", Tag: "v1", PullSecrets: []string{"local-secret"}},
+			TargetPort: ptr.To[int32](9000),
+		}
+		_, err := reconciler.reconcileNIMService(ctx, nimService)
+		Expect(err).NotTo(HaveOccurred())
+
+		decode := &appsv1.Deployment{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-nimservice-decode", Namespace: "default"}, decode)).To(Succeed())
+		sidecar := getContainer(decode.Spec.Template.Spec.Containers, "routing-proxy")
+		Expect(sidecar).NotTo(BeNil())
+		Expect(sidecar.Image).To(Equal("registry.local/routing-sidecar:v1"))
+		Expect(sidecar.Args).To(ContainElements("--vllm-port=9000", "--connector=lmcache"))
+		Expect(decode.Spec.Template.Spec.ImagePullSecrets).To(ContainElement(corev1.LocalObjectReference{Name: "local-secret"}))
+		Expect(getEnvVar(decode.Spec.Template.Spec.Containers[0].Env, "NIM_PASSTHROUGH_ARGS").Value).To(ContainSubstring(`"kv_connector":"LMCacheConnectorV1"`))
+	})
+
+	It("should render the role DRA claims and scheduling options", func() {
+		nimService.Spec.NodeSelector = map[string]string{"nvidia.com/gpu.product": "NVIDIA-H100-80GB-HBM3"}
+		nimService.Spec.LLMD.Prefill.Resources = nil
+		nimService.Spec.LLMD.Prefill.DRAResources = []appsv1alpha1.DRAResource{
+			{
+				ClaimCreationSpec: &appsv1alpha1.DRAClaimCreationSpec{
+					Devices: []appsv1alpha1.DRADeviceSpec{{Name: "gpu", DeviceClassName: "gpu.nvidia.com", Count: 2}},
+				},
+			},
+		}
+		nimService.Spec.LLMD.Decode = appsv1alpha1.LLMDRoleSpec{
+			NodeSelector: map[string]string{"nvidia.com/gpu.product": "NVIDIA-L40S"},
+			Tolerations:  []corev1.Toleration{{Key: "decode", Operator: corev1.TolerationOpExists}},
+		}
+		nimService.Spec.LLMD.KVConnectorExtraConfig = map[string]string{"backends": "UCX"}
+		nimService.Spec.LLMD.NIXLSideChannelPort = ptr.To[int32](5600)
+		_, err := reconciler.reconcileNIMService(ctx, nimService)
+		Expect(err).NotTo(HaveOccurred())
+
+		prefill := &appsv1.Deployment{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-nimservice-prefill", Namespace: "default"}, prefill)).To(Succeed())
+		Expect(prefill.Spec.Template.Spec.NodeSelector).To(Equal(nimService.Spec.NodeSelector))
+		Expect(prefill.Spec.Template.Spec.ResourceClaims).To(HaveLen(1))
+		claimTemplateName := *prefill.Spec.Template.Spec.ResourceClaims[0].ResourceClaimTemplateName
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: claimTemplateName, Namespace: "default"}, &resourcev1beta2.ResourceClaimTemplate{})).To(Succeed())
+		prefillContainer := prefill.Spec.Template.Spec.Containers[0]
+		Expect(prefillContainer.Resources.Claims).To(ConsistOf(corev1.ResourceClaim{Name: prefill.Spec.Template.Spec.ResourceClaims[0].Name}))
+		Expect(getEnvVar(prefillContainer.Env, "NIM_PASSTHROUGH_ARGS").Value).To(Equal(
+			`--kv-transfer-config {"kv_connector":"NixlConnector","kv_role":"kv_both","kv_connector_extra_config":{"backends":"UCX"}}`))
+		Expect(getEnvVar(prefillContainer.Env, "VLLM_NIXL_SIDE_CHANNEL_PORT").Value).To(Equal("5600"))
+
+		decode := &appsv1.Deployment{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-nimservice-decode", Namespace: "default"}, decode)).To(Succeed())
+		Expect(decode.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"nvidia.com/gpu.product": "NVIDIA-L40S"}))
+		Expect(decode.Spec.Template.Spec.Tolerations).To(ContainElement(corev1.Toleration{Key: "decode", Operator: corev1.TolerationOpExists}))
+		Expect(decode.Spec.Template.Spec.ResourceClaims).To(BeEmpty())
+
+		obj := &appsv1alpha1.NIMService{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(nimService), obj)).To(Succeed())
+		Expect(obj.Status.LLMD).NotTo(BeNil())
+		Expect(obj.Status.LLMD.Roles).To(HaveLen(2))
+		Expect(obj.Status.LLMD.Roles[0].Role).To(Equal(appsv1alpha1.LLMDRolePrefill))
+		Expect(obj.Status.LLMD.Roles[0].Deployment).To(Equal("test-nimservice-prefill"))
+		Expect(obj.Status.LLMD.Roles[0].Replicas).To(Equal(int32(2)))
+		Expect(obj.Status.LLMD.Roles[0].DRAResourceStatuses).To(HaveLen(1))
+		Expect(obj.Status.LLMD.Roles[1].Role).To(Equal(appsv1alpha1.LLMDRoleDecode))
+		Expect(obj.Status.LLMD.Roles[1].DRAResourceStatuses).To(BeEmpty())
+	})
+
+	It("should fail when the NIMCache is not found", func() {
+		nimService.Spec.Storage = appsv1alpha1.NIMServiceStorage{
+			NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: "missing-nimcache"},
+		}
+		_, err := reconciler.reconcileNIMService(ctx, nimService)
+		Expect(err).NotTo(HaveOccurred())
+
+		obj := &appsv1alpha1.NIMService{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(nimService), obj)).To(Succeed())
+		Expect(obj.Status.State).To(Equal(appsv1alpha1.NIMServiceStatusFailed))
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-nimservice-decode", Namespace: "default"}, &appsv1.Deployment{})).NotTo(Succeed())
+	})
+})
@@ -19,12 +19,16 @@ package platform
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"sync"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 	"github.com/NVIDIA/k8s-nim-operator/internal/controller/platform/kserve"
+	"github.com/NVIDIA/k8s-nim-operator/internal/controller/platform/llmd"
 	"github.com/NVIDIA/k8s-nim-operator/internal/controller/platform/standalone"
 	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
 )
@@ -35,14 +39,52 @@ type InferencePlatform interface {
 	Sync(ctx context.Context, r shared.Reconciler, resource client.Object) (ctrl.Result, error)
 }
 
+// InferencePlatformFactory returns a new instance of an inference platform implementation.
+type InferencePlatformFactory func() InferencePlatform
+
+var (
+	inferencePlatformsMu sync.RWMutex
+	inferencePlatforms   = map[appsv1alpha1.PlatformType]InferencePlatformFactory{}
+)
+
+func init() {
+	RegisterInferencePlatform(appsv1alpha1.PlatformTypeStandalone, func() InferencePlatform { return &standalone.Standalone{} })
+	RegisterInferencePlatform(appsv1alpha1.PlatformTypeKServe, func() InferencePlatform { return &kserve.KServe{} })
+	RegisterInferencePlatform(appsv1alpha1.PlatformTypeLLMD, func() InferencePlatform { return &llmd.LLMD{} })
+}
+
+// RegisterInferencePlatform registers the implementation of an inference platform type.
+// It panics if the platform type is already registered.
+func RegisterInferencePlatform(inferencePlatformType appsv1alpha1.PlatformType, factory InferencePlatformFactory) {
+	inferencePlatformsMu.Lock()
+	defer inferencePlatformsMu.Unlock()
+
+	if _, ok := inferencePlatforms[inferencePlatformType]; ok {
+		panic(fmt.Sprintf("inference platform %s is already registered", inferencePlatformType))
+	}
+	inferencePlatforms[inferencePlatformType] = factory
+}
+
+// RegisteredInferencePlatforms returns the sorted list of the registered inference platform types.
+func RegisteredInferencePlatforms() []appsv1alpha1.PlatformType {
+	inferencePlatformsMu.RLock()
+	defer inferencePlatformsMu.RUnlock()
+
+	return slices.Sorted(maps.Keys(inferencePlatforms))
+}
+
 // GetInferencePlatform returns an inference platform implementation based on the platform type.
 func GetInferencePlatform(inferencePlatformType appsv1alpha1.PlatformType) (InferencePlatform, error) {
-	switch inferencePlatformType {
-	case appsv1alpha1.PlatformTypeStandalone, "": // Default to standalone for empty values
-		return &standalone.Standalone{}, nil
-	case appsv1alpha1.PlatformTypeKServe:
-		return &kserve.KServe{}, nil
-	default:
+	if inferencePlatformType == "" {
+		// Default to standalone for empty values
+		inferencePlatformType = appsv1alpha1.PlatformTypeStandalone
+	}
+
+	inferencePlatformsMu.RLock()
+	factory, ok := inferencePlatforms[inferencePlatformType]
+	inferencePlatformsMu.RUnlock()
+	if !ok {
 		return nil, fmt.Errorf("unsupported platform type: %s", inferencePlatformType)
 	}
+	return factory(), nil
 }
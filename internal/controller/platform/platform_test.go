@@ -0,0 +1,81 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"fmt"
+	"testing"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/controller/platform/kserve"
+	"github.com/NVIDIA/k8s-nim-operator/internal/controller/platform/llmd"
+	"github.com/NVIDIA/k8s-nim-operator/internal/controller/platform/standalone"
+)
+
+func TestGetInferencePlatform(t *testing.T) {
+	tests := []struct {
+		platformType appsv1alpha1.PlatformType
+		want         InferencePlatform
+		wantErr      bool
+	}{
+		{platformType: "", want: &standalone.Standalone{}},
+		{platformType: appsv1alpha1.PlatformTypeStandalone, want: &standalone.Standalone{}},
+		{platformType: appsv1alpha1.PlatformTypeKServe, want: &kserve.KServe{}},
+		{platformType: appsv1alpha1.PlatformTypeLLMD, want: &llmd.LLMD{}},
+		{platformType: "unknown", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := GetInferencePlatform(tt.platformType)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("GetInferencePlatform(%q) error = %v, wantErr %v", tt.platformType, err, tt.wantErr)
+		}
+		if !tt.wantErr && fmt.Sprintf("%T", got) != fmt.Sprintf("%T", tt.want) {
+			t.Errorf("GetInferencePlatform(%q) = %T, want %T", tt.platformType, got, tt.want)
+		}
+	}
+}
+
+func TestRegisterInferencePlatform(t *testing.T) {
+	const custom appsv1alpha1.PlatformType = "custom"
+	RegisterInferencePlatform(custom, func() InferencePlatform { return &standalone.Standalone{} })
+	t.Cleanup(func() {
+		inferencePlatformsMu.Lock()
+		delete(inferencePlatforms, custom)
+		inferencePlatformsMu.Unlock()
+	})
+
+	if _, err := GetInferencePlatform(custom); err != nil {
+		t.Fatalf("GetInferencePlatform(%q) error = %v", custom, err)
+	}
+	want := []appsv1alpha1.PlatformType{custom, appsv1alpha1.PlatformTypeKServe, appsv1alpha1.PlatformTypeLLMD, appsv1alpha1.PlatformTypeStandalone}
+	got := RegisteredInferencePlatforms()
+	if len(got) != len(want) {
+		t.Fatalf("RegisteredInferencePlatforms() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("RegisteredInferencePlatforms() = %v, want %v", got, want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RegisterInferencePlatform(%q) did not panic on duplicate registration", custom)
+		}
+	}()
+	RegisterInferencePlatform(custom, func() InferencePlatform { return &standalone.Standalone{} })
+}
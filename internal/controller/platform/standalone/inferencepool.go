@@ -0,0 +1,192 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"context"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+)
+
+const (
+	inferencePoolKind  = "InferencePool"
+	inferenceModelKind = "InferenceModel"
+)
+
+// inferenceExtensionGroupVersion is the group version of the Gateway API Inference Extension resources.
+var inferenceExtensionGroupVersion = schema.GroupVersion{
+	Group:   appsv1alpha1.InferenceExtensionGroup,
+	Version: appsv1alpha1.InferenceExtensionVersion,
+}
+
+// reconcileInferencePool syncs the InferencePool of a NIMService routing through the Gateway API Inference Extension.
+// An InferencePool is shared by all NIMServices using the same pool name in a namespace, each of them holding an
+// owner reference on it, so that the pool is garbage collected once none of them use it anymore.
+func (r *NIMServiceReconciler) reconcileInferencePool(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	if !nimService.IsInferencePoolEnabled() {
+		// Release the inference resources of a previously enabled pool, if the extension is installed at all.
+		crdExists, err := k8sutil.CRDExists(r.GetDiscoveryClient(), inferenceExtensionGroupVersion.WithResource("inferencepools"))
+		if err != nil || !crdExists {
+			return err
+		}
+		if err := r.pruneInferenceResources(ctx, nimService, inferenceModelKind, nil); err != nil {
+			return err
+		}
+		return r.pruneInferenceResources(ctx, nimService, inferencePoolKind, nil)
+	}
+
+	for _, resource := range []string{"inferencepools", "inferencemodels"} {
+		crdExists, err := k8sutil.CRDExists(r.GetDiscoveryClient(), inferenceExtensionGroupVersion.WithResource(resource))
+		if err != nil {
+			return fmt.Errorf("failed to check if %s CRD exists: %w", resource, err)
+		}
+		if !crdExists {
+			return fmt.Errorf("inference pools are not supported on this cluster, please ensure %s API group is enabled", inferenceExtensionGroupVersion)
+		}
+	}
+
+	pool, err := r.renderer.InferencePool(nimService.GetInferencePoolParams())
+	if err != nil {
+		return err
+	}
+	if err := r.syncInferenceResource(ctx, nimService, pool); err != nil {
+		return fmt.Errorf("failed to sync inference pool %s: %w", pool.GetName(), err)
+	}
+	return r.pruneInferenceResources(ctx, nimService, inferencePoolKind, map[string]bool{pool.GetName(): true})
+}
+
+// reconcileInferenceModels syncs an InferenceModel for each model served by a NIMService, keyed by the model name
+// discovered from the NIM, so that the endpoint picker routes the model across all NIMServices of the pool serving it.
+func (r *NIMServiceReconciler) reconcileInferenceModels(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	if !nimService.IsInferencePoolEnabled() || nimService.Status.Model == nil {
+		return nil
+	}
+
+	modelNames := append([]string{nimService.Status.Model.Name}, nimService.Status.Model.LoRAAdapters...)
+	desired := map[string]bool{}
+	for _, modelName := range modelNames {
+		if modelName == "" {
+			continue
+		}
+		model, err := r.renderer.InferenceModel(nimService.GetInferenceModelParams(modelName))
+		if err != nil {
+			return err
+		}
+		if err := r.syncInferenceResource(ctx, nimService, model); err != nil {
+			return fmt.Errorf("failed to sync inference model %s: %w", model.GetName(), err)
+		}
+		desired[model.GetName()] = true
+	}
+	return r.pruneInferenceResources(ctx, nimService, inferenceModelKind, desired)
+}
+
+// syncInferenceResource creates or updates a shared inference resource and adds the NIMService to its owners.
+func (r *NIMServiceReconciler) syncInferenceResource(ctx context.Context, nimService *appsv1alpha1.NIMService, desired *unstructured.Unstructured) error {
+	logger := log.FromContext(ctx)
+
+	desiredHash := utils.DeepHashObject(desired.Object["spec"])
+	annotations := desired.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[utils.NvidiaAnnotationParentSpecHashKey] = desiredHash
+	desired.SetAnnotations(annotations)
+
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(desired.GroupVersionKind())
+	err := r.Get(ctx, client.ObjectKeyFromObject(desired), current)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	if k8serrors.IsNotFound(err) {
+		if err := controllerutil.SetOwnerReference(nimService, desired, r.GetScheme()); err != nil {
+			return err
+		}
+		logger.V(2).Info("Creating inference resource", "kind", desired.GetKind(), "name", desired.GetName())
+		return r.Create(ctx, desired)
+	}
+
+	if isOwnedBy(current, nimService) && !utils.IsParentSpecChanged(current, desiredHash) {
+		return nil
+	}
+	desired.SetOwnerReferences(current.GetOwnerReferences())
+	if err := controllerutil.SetOwnerReference(nimService, desired, r.GetScheme()); err != nil {
+		return err
+	}
+	desired.SetResourceVersion(current.GetResourceVersion())
+	logger.V(2).Info("Updating inference resource", "kind", desired.GetKind(), "name", desired.GetName())
+	return r.Update(ctx, desired)
+}
+
+// pruneInferenceResources releases the inference resources of the given kind owned by the NIMService which are not desired anymore.
+// A resource is deleted once the last NIMService using it releases it.
+func (r *NIMServiceReconciler) pruneInferenceResources(ctx context.Context, nimService *appsv1alpha1.NIMService, kind string, desired map[string]bool) error {
+	logger := log.FromContext(ctx)
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(inferenceExtensionGroupVersion.WithKind(kind + "List"))
+	if err := r.List(ctx, list, client.InNamespace(nimService.GetNamespace()), client.HasLabels{appsv1alpha1.InferencePoolLabelKey}); err != nil {
+		return err
+	}
+
+	for i := range list.Items {
+		obj := &list.Items[i]
+		if desired[obj.GetName()] || !isOwnedBy(obj, nimService) {
+			continue
+		}
+
+		ownerRefs := []metav1.OwnerReference{}
+		for _, ref := range obj.GetOwnerReferences() {
+			if ref.UID != nimService.GetUID() {
+				ownerRefs = append(ownerRefs, ref)
+			}
+		}
+		if len(ownerRefs) == 0 {
+			logger.V(2).Info("Deleting inference resource", "kind", kind, "name", obj.GetName())
+			if err := r.Delete(ctx, obj); err != nil && !k8serrors.IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		obj.SetOwnerReferences(ownerRefs)
+		logger.V(2).Info("Releasing inference resource", "kind", kind, "name", obj.GetName())
+		if err := r.Update(ctx, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isOwnedBy(obj client.Object, owner client.Object) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == owner.GetUID() {
+			return true
+		}
+	}
+	return false
+}
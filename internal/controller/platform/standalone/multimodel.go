@@ -0,0 +1,244 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/nimmodels"
+	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+)
+
+// multiModelStore is the model store of an additional model of a multi-model NIMService.
+type multiModelStore struct {
+	model     appsv1alpha1.NIMServiceModelSpec
+	nimCache  *appsv1alpha1.NIMCache
+	pvcName   string
+	resources *corev1.ResourceRequirements
+}
+
+// getMultiModelStores returns the model stores of the additional models of a multi-model NIMService.
+// False is returned when a NIMCache cannot be used (yet), the NIMService status being updated accordingly.
+func (r *NIMServiceReconciler) getMultiModelStores(ctx context.Context, nimService *appsv1alpha1.NIMService) ([]multiModelStore, bool, error) {
+	if image, _ := nimService.GetModelRouterImage(); image == "" {
+		msg := fmt.Sprintf("no model router image is configured, set spec.multiModel.router.image or the %s env of the operator", appsv1alpha1.ModelRouterImageEnv)
+		return nil, false, r.setMultiModelNotDeployable(ctx, nimService, conditions.ReasonModelRouterUnavailable, msg, true)
+	}
+
+	stores := make([]multiModelStore, 0, len(nimService.GetMultiModels()))
+	for _, model := range nimService.GetMultiModels() {
+		nimCache := &appsv1alpha1.NIMCache{}
+		if err := r.Get(ctx, types.NamespacedName{Name: model.NIMCache.Name, Namespace: nimService.GetNamespace()}, nimCache); err != nil {
+			if k8serrors.IsNotFound(err) {
+				msg := fmt.Sprintf("NIMCache %s of model %s not found", model.NIMCache.Name, model.Name)
+				return nil, false, r.setMultiModelNotDeployable(ctx, nimService, conditions.ReasonNIMCacheNotFound, msg, true)
+			}
+			return nil, false, err
+		}
+
+		switch nimCache.Status.State {
+		case appsv1alpha1.NimCacheStatusReady:
+		case appsv1alpha1.NimCacheStatusFailed:
+			msg := fmt.Sprintf("NIMCache %s of model %s failed: %s", nimCache.Name, model.Name, r.getNIMCacheFailedMessage(nimCache))
+			return nil, false, r.setMultiModelNotDeployable(ctx, nimService, conditions.ReasonNIMCacheFailed, msg, true)
+		default:
+			msg := fmt.Sprintf("NIMCache %s of model %s not ready", nimCache.Name, model.Name)
+			return nil, false, r.setMultiModelNotDeployable(ctx, nimService, conditions.ReasonNIMCacheNotReady, msg, false)
+		}
+		if nimCache.IsObjectStoreEnabled() {
+			msg := fmt.Sprintf("NIMCache %s of model %s is cached in an object store, which is not supported for additional models", nimCache.Name, model.Name)
+			return nil, false, r.setMultiModelNotDeployable(ctx, nimService, conditions.ReasonNIMCacheFailed, msg, true)
+		}
+
+		pvc, err := r.getNIMCachePVC(nimCache)
+		if err != nil {
+			return nil, false, err
+		}
+
+		resources := model.Resources
+		if resources == nil {
+			resources = nimService.GetResources()
+		}
+		if profile := getCachedProfile(nimCache, model.NIMCache.Profile); profile != nil && nimCache.IsOptimizedNIM() {
+			resources, err = shared.GetProfileGPUResources(resources, profile)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+
+		stores = append(stores, multiModelStore{
+			model:     model,
+			nimCache:  nimCache,
+			pvcName:   pvc.Name,
+			resources: resources,
+		})
+	}
+	return stores, true, nil
+}
+
+// setMultiModelNotDeployable updates the NIMService status when an additional model cannot be served (yet).
+func (r *NIMServiceReconciler) setMultiModelNotDeployable(ctx context.Context, nimService *appsv1alpha1.NIMService, reason, msg string, failed bool) error {
+	logger := log.FromContext(ctx)
+
+	var err error
+	if failed {
+		err = r.updater.SetConditionsFailed(ctx, nimService, reason, msg)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeWarning, conditions.Failed, msg)
+	} else {
+		err = r.updater.SetConditionsNotReady(ctx, nimService, reason, msg)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, conditions.NotReady,
+			"NIMService %s not ready yet, msg: %s", nimService.Name, msg)
+	}
+	logger.Info(msg, "nimservice", nimService.Name)
+	if err != nil {
+		logger.Error(err, "failed to update status", "nimservice", nimService.Name)
+	}
+	return err
+}
+
+// getCachedProfile returns the given profile cached by the NIMCache, or nil if it is not cached.
+func getCachedProfile(nimCache *appsv1alpha1.NIMCache, profile string) *appsv1alpha1.NIMProfile {
+	if profile == "" {
+		return nil
+	}
+	for i := range nimCache.Status.Profiles {
+		if nimCache.Status.Profiles[i].Name == profile {
+			return &nimCache.Status.Profiles[i]
+		}
+	}
+	return nil
+}
+
+// setMultiModelContainers adds a NIM container per additional model to the rendered NIMService deployment,
+// each NIM container listening on its own port behind the model router listening on the API port.
+func setMultiModelContainers(deployment *appsv1.Deployment, nimService *appsv1alpha1.NIMService, stores []multiModelStore) {
+	podSpec := &deployment.Spec.Template.Spec
+	nimContainer := podSpec.Containers[0]
+
+	containers := []corev1.Container{*nimContainer.DeepCopy()}
+	setMultiModelContainerPort(&containers[0], 0)
+	for i, store := range stores {
+		container := nimContainer.DeepCopy()
+		container.Name = store.model.Name
+		if image := store.model.Image; image != nil {
+			container.Image = fmt.Sprintf("%s:%s", image.Repository, image.Tag)
+			container.ImagePullPolicy = corev1.PullPolicy(image.PullPolicy)
+		}
+		container.Resources = corev1.ResourceRequirements{}
+		if store.resources != nil {
+			container.Resources = *store.resources.DeepCopy()
+		}
+
+		// Drop the model selection of the model of the NIMService storage.
+		env := slices.DeleteFunc(slices.Clone(container.Env), func(envVar corev1.EnvVar) bool {
+			return envVar.Name == "NIM_MODEL_NAME" || envVar.Name == "NIM_MODEL_PROFILE"
+		})
+		if store.nimCache.IsUniversalNIM() {
+			env = utils.MergeEnvVars(env, []corev1.EnvVar{{Name: "NIM_MODEL_NAME", Value: utils.DefaultModelStorePath}})
+		}
+		if store.model.NIMCache.Profile != "" {
+			env = utils.MergeEnvVars(env, []corev1.EnvVar{{Name: "NIM_MODEL_PROFILE", Value: store.model.NIMCache.Profile}})
+		}
+		container.Env = utils.MergeEnvVars(env, store.model.Env)
+
+		volumeName := appsv1alpha1.GetMultiModelVolumeName(store.model)
+		for j := range container.VolumeMounts {
+			if container.VolumeMounts[j].Name == "model-store" {
+				container.VolumeMounts[j].Name = volumeName
+			}
+		}
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: store.pvcName,
+					ReadOnly:  nimService.GetStorageReadOnly(),
+				},
+			},
+		})
+
+		setMultiModelContainerPort(container, i+1)
+		containers = append(containers, *container)
+	}
+	containers = append(containers, nimService.GetModelRouterContainer())
+	podSpec.Containers = append(containers, podSpec.Containers[1:]...)
+
+	for _, secret := range nimService.GetModelRouterImagePullSecrets() {
+		podSpec.ImagePullSecrets = append(podSpec.ImagePullSecrets, corev1.LocalObjectReference{Name: secret})
+	}
+}
+
+// setMultiModelContainerPort moves the NIM container of the i-th model from the API port onto its model router backend port.
+func setMultiModelContainerPort(container *corev1.Container, i int) {
+	port := appsv1alpha1.GetMultiModelPort(i)
+	portName := appsv1alpha1.GetMultiModelPortName(i)
+	apiPort := intstr.FromString(appsv1alpha1.DefaultNamedPortAPI)
+	for j := range container.Ports {
+		if container.Ports[j].Name == appsv1alpha1.DefaultNamedPortAPI {
+			apiPort = intstr.FromInt32(container.Ports[j].ContainerPort)
+			container.Ports[j].Name = portName
+			container.Ports[j].ContainerPort = port
+		}
+	}
+	container.Env = utils.MergeEnvVars(container.Env, []corev1.EnvVar{
+		{Name: "NIM_SERVER_PORT", Value: fmt.Sprintf("%d", port)},
+		{Name: "NIM_HTTP_API_PORT", Value: fmt.Sprintf("%d", port)},
+	})
+	for _, probe := range []*corev1.Probe{container.LivenessProbe, container.ReadinessProbe, container.StartupProbe} {
+		// Probes target the API port either by name or by number.
+		if probe != nil && probe.HTTPGet != nil &&
+			(probe.HTTPGet.Port.String() == appsv1alpha1.DefaultNamedPortAPI || probe.HTTPGet.Port == apiPort) {
+			probe.HTTPGet.Port = intstr.FromString(portName)
+		}
+	}
+}
+
+// getNIMServedModels returns the models listed by the /v1/models endpoint of the NIMService,
+// or nil if the NIM does not list its models.
+func (r *NIMServiceReconciler) getNIMServedModels(ctx context.Context, nimServiceEndpoint string) ([]appsv1alpha1.ServedModelStatus, error) {
+	modelsList, err := nimmodels.ListModelsV1(ctx, nimServiceEndpoint, "http")
+	if err != nil {
+		if nimmodels.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var models []appsv1alpha1.ServedModelStatus
+	for _, model := range modelsList.Data {
+		if model.Object != nimmodels.ObjectTypeModel {
+			continue
+		}
+		servedModel := appsv1alpha1.ServedModelStatus{Name: model.Id}
+		if model.Root != nil {
+			servedModel.Root = *model.Root
+		}
+		models = append(models, servedModel)
+	}
+	return models, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+)
+
+// getNIMBuildProfile returns the profile to serve from the NIMBuild tracked by the NIMService: the output profile
+// of its latest built engine, or its input profile until the engine is built or once the built engine is rolled back.
+// An empty profile is returned when the NIMBuild has not selected a profile to build yet.
+//
+// It returns false if the NIMService cannot be deployed, its status being updated accordingly.
+func (r *NIMServiceReconciler) getNIMBuildProfile(ctx context.Context, nimService *appsv1alpha1.NIMService, namespace string) (string, bool, error) {
+	logger := log.FromContext(ctx)
+	nimBuildName := nimService.GetNIMBuildName()
+
+	nimBuild := &appsv1alpha1.NIMBuild{}
+	if err := r.Get(ctx, types.NamespacedName{Name: nimBuildName, Namespace: namespace}, nimBuild); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return "", false, err
+		}
+		msg := fmt.Sprintf("NIMBuild %s not found", nimBuildName)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeWarning, conditions.NotReady, msg)
+		logger.Info(msg, "nimbuild", nimBuildName, "nimservice", nimService.Name)
+		return "", false, r.updater.SetConditionsNotReady(ctx, nimService, conditions.ReasonNIMBuildNotFound, msg)
+	}
+
+	status := nimService.Status.Build
+	if status == nil || status.NIMBuild != nimBuildName {
+		status = &appsv1alpha1.NIMServiceBuildStatus{NIMBuild: nimBuildName}
+		nimService.Status.Build = status
+	}
+	status.InputProfile = getNIMBuildInputProfile(nimBuild)
+	status.OutputProfile = ""
+	if nimBuild.Status.State == appsv1alpha1.NimBuildStatusReady {
+		status.OutputProfile = nimBuild.Status.OutputProfile.Name
+	}
+
+	// Roll back to the input profile when the built engine fails to become ready
+	if status.OutputProfile != "" && status.Profile == status.OutputProfile && r.isNIMBuildProfileFailed(ctx, nimService, status.Profile) {
+		logger.Info("Rolling back built profile", "nimservice", nimService.Name, "nimbuild", nimBuildName, "profile", status.Profile)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeWarning, "RolledBack",
+			"NIMService %s rolled back from profile %s of NIMBuild %s, the built engine failed to become ready", nimService.Name, status.Profile, nimBuildName)
+		status.RolledBackProfile = status.OutputProfile
+	}
+
+	profile := status.InputProfile
+	if status.OutputProfile != "" && status.OutputProfile != status.RolledBackProfile {
+		profile = status.OutputProfile
+	}
+	if profile != status.Profile && profile != "" {
+		logger.Info("Rolling out profile of NIMBuild", "nimservice", nimService.Name, "nimbuild", nimBuildName, "profile", profile)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, "RollingOut",
+			"NIMService %s rolling out profile %s of NIMBuild %s", nimService.Name, profile, nimBuildName)
+	}
+	status.Profile = profile
+	return profile, true, nil
+}
+
+// isNIMBuildProfileFailed returns true if the deployment of the given profile failed to become ready in time,
+// either the candidate of a progressive rollout or the NIMService deployment being rolled.
+func (r *NIMServiceReconciler) isNIMBuildProfileFailed(ctx context.Context, nimService *appsv1alpha1.NIMService, profile string) bool {
+	if nimService.IsProgressiveRolloutEnabled() {
+		rollout := nimService.Status.Rollout
+		return rollout != nil && rollout.Phase == appsv1alpha1.RolloutPhaseFailed &&
+			rollout.CandidateRevision != nil && rollout.CandidateRevision.Profile == profile
+	}
+
+	namespacedName := types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}
+	deployment := &appsv1.Deployment{}
+	if err := r.Get(ctx, namespacedName, deployment); err != nil {
+		return false
+	}
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return false
+	}
+	cond := getDeploymentCondition(deployment.Status, appsv1.DeploymentProgressing)
+	return cond != nil && cond.Reason == "ProgressDeadlineExceeded"
+}
+
+// getNIMBuildSpecHash returns the parent spec hash of the NIMService resources, so that they are re-synced
+// whenever the profile rolled out from the NIMBuild changes.
+func getNIMBuildSpecHash(nimService *appsv1alpha1.NIMService) string {
+	return utils.DeepHashObject([]any{nimService.Spec, nimService.Status.Build.Profile})
+}
+
+// getNIMBuildInputProfile returns the profile the latest engine of the NIMBuild is built from.
+func getNIMBuildInputProfile(nimBuild *appsv1alpha1.NIMBuild) string {
+	for _, build := range nimBuild.Status.Builds {
+		if build.OutputProfile != "" && build.OutputProfile == nimBuild.Status.OutputProfile.Name {
+			return build.InputProfile
+		}
+	}
+	if nimBuild.Status.InputProfile.Name != "" {
+		return nimBuild.Status.InputProfile.Name
+	}
+	if len(nimBuild.Status.Builds) > 0 {
+		return nimBuild.Status.Builds[0].InputProfile
+	}
+	return ""
+}
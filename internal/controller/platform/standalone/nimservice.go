@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -44,6 +46,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	lws "sigs.k8s.io/lws/api/leaderworkerset/v1"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
@@ -97,8 +100,8 @@ func (r *NIMServiceReconciler) GetOrchestratorType() k8sutil.OrchestratorType {
 
 func (r *NIMServiceReconciler) cleanupNIMService(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
 	// All dependent (owned) objects will be automatically garbage collected.
-	// TODO: Handle any custom cleanup logic for the NIM microservice
-	return nil
+	// The cluster scoped PV sharing a NIMCache from another namespace is not owned by the NIMService.
+	return shared.DeleteSharedNIMCachePV(ctx, r.GetClient(), nimService)
 }
 
 // TODO: Move to validation webhook.
@@ -243,8 +246,53 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 		}
 	}
 
+	// Sync gateway routes
+	if nimService.IsRouterEnabled() {
+		err = r.renderAndSyncResource(ctx, nimService, &renderer, &gatewayv1.HTTPRoute{}, func() (client.Object, error) {
+			if err := shared.ValidateGatewayRouteResource(r.GetDiscoveryClient(), "httproutes"); err != nil {
+				return nil, err
+			}
+			return renderer.HTTPRoute(nimService.GetHTTPRouteParams())
+		}, "httproute", conditions.ReasonHTTPRouteFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		err = k8sutil.CleanupResource(ctx, r.GetClient(), &gatewayv1.HTTPRoute{}, namespacedName)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+	if nimService.IsGRPCRouteEnabled() {
+		err = r.renderAndSyncResource(ctx, nimService, &renderer, &gatewayv1.GRPCRoute{}, func() (client.Object, error) {
+			if err := shared.ValidateGatewayRouteResource(r.GetDiscoveryClient(), "grpcroutes"); err != nil {
+				return nil, err
+			}
+			return renderer.GRPCRoute(nimService.GetGRPCRouteParams())
+		}, "grpcroute", conditions.ReasonGRPCRouteFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		err = k8sutil.CleanupResource(ctx, r.GetClient(), &gatewayv1.GRPCRoute{}, namespacedName)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Sync inference pool
+	err = r.reconcileInferencePool(ctx, nimService)
+	if err != nil {
+		logger.Error(err, "failed to reconcile inference pool")
+		statusError := r.updater.SetConditionsFailed(ctx, nimService, conditions.ReasonInferencePoolFailed, err.Error())
+		if statusError != nil {
+			logger.Error(statusError, "failed to update status", "nimservice", nimService.Name)
+		}
+		return ctrl.Result{}, err
+	}
+
 	// Sync HPA
-	if nimService.IsAutoScalingEnabled() {
+	if nimService.IsHPAEnabled() {
 		err = r.renderAndSyncResource(ctx, nimService, &renderer, &autoscalingv2.HorizontalPodAutoscaler{}, func() (client.Object, error) {
 			return renderer.HPA(nimService.GetHPAParams())
 		}, "hpa", conditions.ReasonHPAFailed)
@@ -252,13 +300,32 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 			return ctrl.Result{}, err
 		}
 	} else {
-		// If autoscaling is disabled, ensure the HPA is deleted
+		// If HPA autoscaling is disabled, ensure the HPA is deleted
 		err = k8sutil.CleanupResource(ctx, r.GetClient(), &autoscalingv2.HorizontalPodAutoscaler{}, namespacedName)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 	}
 
+	// Sync KEDA ScaledObject
+	if nimService.IsKEDAAutoScalingEnabled() {
+		err = r.renderAndSyncResource(ctx, nimService, &renderer, shared.NewKEDAScaledObject(), func() (client.Object, error) {
+			if err := shared.ValidateKEDAScaledObjectResource(r.GetDiscoveryClient()); err != nil {
+				return nil, err
+			}
+			return renderer.ScaledObject(nimService.GetScaledObjectParams())
+		}, "scaledobject", conditions.ReasonScaledObjectFailed)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		// If KEDA autoscaling is disabled, ensure the ScaledObject is deleted
+		err = k8sutil.CleanupResource(ctx, r.GetClient(), shared.NewKEDAScaledObject(), namespacedName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Sync Service Monitor
 	if nimService.IsServiceMonitorEnabled() {
 		err = r.renderAndSyncResource(ctx, nimService, &renderer, &monitoringv1.ServiceMonitor{}, func() (client.Object, error) {
@@ -272,11 +339,39 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 	var modelPVC *appsv1alpha1.PersistentVolumeClaim
 	modelProfile := ""
 
+	// Delete the mirror of a NIMCache volume from another namespace no longer referenced
+	if !nimService.IsCrossNamespaceNIMCache() {
+		if err := shared.DeleteSharedNIMCachePV(ctx, r.GetClient(), nimService); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Select PVC for model store
 	nimCacheName := nimService.GetNIMCacheName()
+	nimCacheNamespace := nimService.GetNIMCacheNamespace()
 	nimCache := appsv1alpha1.NIMCache{}
 	if nimCacheName != "" { // nolint:gocritic
-		if err := r.Get(ctx, types.NamespacedName{Name: nimCacheName, Namespace: nimService.GetNamespace()}, &nimCache); err != nil {
+		// Fail the NIMService if the NIMCache in another namespace is not granted to it
+		granted, err := shared.IsNIMCacheGranted(ctx, r.GetClient(), nimService.GetNamespace(), types.NamespacedName{Name: nimCacheName, Namespace: nimCacheNamespace})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !granted {
+			if err := shared.DeleteSharedNIMCachePV(ctx, r.GetClient(), nimService); err != nil {
+				return ctrl.Result{}, err
+			}
+			msg := fmt.Sprintf("NIMCache %s/%s is not granted to namespace %s", nimCacheNamespace, nimCacheName, nimService.GetNamespace())
+			statusUpdateErr := r.updater.SetConditionsFailed(ctx, nimService, conditions.ReasonNIMCacheNotGranted, msg)
+			r.GetEventRecorder().Eventf(nimService, corev1.EventTypeWarning, conditions.Failed, msg)
+			logger.Info(msg, "nimcache", nimCacheName, "nimservice", nimService.Name)
+			if statusUpdateErr != nil {
+				logger.Error(statusUpdateErr, "failed to update status", "nimservice", nimService.Name)
+				return ctrl.Result{}, statusUpdateErr
+			}
+			return ctrl.Result{}, nil
+		}
+
+		if err := r.Get(ctx, types.NamespacedName{Name: nimCacheName, Namespace: nimCacheNamespace}, &nimCache); err != nil {
 			// Fail the NIMService if the NIMCache is not found
 			if k8serrors.IsNotFound(err) {
 				msg := fmt.Sprintf("NIMCache %s not found", nimCacheName)
@@ -316,19 +411,59 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 			return ctrl.Result{}, err
 		}
 
-		// Fetch PVC for the associated NIMCache instance and mount it
-		nimCachePVC, err := r.getNIMCachePVC(&nimCache)
-		if err != nil {
-			logger.Error(err, "unable to obtain pvc backing the nimcache instance")
-			return ctrl.Result{}, err
+		if nimCache.IsObjectStoreEnabled() && nimService.IsCrossNamespaceNIMCache() {
+			// Fail the NIMService as the object store credentials only exist in the NIMCache namespace
+			msg := fmt.Sprintf("NIMCache %s/%s is cached in an object store whose credentials are not shared with namespace %s", nimCacheNamespace, nimCacheName, nimService.GetNamespace())
+			statusUpdateErr := r.updater.SetConditionsFailed(ctx, nimService, conditions.ReasonNIMCacheObjectStoreNotShared, msg)
+			r.GetEventRecorder().Eventf(nimService, corev1.EventTypeWarning, conditions.Failed, msg)
+			logger.Info(msg, "nimcache", nimCacheName, "nimservice", nimService.Name)
+			if statusUpdateErr != nil {
+				logger.Error(statusUpdateErr, "failed to update status", "nimservice", nimService.Name)
+				return ctrl.Result{}, statusUpdateErr
+			}
+			return ctrl.Result{}, nil
+		}
+
+		if nimCache.IsObjectStoreEnabled() {
+			// Hydrate the model store from the object store backing the NIMCache instance
+			modelPVC, err = r.getObjectStoreModelPVC(ctx, nimService)
+			if err != nil {
+				logger.Error(err, "unable to obtain pvc to hydrate the nimcache instance")
+				return ctrl.Result{}, err
+			}
+		} else if nimService.IsCrossNamespaceNIMCache() {
+			// Mirror the PVC of the NIMCache instance in another namespace and mount it
+			modelPVC, err = shared.ReconcileSharedNIMCachePVC(ctx, r.GetClient(), r.GetScheme(), nimService, &nimCache)
+			if err != nil {
+				logger.Error(err, "unable to share pvc backing the nimcache instance", "nimcache", nimCacheName, "namespace", nimCacheNamespace)
+				return ctrl.Result{}, err
+			}
+		} else {
+			// Fetch PVC for the associated NIMCache instance and mount it
+			nimCachePVC, err := r.getNIMCachePVC(&nimCache)
+			if err != nil {
+				logger.Error(err, "unable to obtain pvc backing the nimcache instance")
+				return ctrl.Result{}, err
+			}
+			logger.V(2).Info("obtained the backing pvc for nimcache instance", "pvc", nimCachePVC)
+			modelPVC = nimCachePVC
 		}
-		logger.V(2).Info("obtained the backing pvc for nimcache instance", "pvc", nimCachePVC)
-		modelPVC = nimCachePVC
 
 		if profile := nimService.GetNIMCacheProfile(); profile != "" {
 			logger.Info("overriding model profile", "profile", profile)
 			modelProfile = profile
 		}
+
+		// Serve the latest engine built by the tracked NIMBuild
+		if nimService.GetNIMBuildName() != "" {
+			profile, deployable, err := r.getNIMBuildProfile(ctx, nimService, nimCacheNamespace)
+			if err != nil || !deployable {
+				return ctrl.Result{}, err
+			}
+			if profile != "" {
+				modelProfile = profile
+			}
+		}
 	} else if nimService.Spec.Storage.PVC.Create != nil && *nimService.Spec.Storage.PVC.Create {
 		// Create a new PVC
 		modelPVC, err = r.reconcilePVC(ctx, nimService)
@@ -345,44 +480,48 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 		return ctrl.Result{}, err
 	}
 
-	var profileEnv *[]corev1.EnvVar
-	var profile *appsv1alpha1.NIMProfile
-	var gpuResources *corev1.ResourceRequirements
+	var multiModelStores []multiModelStore
+	if nimService.IsMultiModelEnabled() {
+		var deployable bool
+		multiModelStores, deployable, err = r.getMultiModelStores(ctx, nimService)
+		if err != nil || !deployable {
+			return ctrl.Result{}, err
+		}
+	}
+
 	var initContainers []corev1.Container
 	var renderFunc func() (client.Object, error)
 	var conType, failedCon string
 	var renderObj client.Object
 
-	if modelProfile != "" {
-		profileEnv = &[]corev1.EnvVar{{
-			Name:  "NIM_MODEL_PROFILE",
-			Value: modelProfile,
-		}}
-
-		// Only assign GPU resources if the NIMCache is for optimized NIM
-		if nimCache.IsOptimizedNIM() {
-			// Retrieve and set profile details from NIMCache
-			profile, err = r.getNIMCacheProfile(ctx, nimService, modelProfile)
-			if err != nil {
-				logger.Error(err, "Failed to get cached NIM profile")
-				return ctrl.Result{}, err
-			}
+	profileEnv, gpuResources, err := r.getProfileEnvAndResources(ctx, nimService, &nimCache, modelProfile)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 
-			// Auto assign GPU resources in case of the optimized profile
-			if profile != nil {
-				gpuResources, err = r.addGPUResources(ctx, nimService, profile)
-				if err != nil {
-					logger.Error(err, "Failed to get GPU resources")
-					return ctrl.Result{}, err
-				}
-			}
+	loraAdapters, err := shared.ResolveLoRAAdapters(ctx, r.GetClient(), nimService)
+	if err != nil {
+		msg := err.Error()
+		err = r.updater.SetConditionsNotReady(ctx, nimService, conditions.ReasonLoRAAdaptersNotReady, msg)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, conditions.NotReady,
+			"NIMService %s not ready yet, msg: %s", nimService.Name, msg)
+		logger.Info(msg, "nimservice", nimService.Name)
+		if err != nil {
+			logger.Error(err, "failed to update status", "nimservice", nimService.Name)
 		}
-
-		// TODO: assign GPU resources and node selector that is required for the selected profile
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 	}
 
-	initContainers = nimService.GetInitContainers()
-	namedDraResources := shared.GenerateNamedDRAResources(nimService)
+	initContainers = append(nimService.GetInitContainers(), shared.GetObjectStoreInitContainers(nimService, &nimCache, *modelPVC)...)
+	initContainers = append(initContainers, shared.GetLoRAInitContainers(nimService, loraAdapters)...)
+	namedDraResources, err := getNamedDRAResources(nimService, &nimCache, modelProfile)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get DRA resources: %v", err)
+		updateErr := r.updater.SetConditionsFailed(ctx, nimService, conditions.ReasonResourceClaimTemplateFailed, msg)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeWarning, conditions.Failed, msg)
+		logger.Error(err, "failed to get DRA resources", "nimservice", nimService.Name)
+		return ctrl.Result{}, updateErr
+	}
 
 	err = r.reconcileDRAResources(ctx, nimService, namedDraResources)
 	if err != nil {
@@ -392,9 +531,13 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 	if nimService.Spec.MultiNode != nil && nimService.Spec.MultiNode.BackendType == appsv1alpha1.NIMBackendTypeLWS {
 		lwsParams := nimService.GetLWSParams()
 		lwsParams.PodResourceClaims = shared.GetPodResourceClaims(namedDraResources)
+		lwsParams.ImagePullSecrets = append(slices.Clone(lwsParams.ImagePullSecrets), shared.GetObjectStoreImagePullSecrets(&nimCache)...)
 		lwsParams.OrchestratorType = string(r.GetOrchestratorType())
 		lwsParams.LeaderVolumes = nimService.GetLeaderVolumes(*modelPVC)
 		lwsParams.WorkerVolumes = nimService.GetWorkerVolumes(*modelPVC)
+		if nimService.Status.Build != nil {
+			lwsParams.Annotations[utils.NvidiaAnnotationParentSpecHashKey] = getNIMBuildSpecHash(nimService)
+		}
 		if nimCache.IsUniversalNIM() {
 			lwsParams.WorkerEnvs = utils.MergeEnvVars([]corev1.EnvVar{{
 				Name:  "NIM_MODEL_NAME",
@@ -437,52 +580,55 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 			return ctrl.Result{}, fmt.Errorf("failed to create multi-node volumes: %v", err)
 		}
 	} else {
-		deploymentParams := nimService.GetDeploymentParams()
-		deploymentParams.OrchestratorType = string(r.GetOrchestratorType())
-		deploymentParams.PodResourceClaims = shared.GetPodResourceClaims(namedDraResources)
-		if nimCache.IsUniversalNIM() {
-			deploymentParams.Env = utils.MergeEnvVars([]corev1.EnvVar{{
-				Name:  "NIM_MODEL_NAME",
-				Value: utils.DefaultModelStorePath,
-			}}, deploymentParams.Env)
+		deploymentParams := r.getDeploymentParams(nimService, &nimCache, modelPVC, namedDraResources, loraAdapters, profileEnv, gpuResources)
+		if nimService.Status.Build != nil {
+			deploymentParams.Annotations[utils.NvidiaAnnotationParentSpecHashKey] = getNIMBuildSpecHash(nimService)
 		}
-		// Setup volume mounts with model store
-		deploymentParams.Volumes = nimService.GetVolumes(*modelPVC)
-		deploymentParams.VolumeMounts = nimService.GetVolumeMounts(*modelPVC)
-		if profileEnv != nil {
-			deploymentParams.Env = utils.MergeEnvVars(*profileEnv, deploymentParams.Env)
-		}
-		// Auto assign GPU resources in case of the optimized profile
-		if gpuResources != nil {
-			deploymentParams.Resources = gpuResources
-		}
-		renderFunc = func() (client.Object, error) {
-			result, err := renderer.Deployment(deploymentParams)
-			if err != nil {
-				return nil, err
-			}
-			if len(initContainers) > 0 {
-				result.Spec.Template.Spec.InitContainers = initContainers
+		renderFunc = r.getDeploymentRenderFunc(deploymentParams, initContainers, namedDraResources)
+		if len(multiModelStores) > 0 {
+			renderDeployment := renderFunc
+			renderFunc = func() (client.Object, error) {
+				result, err := renderDeployment()
+				if err != nil {
+					return nil, err
+				}
+				// Serve the additional models behind the model router.
+				setMultiModelContainers(result.(*appsv1.Deployment), nimService, multiModelStores)
+				return result, nil
 			}
-			// Update Container resources with DRA resource claims.
-			shared.UpdateContainerResourceClaims(result.Spec.Template.Spec.Containers, namedDraResources)
-			return result, nil
 		}
 		conType = "Deployment"
 		failedCon = conditions.ReasonDeploymentFailed
 		renderObj = &appsv1.Deployment{}
 	}
 
-	err = r.renderAndSyncResource(ctx, nimService, &renderer, renderObj, renderFunc, conType, failedCon)
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-
 	var ready bool
-	if nimService.Spec.MultiNode != nil {
-		msg, ready, err = r.isLeaderWorkerSetReady(ctx, nimService)
+	var result ctrl.Result
+	if nimService.IsProgressiveRolloutEnabled() {
+		var requeue bool
+		msg, ready, requeue, err = r.reconcileRollout(ctx, nimService, &nimCache, modelPVC, modelProfile, namedDraResources, loraAdapters)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if requeue {
+			// Re-check the rollout candidate once the status is updated.
+			result = ctrl.Result{RequeueAfter: 5 * time.Second}
+		}
 	} else {
-		msg, ready, err = r.isDeploymentReady(ctx, &namespacedName)
+		err = r.renderAndSyncResource(ctx, nimService, &renderer, renderObj, renderFunc, conType, failedCon)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if nimService.Spec.MultiNode != nil {
+			msg, ready, err = r.isLeaderWorkerSetReady(ctx, nimService)
+		} else {
+			err = r.cleanupRollout(ctx, nimService)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			msg, ready, err = r.isDeploymentReady(ctx, &namespacedName)
+		}
 	}
 
 	if err != nil {
@@ -514,6 +660,17 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 			return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
 		}
 
+		// Route the discovered models through the inference pool.
+		err = r.reconcileInferenceModels(ctx, nimService)
+		if err != nil {
+			logger.Error(err, "failed to reconcile inference models")
+			statusError := r.updater.SetConditionsFailed(ctx, nimService, conditions.ReasonInferenceModelFailed, err.Error())
+			if statusError != nil {
+				logger.Error(statusError, "failed to update status", "nimservice", nimService.Name)
+			}
+			return ctrl.Result{}, err
+		}
+
 		// Update status as ready
 		err = r.updater.SetConditionsReady(ctx, nimService, conditions.Ready, msg)
 		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, conditions.Ready,
@@ -524,7 +681,94 @@ func (r *NIMServiceReconciler) reconcileNIMService(ctx context.Context, nimServi
 		logger.Error(err, "failed to update status", "nimservice", nimService.Name, "state", conditions.Ready)
 		return ctrl.Result{}, err
 	}
-	return ctrl.Result{}, nil
+	return result, nil
+}
+
+// getProfileEnvAndResources returns the profile env and the GPU resources to use for the given NIMCache profile.
+func (r *NIMServiceReconciler) getProfileEnvAndResources(ctx context.Context, nimService *appsv1alpha1.NIMService, nimCache *appsv1alpha1.NIMCache, modelProfile string) (*[]corev1.EnvVar, *corev1.ResourceRequirements, error) {
+	logger := log.FromContext(ctx)
+
+	if modelProfile == "" {
+		return nil, nil, nil
+	}
+
+	profileEnv := &[]corev1.EnvVar{{
+		Name:  "NIM_MODEL_PROFILE",
+		Value: modelProfile,
+	}}
+
+	// Only assign GPU resources if the NIMCache is for optimized NIM
+	var gpuResources *corev1.ResourceRequirements
+	if nimCache.IsOptimizedNIM() {
+		// Retrieve and set profile details from NIMCache
+		profile, err := r.getNIMCacheProfile(ctx, nimService, modelProfile)
+		if err != nil {
+			logger.Error(err, "Failed to get cached NIM profile")
+			return nil, nil, err
+		}
+
+		// Auto assign GPU resources in case of the optimized profile
+		if profile != nil {
+			gpuResources, err = r.addGPUResources(ctx, nimService, profile)
+			if err != nil {
+				logger.Error(err, "Failed to get GPU resources")
+				return nil, nil, err
+			}
+		}
+	}
+
+	// TODO: assign GPU resources and node selector that is required for the selected profile
+	return profileEnv, gpuResources, nil
+}
+
+// getDeploymentParams returns the params to render the NIMService deployment with the given model store and profile.
+func (r *NIMServiceReconciler) getDeploymentParams(nimService *appsv1alpha1.NIMService, nimCache *appsv1alpha1.NIMCache, modelPVC *appsv1alpha1.PersistentVolumeClaim,
+	namedDraResources []shared.NamedDRAResource, loraAdapters []shared.NamedLoRAAdapter, profileEnv *[]corev1.EnvVar, gpuResources *corev1.ResourceRequirements) *rendertypes.DeploymentParams {
+	deploymentParams := nimService.GetDeploymentParams()
+	deploymentParams.OrchestratorType = string(r.GetOrchestratorType())
+	deploymentParams.PodResourceClaims = shared.GetPodResourceClaims(namedDraResources)
+	if nimCache.IsUniversalNIM() {
+		deploymentParams.Env = utils.MergeEnvVars([]corev1.EnvVar{{
+			Name:  "NIM_MODEL_NAME",
+			Value: utils.DefaultModelStorePath,
+		}}, deploymentParams.Env)
+	}
+	// Setup volume mounts with model store
+	deploymentParams.Volumes = nimService.GetVolumes(*modelPVC)
+	deploymentParams.VolumeMounts = nimService.GetVolumeMounts(*modelPVC)
+	if nimCache.IsObjectStoreEnabled() {
+		deploymentParams.ImagePullSecrets = append(slices.Clone(deploymentParams.ImagePullSecrets), shared.GetObjectStoreImagePullSecrets(nimCache)...)
+	}
+	// Setup the LoRA adapter store
+	if len(loraAdapters) > 0 {
+		deploymentParams.Volumes = append(deploymentParams.Volumes, shared.GetLoRAVolumes(loraAdapters)...)
+		deploymentParams.VolumeMounts = append(deploymentParams.VolumeMounts, shared.GetLoRAVolumeMounts(loraAdapters)...)
+		deploymentParams.ImagePullSecrets = append(slices.Clone(deploymentParams.ImagePullSecrets), shared.GetLoRAImagePullSecrets(loraAdapters)...)
+	}
+	if profileEnv != nil {
+		deploymentParams.Env = utils.MergeEnvVars(*profileEnv, deploymentParams.Env)
+	}
+	// Auto assign GPU resources in case of the optimized profile
+	if gpuResources != nil {
+		deploymentParams.Resources = gpuResources
+	}
+	return deploymentParams
+}
+
+// getDeploymentRenderFunc returns the function rendering the NIMService deployment from the given params.
+func (r *NIMServiceReconciler) getDeploymentRenderFunc(deploymentParams *rendertypes.DeploymentParams, initContainers []corev1.Container, namedDraResources []shared.NamedDRAResource) func() (client.Object, error) {
+	return func() (client.Object, error) {
+		result, err := r.renderer.Deployment(deploymentParams)
+		if err != nil {
+			return nil, err
+		}
+		if len(initContainers) > 0 {
+			result.Spec.Template.Spec.InitContainers = initContainers
+		}
+		// Update Container resources with DRA resource claims.
+		shared.UpdateContainerResourceClaims(result.Spec.Template.Spec.Containers, namedDraResources)
+		return result, nil
+	}
 }
 
 func (r *NIMServiceReconciler) createMultiNodeVolumeObjects(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
@@ -606,15 +850,39 @@ func (r *NIMServiceReconciler) updateModelStatus(ctx context.Context, nimService
 	if err != nil {
 		return err
 	}
+	var loraAdapters []string
+	if nimService.IsLoRAEnabled() {
+		loraAdapters, err = r.getNIMLoRAAdapters(ctx, clusterEndpoint)
+		if err != nil {
+			return err
+		}
+	}
+	models, err := r.getNIMServedModels(ctx, clusterEndpoint)
+	if err != nil {
+		return err
+	}
 	nimService.Status.Model = &appsv1alpha1.ModelStatus{
 		Name:             modelName,
 		ClusterEndpoint:  clusterEndpoint,
 		ExternalEndpoint: externalEndpoint,
+		LoRAAdapters:     loraAdapters,
+		Models:           models,
 	}
 
 	return nil
 }
 
+func (r *NIMServiceReconciler) getNIMLoRAAdapters(ctx context.Context, nimServiceEndpoint string) ([]string, error) {
+	logger := log.FromContext(ctx)
+
+	modelsList, err := nimmodels.ListModelsV1(ctx, nimServiceEndpoint, "http")
+	if err != nil {
+		logger.Error(err, "Failed to list LoRA adapters", "endpoint", nimServiceEndpoint)
+		return nil, err
+	}
+	return nimmodels.GetLoRAAdapterIDs(modelsList), nil
+}
+
 func (r *NIMServiceReconciler) updateResourceClaimStatus(ctx context.Context, nimService *appsv1alpha1.NIMService, namedDraResources []shared.NamedDRAResource) error {
 	logger := log.FromContext(ctx)
 
@@ -704,7 +972,7 @@ func getModelNameFromMetadata(metadata *nimmodels.MetadataV1) (string, error) {
 func (r *NIMServiceReconciler) getNIMModelEndpoints(ctx context.Context, nimService *appsv1alpha1.NIMService) (string, string, error) {
 	logger := log.FromContext(ctx)
 
-	// Lookup NIMCache instance in the same namespace as the NIMService instance
+	// Lookup the k8s service of the NIMService instance
 	svc := &corev1.Service{}
 	if err := r.Get(ctx, types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}, svc); err != nil {
 		logger.Error(err, "unable to fetch k8s service", "nimservice", nimService.GetName())
@@ -745,6 +1013,19 @@ func (r *NIMServiceReconciler) getNIMModelEndpoints(ctx context.Context, nimServ
 				externalEndpoint = ing.Hostname
 			}
 		}
+	} else if nimService.IsRouterEnabled() {
+		route := &gatewayv1.HTTPRoute{}
+		if err := r.Get(ctx, types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}, route); err != nil {
+			logger.Error(err, "unable to fetch httproute", "nimservice", nimService.GetName())
+			return "", "", err
+		}
+
+		endpoint, err := shared.GetGatewayRouteEndpoint(ctx, r.GetClient(), nimService.Spec.Expose.Router, route)
+		if err != nil {
+			logger.Error(err, "unable to fetch gateway endpoint", "nimservice", nimService.GetName())
+			return "", "", err
+		}
+		externalEndpoint = endpoint
 	} else if svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
 		externalEndpoint = utils.FormatEndpoint(svc.Spec.LoadBalancerIP, port)
 	}
@@ -790,7 +1071,12 @@ func (r *NIMServiceReconciler) renderAndSyncResource(ctx context.Context, nimSer
 		return err
 	}
 	// Don't do anything if CR is unchanged.
-	if err == nil && !utils.IsParentSpecChanged(obj, utils.DeepHashObject(nimService.Spec)) {
+	// Prefer the hash rendered on the resource as it may cover more than the spec (e.g. rollout state).
+	desiredHash, ok := metaAccessor.GetAnnotations()[utils.NvidiaAnnotationParentSpecHashKey]
+	if !ok {
+		desiredHash = utils.DeepHashObject(nimService.Spec)
+	}
+	if err == nil && !utils.IsParentSpecChanged(obj, desiredHash) {
 		return nil
 	}
 
@@ -883,6 +1169,18 @@ func (r *NIMServiceReconciler) getNIMCachePVC(nimCache *appsv1alpha1.NIMCache) (
 	return &nimCache.Spec.Storage.PVC, nil
 }
 
+// getObjectStoreModelPVC returns the PVC the model store is hydrated into from an object store NIMCache.
+// An empty PVC is returned when no PVC is configured on the NIMService, to hydrate the model store into an emptyDir.
+func (r *NIMServiceReconciler) getObjectStoreModelPVC(ctx context.Context, nimService *appsv1alpha1.NIMService) (*appsv1alpha1.PersistentVolumeClaim, error) {
+	if nimService.Spec.Storage.PVC.Create != nil && *nimService.Spec.Storage.PVC.Create {
+		return r.reconcilePVC(ctx, nimService)
+	}
+	if nimService.Spec.Storage.PVC.Name != "" {
+		return &nimService.Spec.Storage.PVC, nil
+	}
+	return &appsv1alpha1.PersistentVolumeClaim{}, nil
+}
+
 func (r *NIMServiceReconciler) getNIMCacheFailedMessage(nimCache *appsv1alpha1.NIMCache) string {
 	cond := meta.FindStatusCondition(nimCache.Status.Conditions, conditions.Failed)
 	if cond != nil && cond.Status == metav1.ConditionTrue {
@@ -948,9 +1246,9 @@ func (r *NIMServiceReconciler) getNIMCacheProfile(ctx context.Context, nimServic
 		return nil, nil
 	}
 
-	// Lookup NIMCache instance in the same namespace as the NIMService instance
+	// Lookup NIMCache instance in the namespace it is referenced in, defaulting to the NIMService namespace
 	nimCache := &appsv1alpha1.NIMCache{}
-	if err := r.Get(ctx, types.NamespacedName{Name: nimService.GetNIMCacheName(), Namespace: nimService.Namespace}, nimCache); err != nil {
+	if err := r.Get(ctx, types.NamespacedName{Name: nimService.GetNIMCacheName(), Namespace: nimService.GetNIMCacheNamespace()}, nimCache); err != nil {
 		logger.Error(err, "unable to fetch nimcache", "nimcache", nimService.GetNIMCacheName(), "nimservice", nimService.Name)
 		return nil, err
 	}
@@ -1032,7 +1330,13 @@ func (r *NIMServiceReconciler) addGPUResources(ctx context.Context, nimService *
 
 	var resources *corev1.ResourceRequirements
 	if nimService.Spec.Resources != nil {
-		resources = nimService.Spec.Resources
+		resources = nimService.Spec.Resources.DeepCopy()
+		if resources.Requests == nil {
+			resources.Requests = corev1.ResourceList{}
+		}
+		if resources.Limits == nil {
+			resources.Limits = corev1.ResourceList{}
+		}
 	} else {
 		resources = &corev1.ResourceRequirements{
 			Requests: corev1.ResourceList{},
@@ -1045,14 +1349,55 @@ func (r *NIMServiceReconciler) addGPUResources(ctx context.Context, nimService *
 	return resources, nil
 }
 
+// getNamedDRAResources returns the DRA resources of the NIMService, the auto DRA resources being derived from the
+// model profile and the MIG partitions and shared GPUs it requests being sized for its tensor parallelism.
+func getNamedDRAResources(nimService *appsv1alpha1.NIMService, nimCache *appsv1alpha1.NIMCache, modelProfile string) ([]shared.NamedDRAResource, error) {
+	namedDraResources := shared.GenerateNamedDRAResources(nimService)
+	var profile *appsv1alpha1.NIMProfile
+	for idx := range nimCache.Status.Profiles {
+		if nimCache.Status.Profiles[idx].Name == modelProfile {
+			profile = &nimCache.Status.Profiles[idx]
+			break
+		}
+	}
+
+	if shared.HasAutoDRAResources(namedDraResources) {
+		if profile == nil {
+			return nil, fmt.Errorf("profile %q of NIMCache %s not found to derive the auto DRA resources from", modelProfile, nimCache.GetName())
+		}
+		var err error
+		namedDraResources, err = shared.ResolveAutoDRAResources(namedDraResources, profile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Multi-node deployments request the devices of each worker
+	if profile == nil || nimService.Spec.MultiNode != nil {
+		return namedDraResources, nil
+	}
+
+	tp, err := utils.GetTensorParallelismByProfileTags(profile.Config)
+	if err != nil || tp == "" {
+		return namedDraResources, err
+	}
+	tensorParallelism, err := strconv.Atoi(tp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tensor parallelism %q of profile %s: %w", tp, modelProfile, err)
+	}
+	return shared.SizeDRAResources(namedDraResources, tensorParallelism), nil
+}
+
 func (r *NIMServiceReconciler) reconcileDRAResources(ctx context.Context, nimService *appsv1alpha1.NIMService, namedDraResources []shared.NamedDRAResource) error {
 	logger := log.FromContext(ctx)
 
 	renderer := r.GetRenderer()
+	keep := make(map[string]bool)
 	for _, namedDraResource := range namedDraResources {
 		if !shared.ShouldCreateDRAResource(namedDraResource.DRAResource) {
 			continue
 		}
+		keep[namedDraResource.ResourceName] = true
 
 		labels := nimService.GetServiceLabels()
 		annotations := nimService.GetNIMServiceAnnotations()
@@ -1068,17 +1413,12 @@ func (r *NIMServiceReconciler) reconcileDRAResources(ctx context.Context, nimSer
 				ClaimAnnotations: claimAnnotations,
 			}
 			for _, device := range namedDraResource.ClaimCreationSpec.Devices {
-				exprs, err := shared.GetDRADeviceCELExpressions(device)
+				deviceParams, err := shared.GetDRADeviceParams(device)
 				if err != nil {
-					logger.Error(err, "failed to get CEL expressions for device", "device", device.Name)
+					logger.Error(err, "failed to get params for device", "device", device.Name)
 					return nil, err
 				}
-				resourceClaimTemplateParams.Devices = append(resourceClaimTemplateParams.Devices, rendertypes.DRADeviceParams{
-					Name:            device.Name,
-					Count:           device.Count,
-					DeviceClassName: device.DeviceClassName,
-					CELExpressions:  exprs,
-				})
+				resourceClaimTemplateParams.Devices = append(resourceClaimTemplateParams.Devices, deviceParams)
 			}
 			return renderer.ResourceClaimTemplate(resourceClaimTemplateParams)
 		}, "resourceclaimtemplate", conditions.ReasonResourceClaimTemplateFailed)
@@ -1087,5 +1427,30 @@ func (r *NIMServiceReconciler) reconcileDRAResources(ctx context.Context, nimSer
 			return fmt.Errorf("failed to reconcile DRAResource %s: %w", namedDraResource.ResourceName, err)
 		}
 	}
+	return r.cleanupDRAResources(ctx, nimService, keep)
+}
+
+// cleanupDRAResources deletes the resource claim templates generated for the NIMService that are no longer desired,
+// e.g. when the devices derived from the model profile change.
+func (r *NIMServiceReconciler) cleanupDRAResources(ctx context.Context, nimService *appsv1alpha1.NIMService, keep map[string]bool) error {
+	logger := log.FromContext(ctx)
+
+	templates := &resourcev1beta2.ResourceClaimTemplateList{}
+	if err := r.GetClient().List(ctx, templates, client.InNamespace(nimService.GetNamespace()), client.MatchingLabels{
+		"app.kubernetes.io/managed-by": "k8s-nim-operator",
+		"app.kubernetes.io/instance":   nimService.GetName(),
+	}); err != nil {
+		return fmt.Errorf("failed to list resource claim templates: %w", err)
+	}
+	for i := range templates.Items {
+		template := &templates.Items[i]
+		if keep[template.Name] || !metav1.IsControlledBy(template, nimService) {
+			continue
+		}
+		if err := r.GetClient().Delete(ctx, template); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete stale resource claim template %s: %w", template.Name, err)
+		}
+		logger.Info("Deleted stale resource claim template", "name", template.Name)
+	}
 	return nil
 }
@@ -41,9 +41,12 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	resourcev1beta2 "k8s.io/api/resource/v1beta2"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	discoveryfake "k8s.io/client-go/discovery/fake"
@@ -53,6 +56,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	lwsv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
 
 	"k8s.io/apimachinery/pkg/version"
@@ -63,6 +67,7 @@ import (
 	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
 	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
 	"github.com/NVIDIA/k8s-nim-operator/internal/render"
+	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
 )
 
 func sortEnvVars(envVars []corev1.EnvVar) {
@@ -142,6 +147,7 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 		Expect(rbacv1.AddToScheme(scheme)).To(Succeed())
 		Expect(autoscalingv2.AddToScheme(scheme)).To(Succeed())
 		Expect(networkingv1.AddToScheme(scheme)).To(Succeed())
+		Expect(gatewayv1.AddToScheme(scheme)).To(Succeed())
 		Expect(corev1.AddToScheme(scheme)).To(Succeed())
 		Expect(monitoringv1.AddToScheme(scheme)).To(Succeed())
 		Expect(lwsv1.AddToScheme(scheme)).To(Succeed())
@@ -838,6 +844,158 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 				failedCondition := getCondition(obj, conditions.Failed)
 				Expect(failedCondition.Status).To(Equal(metav1.ConditionFalse))
 			})
+
+			It("should request shared MIG partitions sized for the NIMCache profile", func() {
+				nimCache.Status.Profiles = []appsv1alpha1.NIMProfile{{Name: "test-profile", Config: map[string]string{"tp": "2"}}}
+				Expect(client.Status().Update(context.TODO(), nimCache)).To(Succeed())
+				nimService.Spec.Storage.NIMCache.Profile = "test-profile"
+				nimService.Spec.DRAResources = []appsv1alpha1.DRAResource{
+					{
+						ClaimCreationSpec: &appsv1alpha1.DRAClaimCreationSpec{
+							Devices: []appsv1alpha1.DRADeviceSpec{
+								{
+									Name:            "test-device",
+									Count:           1,
+									DriverName:      "gpu.nvidia.com",
+									DeviceClassName: "gpu.nvidia.com",
+									MIG:             &appsv1alpha1.DRAMIGSpec{Profile: "3g.40gb"},
+									Sharing:         &appsv1alpha1.DRADeviceSharingSpec{Strategy: appsv1alpha1.DRADeviceSharingStrategyTimeSlicing},
+								},
+							},
+						},
+					},
+				}
+				nimServiceKey := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+				Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+
+				_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+				Expect(err).NotTo(HaveOccurred())
+
+				template := &resourcev1beta2.ResourceClaimTemplate{}
+				namedDraResources, err := getNamedDRAResources(nimService, nimCache, "test-profile")
+				Expect(err).NotTo(HaveOccurred())
+				templateName := namedDraResources[0].ResourceName
+				Expect(client.Get(context.TODO(), types.NamespacedName{Name: templateName, Namespace: nimService.Namespace}, template)).To(Succeed())
+				devices := template.Spec.Spec.Devices
+				Expect(devices.Requests).To(HaveLen(1))
+				Expect(devices.Requests[0].Exactly.DeviceClassName).To(Equal("mig.nvidia.com"))
+				Expect(devices.Requests[0].Exactly.Count).To(Equal(int64(2)))
+				Expect(devices.Requests[0].Exactly.Selectors).To(ContainElement(HaveField("CEL.Expression", `device.attributes["gpu.nvidia.com"].profile == "3g.40gb"`)))
+				Expect(devices.Config).To(HaveLen(1))
+				Expect(devices.Config[0].Requests).To(Equal([]string{"test-device"}))
+				Expect(devices.Config[0].Opaque.Driver).To(Equal("gpu.nvidia.com"))
+				Expect(devices.Config[0].Opaque.Parameters.Raw).To(MatchJSON(`{"apiVersion":"resource.nvidia.com/v1beta1","kind":"MigDeviceConfig","sharing":{"strategy":"TimeSlicing"}}`))
+
+				obj := &appsv1alpha1.NIMService{}
+				Expect(client.Get(context.TODO(), nimServiceKey, obj)).To(Succeed())
+				Expect(obj.Status.DRAResourceStatuses).To(HaveLen(1))
+				Expect(obj.Status.DRAResourceStatuses[0].ResourceClaimTemplateStatus.Devices).To(Equal([]appsv1alpha1.DRADeviceRequestStatus{{
+					Name:            "test-device",
+					Count:           2,
+					DeviceClassName: "mig.nvidia.com",
+					MIGProfile:      "3g.40gb",
+					SharingStrategy: appsv1alpha1.DRADeviceSharingStrategyTimeSlicing,
+				}}))
+			})
+
+			It("should derive the auto DRA resources from the NIMCache profile", func() {
+				nimCache.Status.Profiles = []appsv1alpha1.NIMProfile{{Name: "test-profile", Config: map[string]string{"tp": "2", "gpu": "H100_80GB"}}}
+				Expect(client.Status().Update(context.TODO(), nimCache)).To(Succeed())
+				nimService.Spec.Storage.NIMCache.Profile = "test-profile"
+				nimService.Spec.DRAResources = []appsv1alpha1.DRAResource{
+					{
+						Auto: &appsv1alpha1.DRAAutoClaimSpec{},
+					},
+				}
+				Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+
+				_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+				Expect(err).NotTo(HaveOccurred())
+
+				template := &resourcev1beta2.ResourceClaimTemplate{}
+				namedDraResources, err := getNamedDRAResources(nimService, nimCache, "test-profile")
+				Expect(err).NotTo(HaveOccurred())
+				templateName := namedDraResources[0].ResourceName
+				Expect(client.Get(context.TODO(), types.NamespacedName{Name: templateName, Namespace: nimService.Namespace}, template)).To(Succeed())
+				devices := template.Spec.Spec.Devices
+				Expect(devices.Requests).To(HaveLen(1))
+				Expect(devices.Requests[0].Name).To(Equal("gpu"))
+				Expect(devices.Requests[0].Exactly.DeviceClassName).To(Equal("gpu.nvidia.com"))
+				Expect(devices.Requests[0].Exactly.Count).To(Equal(int64(2)))
+				Expect(devices.Requests[0].Exactly.Selectors).To(ContainElement(HaveField("CEL.Expression", `device.attributes["gpu.nvidia.com"].productName.lowerAscii().matches("(^|[^a-z0-9])h100([^a-z0-9]|$)")`)))
+			})
+
+			It("should replace the auto DRA resource claim template when the NIMCache profile changes", func() {
+				nimCache.Status.Profiles = []appsv1alpha1.NIMProfile{
+					{Name: "test-profile", Config: map[string]string{"tp": "2", "gpu": "H100_80GB"}},
+					{Name: "test-profile-2", Config: map[string]string{"tp": "4", "gpu": "A100_80GB"}},
+				}
+				Expect(client.Status().Update(context.TODO(), nimCache)).To(Succeed())
+				nimService.Spec.Storage.NIMCache.Profile = "test-profile"
+				nimService.Spec.DRAResources = []appsv1alpha1.DRAResource{
+					{
+						Auto: &appsv1alpha1.DRAAutoClaimSpec{},
+					},
+				}
+				nimServiceKey := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+				Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+
+				_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+				Expect(err).NotTo(HaveOccurred())
+
+				namedDraResources, err := getNamedDRAResources(nimService, nimCache, "test-profile")
+				Expect(err).NotTo(HaveOccurred())
+				oldTemplateName := namedDraResources[0].ResourceName
+				Expect(client.Get(context.TODO(), types.NamespacedName{Name: oldTemplateName, Namespace: nimService.Namespace}, &resourcev1beta2.ResourceClaimTemplate{})).To(Succeed())
+
+				obj := &appsv1alpha1.NIMService{}
+				Expect(client.Get(context.TODO(), nimServiceKey, obj)).To(Succeed())
+				obj.Spec.Storage.NIMCache.Profile = "test-profile-2"
+				Expect(client.Update(context.TODO(), obj)).To(Succeed())
+
+				_, err = reconciler.reconcileNIMService(context.TODO(), obj)
+				Expect(err).NotTo(HaveOccurred())
+
+				namedDraResources, err = getNamedDRAResources(obj, nimCache, "test-profile-2")
+				Expect(err).NotTo(HaveOccurred())
+				newTemplateName := namedDraResources[0].ResourceName
+				Expect(newTemplateName).NotTo(Equal(oldTemplateName))
+
+				template := &resourcev1beta2.ResourceClaimTemplate{}
+				Expect(client.Get(context.TODO(), types.NamespacedName{Name: newTemplateName, Namespace: nimService.Namespace}, template)).To(Succeed())
+				Expect(template.Spec.Spec.Devices.Requests[0].Exactly.Count).To(Equal(int64(4)))
+				err = client.Get(context.TODO(), types.NamespacedName{Name: oldTemplateName, Namespace: nimService.Namespace}, &resourcev1beta2.ResourceClaimTemplate{})
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+
+				deployment := &appsv1.Deployment{}
+				Expect(client.Get(context.TODO(), nimServiceKey, deployment)).To(Succeed())
+				Expect(deployment.Spec.Template.Spec.ResourceClaims).To(HaveLen(1))
+				Expect(*deployment.Spec.Template.Spec.ResourceClaims[0].ResourceClaimTemplateName).To(Equal(newTemplateName))
+			})
+
+			It("should fail when the NIMCache profile of the auto DRA resources is not found", func() {
+				nimCache.Status.Profiles = []appsv1alpha1.NIMProfile{{Name: "test-profile-1"}, {Name: "test-profile-2"}}
+				Expect(client.Status().Update(context.TODO(), nimCache)).To(Succeed())
+				nimService.Spec.Storage.NIMCache.Profile = "test-profile-3"
+				nimService.Spec.DRAResources = []appsv1alpha1.DRAResource{
+					{
+						Auto: &appsv1alpha1.DRAAutoClaimSpec{},
+					},
+				}
+				nimServiceKey := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+				Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+
+				_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+				Expect(err).NotTo(HaveOccurred())
+
+				obj := &appsv1alpha1.NIMService{}
+				Expect(client.Get(context.TODO(), nimServiceKey, obj)).To(Succeed())
+				Expect(obj.Status.State).To(Equal(appsv1alpha1.NIMServiceStatusFailed))
+				failedCondition := getCondition(obj, conditions.Failed)
+				Expect(failedCondition).NotTo(BeNil())
+				Expect(failedCondition.Reason).To(Equal(conditions.ReasonResourceClaimTemplateFailed))
+				Expect(failedCondition.Message).To(ContainSubstring("profile \"test-profile-3\" of NIMCache test-nimcache not found to derive the auto DRA resources from"))
+			})
 		})
 
 		It("should delete Deployment when the NIMService is deleted", func() {
@@ -899,6 +1057,304 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 			Expect(errors.IsNotFound(err)).To(Equal(true))
 		})
 
+		It("should create a KEDA ScaledObject instead of HPA when the KEDA backend is selected", func() {
+			discoveryClient.Resources = append(discoveryClient.Resources, &metav1.APIResourceList{
+				GroupVersion: shared.KEDAScaledObjectGVK.GroupVersion().String(),
+				APIResources: []metav1.APIResource{
+					{Name: "scaledobjects"},
+				},
+			})
+			namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			nimService.Spec.Scale.Backend = appsv1alpha1.AutoscalingBackendKEDA
+			nimService.Spec.Scale.KEDA = &appsv1alpha1.KEDAScaledObjectSpec{
+				MaxReplicas:             4,
+				PrometheusServerAddress: "http://prometheus.monitoring:9090",
+				Triggers: []appsv1alpha1.KEDAPrometheusTrigger{
+					{Metric: appsv1alpha1.NIMMetricRequestsWaiting, Threshold: "10"},
+					{Metric: appsv1alpha1.NIMMetricKVCacheUtilization, Threshold: "0.8"},
+				},
+			}
+			err := client.Create(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			err = client.Get(context.TODO(), namespacedName, &autoscalingv2.HorizontalPodAutoscaler{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			scaledObject := shared.NewKEDAScaledObject()
+			err = client.Get(context.TODO(), namespacedName, scaledObject)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scaledObject.GetOwnerReferences()).To(HaveLen(1))
+			target, _, _ := unstructured.NestedString(scaledObject.Object, "spec", "scaleTargetRef", "name")
+			Expect(target).To(Equal(nimService.GetName()))
+			triggers, _, _ := unstructured.NestedSlice(scaledObject.Object, "spec", "triggers")
+			Expect(triggers).To(HaveLen(2))
+			query, _, _ := unstructured.NestedString(triggers[0].(map[string]interface{}), "metadata", "query")
+			Expect(query).To(Equal(fmt.Sprintf(`sum(num_requests_waiting{namespace=%q,service=%q})`, nimService.GetNamespace(), nimService.GetName())))
+			metricType, _, _ := unstructured.NestedString(triggers[1].(map[string]interface{}), "metricType")
+			Expect(metricType).To(Equal("Value"))
+
+			// The ScaledObject is updated along with the NIMService
+			nimService = &appsv1alpha1.NIMService{}
+			err = client.Get(context.TODO(), namespacedName, nimService)
+			Expect(err).NotTo(HaveOccurred())
+			nimService.Spec.Scale.KEDA.MaxReplicas = 8
+			err = client.Update(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			scaledObject = shared.NewKEDAScaledObject()
+			err = client.Get(context.TODO(), namespacedName, scaledObject)
+			Expect(err).NotTo(HaveOccurred())
+			maxReplicas, _, _ := unstructured.NestedInt64(scaledObject.Object, "spec", "maxReplicaCount")
+			Expect(maxReplicas).To(Equal(int64(8)))
+
+			// Switching back to HPA removes the ScaledObject
+			nimService = &appsv1alpha1.NIMService{}
+			err = client.Get(context.TODO(), namespacedName, nimService)
+			Expect(err).NotTo(HaveOccurred())
+			nimService.Spec.Scale.Backend = appsv1alpha1.AutoscalingBackendHPA
+			err = client.Update(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			err = client.Get(context.TODO(), namespacedName, shared.NewKEDAScaledObject())
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = client.Get(context.TODO(), namespacedName, &autoscalingv2.HorizontalPodAutoscaler{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should mark NIMService as failed when KEDA is not installed", func() {
+			nimService.Spec.Scale.Backend = appsv1alpha1.AutoscalingBackendKEDA
+			nimService.Spec.Scale.KEDA = &appsv1alpha1.KEDAScaledObjectSpec{
+				MaxReplicas:             4,
+				PrometheusServerAddress: "http://prometheus.monitoring:9090",
+				Triggers:                []appsv1alpha1.KEDAPrometheusTrigger{{Metric: appsv1alpha1.NIMMetricQueueDepth, Threshold: "10"}},
+			}
+			err := client.Create(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).To(HaveOccurred())
+			obj := &appsv1alpha1.NIMService{}
+			err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj.Status.State).To(Equal(appsv1alpha1.NIMServiceStatusFailed))
+			failed := meta.FindStatusCondition(obj.Status.Conditions, conditions.Failed)
+			Expect(failed).NotTo(BeNil())
+			Expect(failed.Reason).To(Equal(conditions.ReasonScaledObjectFailed))
+		})
+
+		It("should create gateway routes when the router is enabled", func() {
+			discoveryClient.Resources = append(discoveryClient.Resources, &metav1.APIResourceList{
+				GroupVersion: gatewayv1.SchemeGroupVersion.String(),
+				APIResources: []metav1.APIResource{
+					{Name: "httproutes"},
+					{Name: "grpcroutes"},
+				},
+			})
+			namespacedName := types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			nimService.Spec.Expose.Service.GRPCPort = ptr.To[int32](8001)
+			nimService.Spec.Expose.Router = &appsv1alpha1.Router{
+				Gateway:   appsv1alpha1.GatewayReference{Name: "inference-gateway", Namespace: "gateway", SectionName: "https"},
+				Hostnames: []string{"llm.example.com"},
+			}
+			err := client.Create(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			httpRoute := &gatewayv1.HTTPRoute{}
+			err = client.Get(context.TODO(), namespacedName, httpRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(httpRoute.Spec.ParentRefs).To(HaveLen(1))
+			Expect(string(httpRoute.Spec.ParentRefs[0].Name)).To(Equal("inference-gateway"))
+			Expect(string(*httpRoute.Spec.ParentRefs[0].Namespace)).To(Equal("gateway"))
+			Expect(string(*httpRoute.Spec.ParentRefs[0].SectionName)).To(Equal("https"))
+			Expect(httpRoute.Spec.Hostnames).To(ConsistOf(gatewayv1.Hostname("llm.example.com")))
+			Expect(httpRoute.Spec.Rules).To(HaveLen(1))
+			Expect(httpRoute.Spec.Rules[0].BackendRefs).To(HaveLen(1))
+			Expect(string(httpRoute.Spec.Rules[0].BackendRefs[0].Name)).To(Equal(nimService.GetName()))
+			Expect(*httpRoute.Spec.Rules[0].BackendRefs[0].Port).To(Equal(gatewayv1.PortNumber(nimService.GetServicePort())))
+
+			grpcRoute := &gatewayv1.GRPCRoute{}
+			err = client.Get(context.TODO(), namespacedName, grpcRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(grpcRoute.Spec.Rules).To(HaveLen(1))
+			Expect(*grpcRoute.Spec.Rules[0].BackendRefs[0].Port).To(Equal(gatewayv1.PortNumber(8001)))
+
+			// Routes are updated along with the router
+			nimService = &appsv1alpha1.NIMService{}
+			err = client.Get(context.TODO(), namespacedName, nimService)
+			Expect(err).NotTo(HaveOccurred())
+			nimService.Spec.Expose.Router.Hostnames = []string{"llm.example.org"}
+			err = client.Update(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			err = client.Get(context.TODO(), namespacedName, httpRoute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(httpRoute.Spec.Hostnames).To(ConsistOf(gatewayv1.Hostname("llm.example.org")))
+
+			// Routes are removed when the router is disabled
+			nimService = &appsv1alpha1.NIMService{}
+			err = client.Get(context.TODO(), namespacedName, nimService)
+			Expect(err).NotTo(HaveOccurred())
+			nimService.Spec.Expose.Router = nil
+			err = client.Update(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			err = client.Get(context.TODO(), namespacedName, &gatewayv1.HTTPRoute{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = client.Get(context.TODO(), namespacedName, &gatewayv1.GRPCRoute{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should mark NIMService as failed when gateway API is not enabled", func() {
+			nimService.Spec.Expose.Router = &appsv1alpha1.Router{
+				Gateway: appsv1alpha1.GatewayReference{Name: "inference-gateway"},
+			}
+			err := client.Create(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).To(HaveOccurred())
+
+			obj := &appsv1alpha1.NIMService{}
+			err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj.Status.State).To(Equal(appsv1alpha1.NIMServiceStatusFailed))
+			failed := meta.FindStatusCondition(obj.Status.Conditions, conditions.Failed)
+			Expect(failed).NotTo(BeNil())
+			Expect(failed.Reason).To(Equal(conditions.ReasonHTTPRouteFailed))
+		})
+
+		It("should serve the additional models behind the model router", func() {
+			mistralNIMCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-mistral-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source:  appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{ModelPuller: "test-container", PullSecret: "my-secret"}},
+					Storage: appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To[bool](true), StorageClass: "standard", Size: "1Gi"}},
+				},
+			}
+			Expect(client.Create(context.TODO(), mistralNIMCache)).To(Succeed())
+			mistralNIMCache.Status = appsv1alpha1.NIMCacheStatus{
+				State: appsv1alpha1.NimCacheStatusReady,
+				PVC:   "test-mistral-pvc",
+			}
+			Expect(client.Status().Update(context.TODO(), mistralNIMCache)).To(Succeed())
+
+			// Use the default probes, targeting the API port
+			nimService.Spec.ReadinessProbe = appsv1alpha1.Probe{}
+			nimService.Spec.LivenessProbe = appsv1alpha1.Probe{}
+			nimService.Spec.StartupProbe = appsv1alpha1.Probe{}
+			nimService.Spec.MultiModel = &appsv1alpha1.MultiModelSpec{
+				Models: []appsv1alpha1.NIMServiceModelSpec{
+					{
+						Name:     "mistral",
+						NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: "test-mistral-nimcache", Profile: "mistral-profile"},
+						Image:    &appsv1alpha1.Image{Repository: "nvcr.io/nim/mistralai/mistral-7b-instruct-v0.3", Tag: "1.0.0", PullPolicy: "IfNotPresent"},
+						Env:      []corev1.EnvVar{{Name: "NIM_MAX_MODEL_LEN", Value: "4096"}},
+					},
+				},
+				Router: appsv1alpha1.ModelRouterSpec{
+					Image: &appsv1alpha1.Image{Repository: "ghcr.io/nvidia/k8s-nim-operator", Tag: "main", PullPolicy: "IfNotPresent", PullSecrets: []string{"router-secret"}},
+				},
+			}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			deployment := &appsv1.Deployment{}
+			err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, deployment)
+			Expect(err).NotTo(HaveOccurred())
+			podSpec := deployment.Spec.Template.Spec
+			Expect(podSpec.Containers).To(HaveLen(3))
+
+			nimContainer := podSpec.Containers[0]
+			Expect(nimContainer.Ports).To(ContainElement(corev1.ContainerPort{Name: "model-0", Protocol: corev1.ProtocolTCP, ContainerPort: 8100}))
+			Expect(nimContainer.Env).To(ContainElement(corev1.EnvVar{Name: "NIM_SERVER_PORT", Value: "8100"}))
+			Expect(nimContainer.ReadinessProbe.HTTPGet.Port).To(Equal(intstr.FromString("model-0")))
+
+			mistralContainer := podSpec.Containers[1]
+			Expect(mistralContainer.Name).To(Equal("mistral"))
+			Expect(mistralContainer.Image).To(Equal("nvcr.io/nim/mistralai/mistral-7b-instruct-v0.3:1.0.0"))
+			Expect(mistralContainer.Ports).To(ContainElement(corev1.ContainerPort{Name: "model-1", Protocol: corev1.ProtocolTCP, ContainerPort: 8101}))
+			Expect(mistralContainer.ReadinessProbe.HTTPGet.Port).To(Equal(intstr.FromString("model-1")))
+			Expect(mistralContainer.Env).To(ContainElements(
+				corev1.EnvVar{Name: "NIM_SERVER_PORT", Value: "8101"},
+				corev1.EnvVar{Name: "NIM_MODEL_PROFILE", Value: "mistral-profile"},
+				corev1.EnvVar{Name: "NIM_MAX_MODEL_LEN", Value: "4096"},
+			))
+			Expect(mistralContainer.VolumeMounts).To(ContainElement(HaveField("Name", "model-store-mistral")))
+			Expect(mistralContainer.VolumeMounts).NotTo(ContainElement(HaveField("Name", "model-store")))
+			Expect(podSpec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "model-store-mistral",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "test-mistral-pvc"},
+				},
+			}))
+
+			routerContainer := podSpec.Containers[2]
+			Expect(routerContainer.Name).To(Equal(appsv1alpha1.DefaultModelRouterContainerName))
+			Expect(routerContainer.Image).To(Equal("ghcr.io/nvidia/k8s-nim-operator:main"))
+			Expect(routerContainer.Command).To(Equal([]string{"/model-router"}))
+			Expect(routerContainer.Args).To(Equal([]string{
+				fmt.Sprintf("--port=%d", nimService.GetServicePort()),
+				"--backend=http://127.0.0.1:8100",
+				"--backend=http://127.0.0.1:8101",
+			}))
+			Expect(routerContainer.Ports).To(ConsistOf(corev1.ContainerPort{Name: "api", Protocol: corev1.ProtocolTCP, ContainerPort: nimService.GetServicePort()}))
+			Expect(podSpec.ImagePullSecrets).To(ContainElement(corev1.LocalObjectReference{Name: "router-secret"}))
+		})
+
+		It("should mark NIMService as not ready when the NIMCache of an additional model is not ready", func() {
+			mistralNIMCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-mistral-nimcache",
+					Namespace: "default",
+				},
+			}
+			Expect(client.Create(context.TODO(), mistralNIMCache)).To(Succeed())
+
+			nimService.Spec.MultiModel = &appsv1alpha1.MultiModelSpec{
+				Models: []appsv1alpha1.NIMServiceModelSpec{
+					{Name: "mistral", NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: "test-mistral-nimcache"}},
+				},
+				Router: appsv1alpha1.ModelRouterSpec{
+					Image: &appsv1alpha1.Image{Repository: "ghcr.io/nvidia/k8s-nim-operator", Tag: "main"},
+				},
+			}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj := &appsv1alpha1.NIMService{}
+			err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj.Status.State).To(Equal(appsv1alpha1.NIMServiceStatusNotReady))
+			ready := meta.FindStatusCondition(obj.Status.Conditions, conditions.Ready)
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Reason).To(Equal(conditions.ReasonNIMCacheNotReady))
+			err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
 	})
 
 	It("should be NotReady when nimcache is not ready", func() {
@@ -2196,6 +2652,116 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 		})
 	})
 
+	Describe("inference pool routing", func() {
+		var (
+			poolGVK  = schema.GroupVersionKind{Group: appsv1alpha1.InferenceExtensionGroup, Version: appsv1alpha1.InferenceExtensionVersion, Kind: "InferencePool"}
+			modelGVK = schema.GroupVersionKind{Group: appsv1alpha1.InferenceExtensionGroup, Version: appsv1alpha1.InferenceExtensionVersion, Kind: "InferenceModel"}
+		)
+
+		newPoolNIMService := func(name string) *appsv1alpha1.NIMService {
+			ns := nimService.DeepCopy()
+			ns.Name = name
+			ns.UID = types.UID(name + "-uid")
+			ns.Spec.Expose.Router = &appsv1alpha1.Router{
+				Gateway: appsv1alpha1.GatewayReference{Name: "inference-gateway"},
+				InferencePool: &appsv1alpha1.InferencePoolRouting{
+					Name:           "llama",
+					EndpointPicker: appsv1alpha1.EndpointPickerReference{Name: "llama-epp"},
+				},
+			}
+			ns.Status.Model = &appsv1alpha1.ModelStatus{
+				Name:         "meta/llama-3.1-8b-instruct",
+				LoRAAdapters: []string{"llama-3.1-8b-math"},
+			}
+			return ns
+		}
+
+		getInferenceResource := func(gvk schema.GroupVersionKind, name string) (*unstructured.Unstructured, error) {
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(gvk)
+			err := client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: "default"}, obj)
+			return obj, err
+		}
+
+		BeforeEach(func() {
+			discoveryClient.Resources = append(discoveryClient.Resources, &metav1.APIResourceList{
+				GroupVersion: appsv1alpha1.InferenceExtensionGroup + "/" + appsv1alpha1.InferenceExtensionVersion,
+				APIResources: []metav1.APIResource{
+					{Name: "inferencepools"},
+					{Name: "inferencemodels"},
+				},
+			})
+		})
+
+		It("should label the pods and route to the inference pool", func() {
+			ns := newPoolNIMService("nim-a")
+			Expect(ns.GetServiceLabels()).To(HaveKeyWithValue(appsv1alpha1.InferencePoolLabelKey, "llama"))
+
+			spec := ns.GetHTTPRouteParams().Spec
+			backendRef := spec.Rules[0].BackendRefs[0]
+			Expect(string(*backendRef.Group)).To(Equal(appsv1alpha1.InferenceExtensionGroup))
+			Expect(string(*backendRef.Kind)).To(Equal("InferencePool"))
+			Expect(string(backendRef.Name)).To(Equal("llama"))
+			Expect(backendRef.Port).To(BeNil())
+		})
+
+		It("should share the inference pool and models across NIMServices serving the same model", func() {
+			nimA := newPoolNIMService("nim-a")
+			nimB := newPoolNIMService("nim-b")
+			for _, ns := range []*appsv1alpha1.NIMService{nimA, nimB} {
+				Expect(reconciler.reconcileInferencePool(context.TODO(), ns)).To(Succeed())
+				Expect(reconciler.reconcileInferenceModels(context.TODO(), ns)).To(Succeed())
+			}
+
+			pool, err := getInferenceResource(poolGVK, "llama")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pool.GetOwnerReferences()).To(HaveLen(2))
+			selector, _, _ := unstructured.NestedStringMap(pool.Object, "spec", "selector")
+			Expect(selector).To(Equal(map[string]string{appsv1alpha1.InferencePoolLabelKey: "llama"}))
+			targetPort, _, _ := unstructured.NestedInt64(pool.Object, "spec", "targetPortNumber")
+			Expect(targetPort).To(Equal(int64(nimA.GetServicePort())))
+			epp, _, _ := unstructured.NestedString(pool.Object, "spec", "extensionRef", "name")
+			Expect(epp).To(Equal("llama-epp"))
+
+			model, err := getInferenceResource(modelGVK, "llama-meta-llama-3-1-8b-instruct")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(model.GetOwnerReferences()).To(HaveLen(2))
+			modelName, _, _ := unstructured.NestedString(model.Object, "spec", "modelName")
+			Expect(modelName).To(Equal("meta/llama-3.1-8b-instruct"))
+			poolRef, _, _ := unstructured.NestedString(model.Object, "spec", "poolRef", "name")
+			Expect(poolRef).To(Equal("llama"))
+			_, err = getInferenceResource(modelGVK, "llama-llama-3-1-8b-math")
+			Expect(err).NotTo(HaveOccurred())
+
+			// A NIMService no longer serving the adapter releases it, the last one deletes it.
+			nimA.Status.Model.LoRAAdapters = nil
+			Expect(reconciler.reconcileInferenceModels(context.TODO(), nimA)).To(Succeed())
+			adapter, err := getInferenceResource(modelGVK, "llama-llama-3-1-8b-math")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adapter.GetOwnerReferences()).To(HaveLen(1))
+			nimB.Status.Model.LoRAAdapters = nil
+			Expect(reconciler.reconcileInferenceModels(context.TODO(), nimB)).To(Succeed())
+			_, err = getInferenceResource(modelGVK, "llama-llama-3-1-8b-math")
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			// Disabling the pool on both NIMServices deletes the shared resources.
+			for _, ns := range []*appsv1alpha1.NIMService{nimA, nimB} {
+				ns.Spec.Expose.Router.InferencePool = nil
+				Expect(reconciler.reconcileInferencePool(context.TODO(), ns)).To(Succeed())
+			}
+			_, err = getInferenceResource(poolGVK, "llama")
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			_, err = getInferenceResource(modelGVK, "llama-meta-llama-3-1-8b-instruct")
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should fail when the inference extension is not installed", func() {
+			discoveryClient.Resources = discoveryClient.Resources[:len(discoveryClient.Resources)-1]
+			err := reconciler.reconcileInferencePool(context.TODO(), newPoolNIMService("nim-a"))
+			Expect(err).To(MatchError(ContainSubstring("inference pools are not supported on this cluster")))
+		})
+	})
+
 	Describe("getNIMModelEndpoints", func() {
 		var (
 			svc     *corev1.Service
@@ -2286,6 +2852,56 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 			Expect(external).To(Equal("10.1.1.2"))
 		})
 
+		It("should return the route hostname as external endpoint once accepted by the gateway", func() {
+			nimService.Spec.Expose.Ingress.Enabled = ptr.To(false)
+			nimService.Spec.Expose.Router = &appsv1alpha1.Router{
+				Gateway:   appsv1alpha1.GatewayReference{Name: "inference-gateway"},
+				Hostnames: []string{"llm.example.com"},
+			}
+			route := &gatewayv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimservice",
+					Namespace: "default",
+				},
+				Spec: nimService.Spec.Expose.Router.GenerateGatewayHTTPRouteSpec(nimService.GetName(), nimService.GetServicePort()),
+			}
+			Expect(client.Create(context.TODO(), route)).To(Succeed())
+
+			_, external, err := reconciler.getNIMModelEndpoints(context.TODO(), nimService)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(external).To(BeEmpty())
+
+			route.Status.Parents = []gatewayv1.RouteParentStatus{
+				{
+					ParentRef:      gatewayv1.ParentReference{Name: "inference-gateway"},
+					ControllerName: "example.com/gateway-controller",
+					Conditions: []metav1.Condition{
+						{Type: string(gatewayv1.RouteConditionAccepted), Status: metav1.ConditionTrue, Reason: "Accepted", LastTransitionTime: metav1.Now()},
+					},
+				},
+			}
+			Expect(client.Update(context.TODO(), route)).To(Succeed())
+			_, external, err = reconciler.getNIMModelEndpoints(context.TODO(), nimService)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(external).To(Equal("llm.example.com"))
+
+			// Fall back to the gateway address without route hostnames
+			nimService.Spec.Expose.Router.Hostnames = nil
+			gateway := &gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "inference-gateway",
+					Namespace: "default",
+				},
+				Status: gatewayv1.GatewayStatus{
+					Addresses: []gatewayv1.GatewayStatusAddress{{Value: "10.1.1.3"}},
+				},
+			}
+			Expect(client.Create(context.TODO(), gateway)).To(Succeed())
+			_, external, err = reconciler.getNIMModelEndpoints(context.TODO(), nimService)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(external).To(Equal("10.1.1.3"))
+		})
+
 		It("should return ingress loadbalancer hostname as external endpoint", func() {
 			nimService.Spec.Expose.Ingress.Spec.Rules[0].Host = ""
 			ingress.Spec.Rules[0].Host = ""
@@ -2571,4 +3187,541 @@ var _ = Describe("NIMServiceReconciler for a standalone platform", func() {
 
 		})
 	})
+
+	Describe("Reconcile NIMService with a progressive rollout", func() {
+		var nimServiceKey, candidateKey types.NamespacedName
+
+		BeforeEach(func() {
+			nimService.Spec.Scale.Enabled = ptr.To(false)
+			nimService.Spec.Replicas = 2
+			nimServiceKey = types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			candidateKey = types.NamespacedName{Name: nimService.GetCandidateName(), Namespace: nimService.Namespace}
+		})
+
+		AfterEach(func() {
+			for _, key := range []types.NamespacedName{nimServiceKey, candidateKey} {
+				_ = client.Delete(context.TODO(), &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}})
+				_ = client.Delete(context.TODO(), &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}})
+			}
+		})
+
+		reconcile := func() {
+			Expect(client.Get(context.TODO(), nimServiceKey, nimService)).To(Succeed())
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Get(context.TODO(), nimServiceKey, nimService)).To(Succeed())
+		}
+
+		setDeploymentReady := func(key types.NamespacedName) {
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), key, deployment)).To(Succeed())
+			deployment.Status.Replicas = *deployment.Spec.Replicas
+			deployment.Status.UpdatedReplicas = *deployment.Spec.Replicas
+			deployment.Status.ReadyReplicas = *deployment.Spec.Replicas
+			deployment.Status.AvailableReplicas = *deployment.Spec.Replicas
+			Expect(client.Status().Update(context.TODO(), deployment)).To(Succeed())
+		}
+
+		updateImageTag := func(tag string) {
+			Expect(client.Get(context.TODO(), nimServiceKey, nimService)).To(Succeed())
+			nimService.Spec.Image.Tag = tag
+			Expect(client.Update(context.TODO(), nimService)).To(Succeed())
+		}
+
+		It("should roll out a new image through a canary deployment", func() {
+			nimService.Spec.Rollout = &appsv1alpha1.NIMServiceRolloutSpec{
+				Strategy: appsv1alpha1.RolloutStrategyCanary,
+				Canary:   &appsv1alpha1.CanaryRolloutSpec{Weight: 50},
+			}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			stableImage := nimService.GetImage()
+
+			reconcile()
+			Expect(nimService.Status.Rollout).NotTo(BeNil())
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhaseStable))
+			Expect(nimService.Status.Rollout.StableRevision.Image).To(Equal(stableImage))
+			err := client.Get(context.TODO(), candidateKey, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			updateImageTag("v0.2.0")
+			reconcile()
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhaseProgressing))
+			Expect(nimService.Status.Rollout.CandidateRevision.Image).To(Equal(nimService.GetImage()))
+
+			primary := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), nimServiceKey, primary)).To(Succeed())
+			Expect(primary.Spec.Template.Spec.Containers[0].Image).To(Equal(stableImage))
+			Expect(*primary.Spec.Replicas).To(Equal(int32(1)))
+			candidate := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), candidateKey, candidate)).To(Succeed())
+			Expect(candidate.Spec.Template.Spec.Containers[0].Image).To(Equal(nimService.GetImage()))
+			Expect(*candidate.Spec.Replicas).To(Equal(int32(1)))
+			Expect(candidate.Spec.Selector.MatchLabels).To(Equal(map[string]string{"app": candidateKey.Name}))
+
+			// Traffic is split across both deployments.
+			svc := &corev1.Service{}
+			Expect(client.Get(context.TODO(), nimServiceKey, svc)).To(Succeed())
+			Expect(svc.Spec.Selector).To(Equal(map[string]string{"app.kubernetes.io/instance": nimService.Name}))
+
+			// Verify the candidate once it is ready.
+			setDeploymentReady(candidateKey)
+			candidateSvc := &corev1.Service{}
+			Expect(client.Get(context.TODO(), candidateKey, candidateSvc)).To(Succeed())
+			candidateSvc.Spec.ClusterIP = "127.0.0.1"
+			Expect(client.Update(context.TODO(), candidateSvc)).To(Succeed())
+			reconcile()
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhasePaused))
+			Expect(nimService.Status.Rollout.CandidateModel).To(Equal("dummy-model"))
+			Expect(nimService.Status.Rollout.CandidateReadyReplicas).To(Equal(int32(1)))
+
+			// Promote on request.
+			nimService.Annotations = map[string]string{appsv1alpha1.NIMServiceRolloutPromoteAnnotation: "true"}
+			Expect(client.Update(context.TODO(), nimService)).To(Succeed())
+			reconcile()
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhasePromoting))
+			Expect(nimService.Status.Rollout.StableRevision.Image).To(Equal(nimService.GetImage()))
+			Expect(nimService.Annotations).NotTo(HaveKey(appsv1alpha1.NIMServiceRolloutPromoteAnnotation))
+
+			reconcile()
+			Expect(client.Get(context.TODO(), nimServiceKey, primary)).To(Succeed())
+			Expect(primary.Spec.Template.Spec.Containers[0].Image).To(Equal(nimService.GetImage()))
+			Expect(*primary.Spec.Replicas).To(Equal(int32(2)))
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhasePromoting))
+
+			// Complete the rollout once the primary deployment is ready.
+			setDeploymentReady(nimServiceKey)
+			reconcile()
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhaseStable))
+			Expect(nimService.Status.Rollout.CandidateRevision).To(BeNil())
+			err = client.Get(context.TODO(), candidateKey, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = client.Get(context.TODO(), candidateKey, &corev1.Service{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(client.Get(context.TODO(), nimServiceKey, svc)).To(Succeed())
+			Expect(svc.Spec.Selector).To(Equal(nimService.GetSelectorLabels()))
+		})
+
+		It("should switch traffic to a verified blue/green deployment on promotion", func() {
+			nimService.Spec.Rollout = &appsv1alpha1.NIMServiceRolloutSpec{
+				Strategy:    appsv1alpha1.RolloutStrategyBlueGreen,
+				AutoPromote: ptr.To(true),
+			}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			reconcile()
+
+			updateImageTag("v0.2.0")
+			reconcile()
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhaseProgressing))
+			candidate := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), candidateKey, candidate)).To(Succeed())
+			Expect(*candidate.Spec.Replicas).To(Equal(int32(2)))
+
+			// The candidate receives no traffic before promotion.
+			svc := &corev1.Service{}
+			Expect(client.Get(context.TODO(), nimServiceKey, svc)).To(Succeed())
+			Expect(svc.Spec.Selector).To(Equal(nimService.GetSelectorLabels()))
+
+			setDeploymentReady(candidateKey)
+			candidateSvc := &corev1.Service{}
+			Expect(client.Get(context.TODO(), candidateKey, candidateSvc)).To(Succeed())
+			candidateSvc.Spec.ClusterIP = "127.0.0.1"
+			Expect(client.Update(context.TODO(), candidateSvc)).To(Succeed())
+			reconcile()
+			Expect(nimService.Status.Rollout.Phase).To(Equal(appsv1alpha1.RolloutPhasePromoting))
+			Expect(client.Get(context.TODO(), nimServiceKey, svc)).To(Succeed())
+			Expect(svc.Spec.Selector).To(Equal(map[string]string{"app": candidateKey.Name}))
+		})
+
+		It("should remove the candidate when the rollout strategy is disabled", func() {
+			nimService.Spec.Rollout = &appsv1alpha1.NIMServiceRolloutSpec{Strategy: appsv1alpha1.RolloutStrategyCanary}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			reconcile()
+			updateImageTag("v0.2.0")
+			reconcile()
+			Expect(client.Get(context.TODO(), candidateKey, &appsv1.Deployment{})).To(Succeed())
+
+			nimService.Spec.Rollout = nil
+			Expect(client.Update(context.TODO(), nimService)).To(Succeed())
+			reconcile()
+			Expect(nimService.Status.Rollout).To(BeNil())
+			err := client.Get(context.TODO(), candidateKey, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			primary := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), nimServiceKey, primary)).To(Succeed())
+			Expect(primary.Spec.Template.Spec.Containers[0].Image).To(Equal(nimService.GetImage()))
+		})
+	})
+
+	Describe("Reconcile NIMService with a NIMBuild", func() {
+		var nimServiceKey types.NamespacedName
+		var nimBuild *appsv1alpha1.NIMBuild
+
+		BeforeEach(func() {
+			nimService.Spec.Scale.Enabled = ptr.To(false)
+			nimService.Spec.Storage.NIMCache.NIMBuild = "test-nimbuild"
+			nimServiceKey = types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}
+			nimBuild = &appsv1alpha1.NIMBuild{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-nimbuild",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMBuildSpec{
+					NIMCache: appsv1alpha1.NIMCacheReference{Name: "test-nimcache"},
+				},
+				Status: appsv1alpha1.NIMBuildStatus{
+					State:        appsv1alpha1.NimBuildStatusInProgress,
+					InputProfile: appsv1alpha1.NIMProfile{Name: "test-profile"},
+				},
+			}
+			Expect(client.Create(context.TODO(), nimBuild)).To(Succeed())
+
+			nimCache.Status.Profiles = []appsv1alpha1.NIMProfile{
+				{Name: "test-profile", Config: map[string]string{"tp": "4"}},
+				{Name: "built-profile", Config: map[string]string{"tp": "4"}},
+			}
+			Expect(client.Status().Update(context.TODO(), nimCache)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			_ = client.Delete(context.TODO(), nimBuild)
+			_ = client.Delete(context.TODO(), &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: nimServiceKey.Name, Namespace: nimServiceKey.Namespace}})
+		})
+
+		reconcile := func() {
+			Expect(client.Get(context.TODO(), nimServiceKey, nimService)).To(Succeed())
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Get(context.TODO(), nimServiceKey, nimService)).To(Succeed())
+		}
+
+		getModelProfile := func() string {
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), nimServiceKey, deployment)).To(Succeed())
+			for _, env := range deployment.Spec.Template.Spec.Containers[0].Env {
+				if env.Name == "NIM_MODEL_PROFILE" {
+					return env.Value
+				}
+			}
+			return ""
+		}
+
+		setNIMBuildReady := func() {
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimBuild.Name, Namespace: nimBuild.Namespace}, nimBuild)).To(Succeed())
+			nimBuild.Status.State = appsv1alpha1.NimBuildStatusReady
+			nimBuild.Status.OutputProfile = appsv1alpha1.NIMProfile{Name: "built-profile"}
+			nimBuild.Status.Builds = []appsv1alpha1.NIMBuildEngineStatus{{
+				Name:          "test-nimbuild",
+				InputProfile:  "test-profile",
+				OutputProfile: "built-profile",
+				State:         appsv1alpha1.NimBuildStatusReady,
+			}}
+			Expect(client.Update(context.TODO(), nimBuild)).To(Succeed())
+		}
+
+		It("should be NotReady when the NIMBuild is not found", func() {
+			Expect(client.Delete(context.TODO(), nimBuild)).To(Succeed())
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			reconcile()
+			Expect(nimService.Status.State).To(Equal(conditions.NotReady))
+			Expect(nimService.Status.Conditions).To(ContainElement(HaveField("Reason", conditions.ReasonNIMBuildNotFound)))
+		})
+
+		It("should roll out the built engine and roll back when it fails to become ready", func() {
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+
+			// Serve the input profile until the engine is built.
+			reconcile()
+			Expect(nimService.Status.Build).NotTo(BeNil())
+			Expect(nimService.Status.Build.NIMBuild).To(Equal("test-nimbuild"))
+			Expect(nimService.Status.Build.Profile).To(Equal("test-profile"))
+			Expect(getModelProfile()).To(Equal("test-profile"))
+
+			setNIMBuildReady()
+			reconcile()
+			Expect(nimService.Status.Build.InputProfile).To(Equal("test-profile"))
+			Expect(nimService.Status.Build.OutputProfile).To(Equal("built-profile"))
+			Expect(nimService.Status.Build.Profile).To(Equal("built-profile"))
+			Expect(getModelProfile()).To(Equal("built-profile"))
+
+			// Roll back once the deployment of the built engine exceeds its progress deadline.
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), nimServiceKey, deployment)).To(Succeed())
+			deployment.Status.ObservedGeneration = deployment.Generation
+			deployment.Status.Conditions = []appsv1.DeploymentCondition{{
+				Type:   appsv1.DeploymentProgressing,
+				Status: corev1.ConditionFalse,
+				Reason: "ProgressDeadlineExceeded",
+			}}
+			Expect(client.Status().Update(context.TODO(), deployment)).To(Succeed())
+			reconcile()
+			Expect(nimService.Status.Build.RolledBackProfile).To(Equal("built-profile"))
+			Expect(nimService.Status.Build.Profile).To(Equal("test-profile"))
+			Expect(getModelProfile()).To(Equal("test-profile"))
+		})
+	})
+
+	Describe("Reconcile NIMService with LoRA adapters", func() {
+		AfterEach(func() {
+			_ = client.Delete(context.TODO(), &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: nimService.Name, Namespace: nimService.Namespace}})
+		})
+
+		It("should mount and download the adapters into the adapter store", func() {
+			nimService.Spec.LoRA = &appsv1alpha1.LoRASpec{
+				Adapters: []appsv1alpha1.LoRAAdapter{
+					{Name: "math", PVC: &appsv1alpha1.LoRAPVCSource{Name: "lora-pvc", SubPath: "math"}},
+					{Name: "squad", NIMCache: &appsv1alpha1.LoRANIMCacheSource{Name: nimCache.Name, Path: "squad"}},
+					{Name: "llama-lora", HF: &appsv1alpha1.HuggingFaceHubSource{
+						Endpoint:  "https://huggingface.co",
+						Namespace: "meta-llama",
+						DSHFCommonFields: appsv1alpha1.DSHFCommonFields{
+							ModelName:   ptr.To("llama-lora"),
+							AuthSecret:  "hf-secret",
+							ModelPuller: "hf-puller:latest",
+							PullSecret:  "hf-pull-secret",
+						},
+					}},
+				},
+				MaxGPULoRAs: ptr.To[int32](4),
+			}
+			nimCache.Status = appsv1alpha1.NIMCacheStatus{State: appsv1alpha1.NimCacheStatusReady, PVC: "test-pvc"}
+			Expect(client.Status().Update(context.TODO(), nimCache)).To(Succeed())
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, deployment)).To(Succeed())
+			podSpec := deployment.Spec.Template.Spec
+			Expect(podSpec.Volumes).To(ContainElements(
+				corev1.Volume{Name: "lora-store", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+				corev1.Volume{Name: "lora-0", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "lora-pvc", ReadOnly: true}}},
+				corev1.Volume{Name: "lora-1", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "test-pvc", ReadOnly: true}}},
+			))
+			Expect(podSpec.Containers[0].VolumeMounts).To(ContainElements(
+				corev1.VolumeMount{Name: "lora-store", MountPath: "/loras"},
+				corev1.VolumeMount{Name: "lora-0", MountPath: "/loras/math", SubPath: "math", ReadOnly: true},
+				corev1.VolumeMount{Name: "lora-1", MountPath: "/loras/squad", SubPath: "subPath/squad", ReadOnly: true},
+			))
+			Expect(podSpec.Containers[0].Env).To(ContainElements(
+				corev1.EnvVar{Name: "NIM_PEFT_SOURCE", Value: "/loras"},
+				corev1.EnvVar{Name: "NIM_MAX_GPU_LORAS", Value: "4"},
+			))
+			Expect(podSpec.InitContainers).To(HaveLen(1))
+			Expect(podSpec.InitContainers[0].Name).To(Equal("download-lora-2"))
+			Expect(podSpec.InitContainers[0].Image).To(Equal("hf-puller:latest"))
+			Expect(podSpec.InitContainers[0].EnvFrom[0].SecretRef.Name).To(Equal("hf-secret"))
+			Expect(podSpec.ImagePullSecrets).To(ContainElement(corev1.LocalObjectReference{Name: "hf-pull-secret"}))
+		})
+
+		It("should not be ready when the adapter NIMCache is not ready", func() {
+			nimService.Spec.LoRA = &appsv1alpha1.LoRASpec{
+				Adapters: []appsv1alpha1.LoRAAdapter{
+					{Name: "squad", NIMCache: &appsv1alpha1.LoRANIMCacheSource{Name: "missing-cache"}},
+				},
+			}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			result, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).NotTo(BeZero())
+
+			obj := &appsv1alpha1.NIMService{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, obj)).To(Succeed())
+			readyCondition := meta.FindStatusCondition(obj.Status.Conditions, conditions.Ready)
+			Expect(readyCondition).NotTo(BeNil())
+			Expect(readyCondition.Reason).To(Equal(conditions.ReasonLoRAAdaptersNotReady))
+			err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("Reconcile NIMService with an object store NIMCache", func() {
+		AfterEach(func() {
+			_ = client.Delete(context.TODO(), &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: nimService.Name, Namespace: nimService.Namespace}})
+			_ = client.Delete(context.TODO(), &appsv1alpha1.NIMCache{ObjectMeta: metav1.ObjectMeta{Name: "s3-nimcache", Namespace: nimService.Namespace}})
+		})
+
+		It("should hydrate the model store from the object store into an emptyDir", func() {
+			s3NIMCache := &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "s3-nimcache",
+					Namespace: "default",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source: appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{ModelPuller: "test-container", PullSecret: "my-secret"}},
+					Storage: appsv1alpha1.NIMCacheStorage{
+						ObjectStore: &appsv1alpha1.NIMCacheObjectStore{
+							Endpoint:    "http://minio.minio:9000",
+							Bucket:      "nim-cache",
+							Credentials: appsv1alpha1.ObjectStoreAccessKeys{SecretName: "minio-creds"},
+							Image:       "amazon/aws-cli:test",
+							PullSecret:  "aws-cli-secret",
+						},
+					},
+				},
+			}
+			Expect(client.Create(context.TODO(), s3NIMCache)).To(Succeed())
+			s3NIMCache.Status = appsv1alpha1.NIMCacheStatus{
+				State:          appsv1alpha1.NimCacheStatusReady,
+				ObjectStoreURI: s3NIMCache.GetObjectStoreURI(),
+			}
+			Expect(client.Status().Update(context.TODO(), s3NIMCache)).To(Succeed())
+
+			nimService.Spec.Storage = appsv1alpha1.NIMServiceStorage{
+				NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: s3NIMCache.Name},
+			}
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, deployment)).To(Succeed())
+			podSpec := deployment.Spec.Template.Spec
+			Expect(podSpec.Volumes).To(ContainElement(corev1.Volume{Name: "model-store", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}))
+			Expect(podSpec.InitContainers).To(HaveLen(1))
+			Expect(podSpec.InitContainers[0].Name).To(Equal("hydrate-model-store"))
+			Expect(podSpec.InitContainers[0].Image).To(Equal("amazon/aws-cli:test"))
+			Expect(podSpec.InitContainers[0].Command).To(Equal([]string{"aws", "s3", "sync", "s3://nim-cache/default/s3-nimcache", "/model-store", "--endpoint-url", "http://minio.minio:9000", "--only-show-errors"}))
+			Expect(podSpec.InitContainers[0].VolumeMounts).To(Equal([]corev1.VolumeMount{{Name: "model-store", MountPath: "/model-store"}}))
+			Expect(podSpec.ImagePullSecrets).To(ContainElement(corev1.LocalObjectReference{Name: "aws-cli-secret"}))
+		})
+	})
+
+	Describe("Reconcile NIMService with a NIMCache in another namespace", func() {
+		var sharedNIMCache *appsv1alpha1.NIMCache
+
+		BeforeEach(func() {
+			sharedNIMCache = &appsv1alpha1.NIMCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shared-nimcache",
+					Namespace: "models",
+				},
+				Spec: appsv1alpha1.NIMCacheSpec{
+					Source:  appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{ModelPuller: "test-container", PullSecret: "my-secret"}},
+					Storage: appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To(true), SubPath: "llama"}},
+				},
+			}
+			Expect(client.Create(context.TODO(), sharedNIMCache)).To(Succeed())
+			sharedNIMCache.Status = appsv1alpha1.NIMCacheStatus{State: appsv1alpha1.NimCacheStatusReady, PVC: "shared-nimcache-pvc"}
+			Expect(client.Status().Update(context.TODO(), sharedNIMCache)).To(Succeed())
+			Expect(client.Create(context.TODO(), &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "shared-nimcache-pvc", Namespace: "models"},
+				Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "pv-shared"},
+			})).To(Succeed())
+			Expect(client.Create(context.TODO(), &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "pv-shared"},
+				Spec: corev1.PersistentVolumeSpec{
+					Capacity:    corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("50Gi")},
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+					PersistentVolumeSource: corev1.PersistentVolumeSource{
+						NFS: &corev1.NFSVolumeSource{Server: "nfs.local", Path: "/exports/models"},
+					},
+				},
+			})).To(Succeed())
+
+			nimService.Spec.Storage = appsv1alpha1.NIMServiceStorage{
+				NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: sharedNIMCache.Name, Namespace: sharedNIMCache.Namespace},
+			}
+		})
+
+		AfterEach(func() {
+			_ = client.Delete(context.TODO(), &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: nimService.Name, Namespace: nimService.Namespace}})
+			_ = client.Delete(context.TODO(), sharedNIMCache)
+			_ = client.Delete(context.TODO(), &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "shared-nimcache-pvc", Namespace: "models"}})
+			_ = client.Delete(context.TODO(), &corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-shared"}})
+			_ = client.Delete(context.TODO(), &appsv1alpha1.NIMCacheGrant{ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "models"}})
+		})
+
+		It("should fail when the NIMCache is not granted", func() {
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj := &appsv1alpha1.NIMService{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, obj)).To(Succeed())
+			Expect(obj.Status.State).To(Equal(appsv1alpha1.NIMServiceStatusFailed))
+			failedCondition := meta.FindStatusCondition(obj.Status.Conditions, conditions.Failed)
+			Expect(failedCondition).NotTo(BeNil())
+			Expect(failedCondition.Reason).To(Equal(conditions.ReasonNIMCacheNotGranted))
+			err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should fail when the granted NIMCache is cached in an object store", func() {
+			sharedNIMCache.Spec.Storage.ObjectStore = &appsv1alpha1.NIMCacheObjectStore{Bucket: "nim-cache", Endpoint: "http://minio.minio:9000"}
+			Expect(client.Update(context.TODO(), sharedNIMCache)).To(Succeed())
+			Expect(client.Create(context.TODO(), &appsv1alpha1.NIMCacheGrant{
+				ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "models"},
+				Spec: appsv1alpha1.NIMCacheGrantSpec{
+					From: []appsv1alpha1.NIMCacheGrantFrom{{Namespace: nimService.Namespace}},
+					To:   []appsv1alpha1.NIMCacheGrantTo{{Name: sharedNIMCache.Name}},
+				},
+			})).To(Succeed())
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj := &appsv1alpha1.NIMService{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, obj)).To(Succeed())
+			Expect(obj.Status.State).To(Equal(appsv1alpha1.NIMServiceStatusFailed))
+			failedCondition := meta.FindStatusCondition(obj.Status.Conditions, conditions.Failed)
+			Expect(failedCondition).NotTo(BeNil())
+			Expect(failedCondition.Reason).To(Equal(conditions.ReasonNIMCacheObjectStoreNotShared))
+			err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should mount a mirror of the NIMCache volume when granted", func() {
+			Expect(client.Create(context.TODO(), &appsv1alpha1.NIMCacheGrant{
+				ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "models"},
+				Spec: appsv1alpha1.NIMCacheGrantSpec{
+					From: []appsv1alpha1.NIMCacheGrantFrom{{Namespace: nimService.Namespace}},
+					To:   []appsv1alpha1.NIMCacheGrantTo{{Name: sharedNIMCache.Name}},
+				},
+			})).To(Succeed())
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			pv := &corev1.PersistentVolume{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimService.GetSharedNIMCachePVName("pv-shared")}, pv)).To(Succeed())
+			Expect(pv.Spec.NFS).NotTo(BeNil())
+			Expect(pv.Spec.PersistentVolumeReclaimPolicy).To(Equal(corev1.PersistentVolumeReclaimRetain))
+
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: nimService.Name, Namespace: nimService.Namespace}, deployment)).To(Succeed())
+			podSpec := deployment.Spec.Template.Spec
+			Expect(podSpec.Volumes).To(ContainElement(corev1.Volume{Name: "model-store", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: nimService.GetSharedNIMCachePVCName("pv-shared")}}}))
+			Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "model-store", MountPath: "/model-store", SubPath: "llama"}))
+
+			Expect(reconciler.cleanupNIMService(context.TODO(), nimService)).To(Succeed())
+			err = client.Get(context.TODO(), types.NamespacedName{Name: nimService.GetSharedNIMCachePVName("pv-shared")}, pv)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should delete the mirror of the NIMCache volume once the grant is revoked", func() {
+			grant := &appsv1alpha1.NIMCacheGrant{
+				ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "models"},
+				Spec: appsv1alpha1.NIMCacheGrantSpec{
+					From: []appsv1alpha1.NIMCacheGrantFrom{{Namespace: nimService.Namespace}},
+					To:   []appsv1alpha1.NIMCacheGrantTo{{Name: sharedNIMCache.Name}},
+				},
+			}
+			Expect(client.Create(context.TODO(), grant)).To(Succeed())
+			Expect(client.Create(context.TODO(), nimService)).To(Succeed())
+			_, err := reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+			pvKey := types.NamespacedName{Name: nimService.GetSharedNIMCachePVName("pv-shared")}
+			Expect(client.Get(context.TODO(), pvKey, &corev1.PersistentVolume{})).To(Succeed())
+
+			Expect(client.Delete(context.TODO(), grant)).To(Succeed())
+			_, err = reconciler.reconcileNIMService(context.TODO(), nimService)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.Get(context.TODO(), pvKey, &corev1.PersistentVolume{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			pvcKey := types.NamespacedName{Name: nimService.GetSharedNIMCachePVCName("pv-shared"), Namespace: nimService.Namespace}
+			err = client.Get(context.TODO(), pvcKey, &corev1.PersistentVolumeClaim{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
 })
@@ -0,0 +1,345 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/conditions"
+	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
+	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+)
+
+// reconcileRollout reconciles the primary and candidate deployments of a NIMService using a canary or
+// blue/green rollout strategy. The primary deployment always serves the stable revision recorded in
+// the rollout status, while a new image or NIMCache profile is deployed as a separate candidate deployment.
+// The candidate is promoted once it is ready and serving its model, either automatically or on request.
+//
+// It returns the readiness of the primary deployment and whether the candidate needs to be re-checked.
+func (r *NIMServiceReconciler) reconcileRollout(ctx context.Context, nimService *appsv1alpha1.NIMService, nimCache *appsv1alpha1.NIMCache,
+	modelPVC *appsv1alpha1.PersistentVolumeClaim, modelProfile string, namedDraResources []shared.NamedDRAResource, loraAdapters []shared.NamedLoRAAdapter) (string, bool, bool, error) {
+	logger := log.FromContext(ctx)
+
+	desired := &appsv1alpha1.NIMServiceRevision{
+		Image:   nimService.GetImage(),
+		Profile: modelProfile,
+	}
+
+	rollout := nimService.Status.Rollout
+	if rollout == nil {
+		rollout = &appsv1alpha1.NIMServiceRolloutStatus{}
+		nimService.Status.Rollout = rollout
+	}
+	rollout.Strategy = nimService.GetRolloutStrategy()
+	if rollout.StableRevision == nil {
+		// First deployment, there is nothing to roll out yet.
+		rollout.StableRevision = desired
+		rollout.Phase = appsv1alpha1.RolloutPhaseStable
+	}
+
+	primaryName := types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}
+	candidateName := types.NamespacedName{Name: nimService.GetCandidateName(), Namespace: nimService.GetNamespace()}
+
+	if *rollout.StableRevision == *desired {
+		// Sync the primary deployment to the desired revision.
+		if err := r.syncRolloutDeployment(ctx, nimService, nimCache, modelPVC, namedDraResources, loraAdapters, desired, primaryName.Name, nimService.GetReplicas()); err != nil {
+			return "", false, false, err
+		}
+		msg, ready, err := r.isDeploymentReady(ctx, &primaryName)
+		if err != nil {
+			return "", false, false, err
+		}
+
+		// Keep serving from the candidate until the promoted primary deployment is ready.
+		if rollout.Phase == appsv1alpha1.RolloutPhasePromoting && rollout.CandidateRevision != nil && (!ready || !r.isDeploymentObserved(ctx, primaryName)) {
+			rollout.Message = msg
+			return msg, true, false, r.syncRolloutService(ctx, nimService)
+		}
+
+		if rollout.CandidateRevision != nil {
+			logger.Info("Rollout completed", "nimservice", nimService.GetName(), "revision", desired)
+			r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, string(appsv1alpha1.RolloutPhaseStable),
+				"NIMService %s rollout completed, image: %s, profile: %s", nimService.GetName(), desired.Image, desired.Profile)
+		}
+		rollout.Phase = appsv1alpha1.RolloutPhaseStable
+		rollout.CandidateRevision = nil
+		rollout.CandidateReadyReplicas = 0
+		rollout.CandidateModel = ""
+		rollout.Message = ""
+		if err := r.syncRolloutService(ctx, nimService); err != nil {
+			return "", false, false, err
+		}
+		if err := r.deleteCandidate(ctx, candidateName); err != nil {
+			return "", false, false, err
+		}
+		return msg, ready, false, nil
+	}
+
+	// A new revision is requested, (re)start the rollout of the candidate.
+	if rollout.CandidateRevision == nil || *rollout.CandidateRevision != *desired {
+		logger.Info("Starting rollout", "nimservice", nimService.GetName(), "strategy", rollout.Strategy, "stable", rollout.StableRevision, "candidate", desired)
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, string(appsv1alpha1.RolloutPhaseProgressing),
+			"NIMService %s rolling out image: %s, profile: %s", nimService.GetName(), desired.Image, desired.Profile)
+		rollout.CandidateRevision = desired
+		rollout.CandidateModel = ""
+		rollout.Phase = appsv1alpha1.RolloutPhaseProgressing
+	}
+
+	primaryReplicas, candidateReplicas := r.getRolloutReplicas(ctx, nimService)
+	if err := r.syncRolloutDeployment(ctx, nimService, nimCache, modelPVC, namedDraResources, loraAdapters, rollout.StableRevision, primaryName.Name, primaryReplicas); err != nil {
+		return "", false, false, err
+	}
+	if err := r.syncRolloutDeployment(ctx, nimService, nimCache, modelPVC, namedDraResources, loraAdapters, desired, candidateName.Name, candidateReplicas); err != nil {
+		return "", false, false, err
+	}
+	if err := r.syncCandidateService(ctx, nimService); err != nil {
+		return "", false, false, err
+	}
+
+	msg, ready, err := r.isDeploymentReady(ctx, &primaryName)
+	if err != nil {
+		return "", false, false, err
+	}
+
+	candidateMsg, candidateReady, err := r.isDeploymentReady(ctx, &candidateName)
+	if err != nil {
+		return "", false, false, err
+	}
+	candidate := &appsv1.Deployment{}
+	if err := r.Get(ctx, candidateName, candidate); err == nil {
+		rollout.CandidateReadyReplicas = candidate.Status.ReadyReplicas
+		if cond := getDeploymentCondition(candidate.Status, appsv1.DeploymentProgressing); cond != nil && cond.Reason == "ProgressDeadlineExceeded" {
+			rollout.Phase = appsv1alpha1.RolloutPhaseFailed
+		}
+	} else if !k8serrors.IsNotFound(err) {
+		return "", false, false, err
+	}
+
+	switch {
+	case rollout.Phase == appsv1alpha1.RolloutPhaseFailed:
+		rollout.Message = candidateMsg
+		r.GetEventRecorder().Eventf(nimService, corev1.EventTypeWarning, string(appsv1alpha1.RolloutPhaseFailed),
+			"NIMService %s rollout failed, msg: %s", nimService.GetName(), candidateMsg)
+	case !candidateReady:
+		rollout.Phase = appsv1alpha1.RolloutPhaseProgressing
+		rollout.Message = candidateMsg
+	default:
+		// Gate promotion on the candidate serving its model.
+		modelName, err := r.getCandidateModelName(ctx, nimService)
+		if err != nil {
+			rollout.Phase = appsv1alpha1.RolloutPhaseProgressing
+			rollout.Message = fmt.Sprintf("candidate %q is ready but not serving models: %v", candidateName.Name, err)
+			logger.Info("WARN: Candidate model check failed, will retry in 5 seconds", "error", err.Error())
+			return msg, ready, true, r.syncRolloutService(ctx, nimService)
+		}
+		rollout.CandidateModel = modelName
+
+		if nimService.IsRolloutAutoPromoteEnabled() || nimService.IsRolloutPromoteRequested() {
+			if err := r.promoteCandidate(ctx, nimService); err != nil {
+				return "", false, false, err
+			}
+			// Keep reporting ready while traffic moves over to the promoted revision.
+			return msg, true, false, nil
+		}
+		rollout.Phase = appsv1alpha1.RolloutPhasePaused
+		rollout.Message = fmt.Sprintf("candidate %q is ready, annotate with %s=true to promote", candidateName.Name, appsv1alpha1.NIMServiceRolloutPromoteAnnotation)
+	}
+
+	return msg, ready, false, r.syncRolloutService(ctx, nimService)
+}
+
+// promoteCandidate makes the candidate revision the stable revision and moves traffic over to it.
+func (r *NIMServiceReconciler) promoteCandidate(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	logger := log.FromContext(ctx)
+	rollout := nimService.Status.Rollout
+
+	rollout.StableRevision = rollout.CandidateRevision.DeepCopy()
+	rollout.Phase = appsv1alpha1.RolloutPhasePromoting
+	rollout.Message = ""
+	logger.Info("Promoting candidate revision", "nimservice", nimService.GetName(), "revision", rollout.StableRevision)
+	r.GetEventRecorder().Eventf(nimService, corev1.EventTypeNormal, string(appsv1alpha1.RolloutPhasePromoting),
+		"NIMService %s promoting image: %s, profile: %s", nimService.GetName(), rollout.StableRevision.Image, rollout.StableRevision.Profile)
+
+	if err := r.syncRolloutService(ctx, nimService); err != nil {
+		return err
+	}
+
+	// Consume the promote request.
+	if nimService.IsRolloutPromoteRequested() {
+		obj := &appsv1alpha1.NIMService{}
+		if err := r.Get(ctx, types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}, obj); err != nil {
+			return err
+		}
+		delete(obj.Annotations, appsv1alpha1.NIMServiceRolloutPromoteAnnotation)
+		if err := r.Update(ctx, obj); err != nil {
+			return err
+		}
+		delete(nimService.Annotations, appsv1alpha1.NIMServiceRolloutPromoteAnnotation)
+	}
+
+	// Persist the promotion before the primary deployment is rolled forward.
+	return r.updater.SetConditionsReady(ctx, nimService, conditions.Ready, string(rollout.Phase))
+}
+
+// getRolloutReplicas returns the replicas of the primary and the candidate deployment during a rollout.
+// A canary splits the replicas by weight, a blue/green candidate runs at full size.
+// Primary replicas are left to the HPA when autoscaling is enabled.
+func (r *NIMServiceReconciler) getRolloutReplicas(ctx context.Context, nimService *appsv1alpha1.NIMService) (int, int) {
+	replicas := nimService.Spec.Replicas
+	if nimService.IsAutoScalingEnabled() {
+		primary := &appsv1.Deployment{}
+		if err := r.Get(ctx, types.NamespacedName{Name: nimService.GetName(), Namespace: nimService.GetNamespace()}, primary); err == nil && primary.Spec.Replicas != nil {
+			replicas = int(*primary.Spec.Replicas)
+		}
+	}
+	if replicas < 1 {
+		replicas = 1
+	}
+
+	if nimService.GetRolloutStrategy() == appsv1alpha1.RolloutStrategyBlueGreen {
+		return nimService.GetReplicas(), replicas
+	}
+
+	weight := int(nimService.GetCanaryWeight())
+	candidate := max(1, (replicas*weight+99)/100)
+	if nimService.IsAutoScalingEnabled() {
+		return 0, candidate
+	}
+	return max(1, replicas-candidate), candidate
+}
+
+// syncRolloutDeployment renders and syncs a deployment serving the given revision.
+func (r *NIMServiceReconciler) syncRolloutDeployment(ctx context.Context, nimService *appsv1alpha1.NIMService, nimCache *appsv1alpha1.NIMCache,
+	modelPVC *appsv1alpha1.PersistentVolumeClaim, namedDraResources []shared.NamedDRAResource, loraAdapters []shared.NamedLoRAAdapter,
+	revision *appsv1alpha1.NIMServiceRevision, name string, replicas int) error {
+	profileEnv, gpuResources, err := r.getProfileEnvAndResources(ctx, nimService, nimCache, revision.Profile)
+	if err != nil {
+		return err
+	}
+
+	params := r.getDeploymentParams(nimService, nimCache, modelPVC, namedDraResources, loraAdapters, profileEnv, gpuResources)
+	params.Name = name
+	params.Image = revision.Image
+	params.Replicas = replicas
+	if revision.Profile == "" {
+		params.Env = removeEnvVar(params.Env, "NIM_MODEL_PROFILE")
+	}
+	// Re-sync the deployment whenever the revision or replicas it serves change.
+	params.Annotations[utils.NvidiaAnnotationParentSpecHashKey] = utils.DeepHashObject([]any{nimService.Spec, revision, replicas})
+
+	initContainers := nimService.GetInitContainers()
+	for i := range initContainers {
+		initContainers[i].Image = revision.Image
+	}
+	initContainers = append(initContainers, shared.GetObjectStoreInitContainers(nimService, nimCache, *modelPVC)...)
+	initContainers = append(initContainers, shared.GetLoRAInitContainers(nimService, loraAdapters)...)
+
+	renderer := r.GetRenderer()
+	return r.renderAndSyncResource(ctx, nimService, &renderer, &appsv1.Deployment{},
+		r.getDeploymentRenderFunc(params, initContainers, namedDraResources), "Deployment", conditions.ReasonDeploymentFailed)
+}
+
+// syncRolloutService re-syncs the NIMService service with the selector of the current rollout phase.
+func (r *NIMServiceReconciler) syncRolloutService(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	renderer := r.GetRenderer()
+	return r.renderAndSyncResource(ctx, nimService, &renderer, &corev1.Service{}, func() (client.Object, error) {
+		return renderer.Service(nimService.GetServiceParams())
+	}, "service", conditions.ReasonServiceFailed)
+}
+
+// syncCandidateService syncs a cluster-internal service selecting only the candidate deployment.
+// It is used to verify the candidate and to preview a blue/green revision before promotion.
+func (r *NIMServiceReconciler) syncCandidateService(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	renderer := r.GetRenderer()
+	return r.renderAndSyncResource(ctx, nimService, &renderer, &corev1.Service{}, func() (client.Object, error) {
+		return renderer.Service(&rendertypes.ServiceParams{
+			Name:           nimService.GetCandidateName(),
+			Namespace:      nimService.GetNamespace(),
+			Labels:         nimService.GetServiceLabels(),
+			Annotations:    nimService.GetNIMServiceAnnotations(),
+			SelectorLabels: map[string]string{"app": nimService.GetCandidateName()},
+			Type:           string(corev1.ServiceTypeClusterIP),
+			Ports: []corev1.ServicePort{
+				{
+					Name:       appsv1alpha1.DefaultNamedPortAPI,
+					Port:       nimService.GetServicePort(),
+					TargetPort: intstr.FromString(appsv1alpha1.DefaultNamedPortAPI),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		})
+	}, "service", conditions.ReasonServiceFailed)
+}
+
+// getCandidateModelName checks the /v1/models endpoint of the candidate and returns the served model name.
+func (r *NIMServiceReconciler) getCandidateModelName(ctx context.Context, nimService *appsv1alpha1.NIMService) (string, error) {
+	svc := &corev1.Service{}
+	if err := r.Get(ctx, types.NamespacedName{Name: nimService.GetCandidateName(), Namespace: nimService.GetNamespace()}, svc); err != nil {
+		return "", err
+	}
+	return r.getNIMModelName(ctx, utils.FormatEndpoint(svc.Spec.ClusterIP, nimService.GetServicePort()))
+}
+
+// cleanupRollout removes the candidate objects and the rollout status when no progressive rollout is configured.
+func (r *NIMServiceReconciler) cleanupRollout(ctx context.Context, nimService *appsv1alpha1.NIMService) error {
+	if nimService.Status.Rollout == nil {
+		return nil
+	}
+	nimService.Status.Rollout = nil
+	return r.deleteCandidate(ctx, types.NamespacedName{Name: nimService.GetCandidateName(), Namespace: nimService.GetNamespace()})
+}
+
+// isDeploymentObserved returns true if the deployment controller has observed the latest deployment spec.
+func (r *NIMServiceReconciler) isDeploymentObserved(ctx context.Context, namespacedName types.NamespacedName) bool {
+	deployment := &appsv1.Deployment{}
+	if err := r.Get(ctx, namespacedName, deployment); err != nil {
+		return false
+	}
+	return deployment.Status.ObservedGeneration >= deployment.Generation
+}
+
+func (r *NIMServiceReconciler) deleteCandidate(ctx context.Context, namespacedName types.NamespacedName) error {
+	if err := k8sutil.CleanupResource(ctx, r.GetClient(), &appsv1.Deployment{}, namespacedName); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	if err := k8sutil.CleanupResource(ctx, r.GetClient(), &corev1.Service{}, namespacedName); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func removeEnvVar(envVars []corev1.EnvVar, name string) []corev1.EnvVar {
+	result := make([]corev1.EnvVar, 0, len(envVars))
+	for _, env := range envVars {
+		if env.Name != name {
+			result = append(result, env)
+		}
+	}
+	return result
+}
@@ -0,0 +1,369 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelrouter
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/NVIDIA/k8s-nim-operator/internal/nimmodels"
+)
+
+// maxRateLimitedClients bounds the number of client rate limiters kept by the gateway.
+const maxRateLimitedClients = 10000
+
+// errFallback makes the reverse proxy give up on a backend for the next one.
+var errFallback = errors.New("backend unavailable, falling back to the next backend")
+
+// GatewayConfig is the routing config of the gateway, rendered by the NIMGateway controller.
+type GatewayConfig struct {
+	// Routes are the backends serving each model.
+	Routes []GatewayRoute `json:"routes"`
+	// RateLimit limits the requests of each client, if set.
+	RateLimit *GatewayRateLimit `json:"rateLimit,omitempty"`
+}
+
+// GatewayRoute defines the backends serving a model.
+type GatewayRoute struct {
+	// Model is the name of the model.
+	Model string `json:"model"`
+	// Backends are the URLs of the NIMs serving the model, in fallback order.
+	Backends []string `json:"backends"`
+}
+
+// GatewayRateLimit defines the request rate limit of each client.
+type GatewayRateLimit struct {
+	RequestsPerMinute int32 `json:"requestsPerMinute"`
+	Burst             int32 `json:"burst"`
+}
+
+// Gateway is an OpenAI compatible reverse proxy routing the requests to the backends serving the requested model,
+// falling back to the next backend of the model when a backend is unreachable or overloaded.
+// The clients are authenticated by API key and rate limited when configured.
+type Gateway struct {
+	configFile string
+	apiKeysDir string
+	transport  http.RoundTripper
+
+	mu        sync.RWMutex
+	routes    map[string][]*url.URL
+	models    []string
+	apiKeys   [][]byte
+	rateLimit *GatewayRateLimit
+	limiters  map[string]*rate.Limiter
+	ready     bool
+}
+
+// NewGateway returns a gateway loading its config from the given file and, if set,
+// the API keys of its clients from the files of the given directory.
+func NewGateway(configFile, apiKeysDir string) *Gateway {
+	return &Gateway{
+		configFile: configFile,
+		apiKeysDir: apiKeysDir,
+		transport:  http.DefaultTransport,
+		routes:     map[string][]*url.URL{},
+		limiters:   map[string]*rate.Limiter{},
+	}
+}
+
+// Load (re)loads the config and the API keys of the gateway.
+// The previous config is kept when the config or the API keys cannot be loaded.
+func (g *Gateway) Load() error {
+	data, err := os.ReadFile(g.configFile)
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	config := GatewayConfig{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	routes := map[string][]*url.URL{}
+	for _, route := range config.Routes {
+		for _, backendURL := range route.Backends {
+			u, err := url.Parse(backendURL)
+			if err != nil || u.Scheme == "" || u.Host == "" {
+				return fmt.Errorf("invalid backend %q of model %s", backendURL, route.Model)
+			}
+			routes[route.Model] = append(routes[route.Model], u)
+		}
+	}
+	models := make([]string, 0, len(routes))
+	for model := range routes {
+		models = append(models, model)
+	}
+	sort.Strings(models)
+
+	var apiKeys [][]byte
+	if g.apiKeysDir != "" {
+		apiKeys, err = readAPIKeys(g.apiKeysDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.routes = routes
+	g.models = models
+	g.apiKeys = apiKeys
+	if !equalRateLimits(g.rateLimit, config.RateLimit) {
+		g.limiters = map[string]*rate.Limiter{}
+	}
+	g.rateLimit = config.RateLimit
+	g.ready = true
+	return nil
+}
+
+// readAPIKeys reads the API keys of a mounted secret, one API key per file.
+func readAPIKeys(dir string) ([][]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API keys: %w", err)
+	}
+	var apiKeys [][]byte
+	for _, entry := range entries {
+		// Skip the ..data and ..<timestamp> entries of the secret volume
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read API key %s: %w", entry.Name(), err)
+		}
+		if apiKey := bytes.TrimSpace(data); len(apiKey) > 0 {
+			apiKeys = append(apiKeys, apiKey)
+		}
+	}
+	return apiKeys, nil
+}
+
+func equalRateLimits(a, b *GatewayRateLimit) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// Run reloads the config of the gateway at the given interval until the context is done,
+// picking up the updates of the mounted configmap and secret.
+func (g *Gateway) Run(ctx context.Context, interval time.Duration) {
+	logger := log.FromContext(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := g.Load(); err != nil {
+			logger.Info("WARN: Failed to reload config, keeping the previous one", "error", err.Error())
+		}
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch req.URL.Path {
+	case HealthLiveURI:
+		w.WriteHeader(http.StatusOK)
+		return
+	case HealthReadyURI:
+		g.mu.RLock()
+		ready := g.ready
+		g.mu.RUnlock()
+		if !ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	client, ok := g.authenticate(w, req)
+	if !ok {
+		return
+	}
+	if !g.allow(w, client) {
+		return
+	}
+
+	switch {
+	case req.Method == http.MethodGet && req.URL.Path == nimmodels.ModelsV1URI:
+		g.serveModels(w)
+	case req.Method == http.MethodPost:
+		g.serveModelRequest(w, req)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s is not supported by the gateway", req.Method, req.URL.Path))
+	}
+}
+
+// authenticate returns the client of the request, identified by its API key when authentication is enabled
+// or else by its address. False is returned when the client is not authenticated, the error response being written.
+func (g *Gateway) authenticate(w http.ResponseWriter, req *http.Request) (string, bool) {
+	if g.apiKeysDir == "" {
+		host, _, err := net.SplitHostPort(req.RemoteAddr)
+		if err != nil {
+			host = req.RemoteAddr
+		}
+		return host, true
+	}
+
+	apiKey, found := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !found || apiKey == "" {
+		writeError(w, http.StatusUnauthorized, "You didn't provide an API key. Provide your API key in an Authorization header using Bearer auth.")
+		return "", false
+	}
+	g.mu.RLock()
+	apiKeys := g.apiKeys
+	g.mu.RUnlock()
+	for _, key := range apiKeys {
+		if subtle.ConstantTimeCompare([]byte(apiKey), key) == 1 {
+			return apiKey, true
+		}
+	}
+	writeError(w, http.StatusUnauthorized, "Incorrect API key provided.")
+	return "", false
+}
+
+// allow returns true if the request of the client is within its rate limit, else the error response is written.
+func (g *Gateway) allow(w http.ResponseWriter, client string) bool {
+	g.mu.Lock()
+	rateLimit := g.rateLimit
+	if rateLimit == nil {
+		g.mu.Unlock()
+		return true
+	}
+	limiter, ok := g.limiters[client]
+	if !ok {
+		if len(g.limiters) >= maxRateLimitedClients {
+			g.limiters = map[string]*rate.Limiter{}
+		}
+		limiter = rate.NewLimiter(rate.Limit(float64(rateLimit.RequestsPerMinute)/60), int(rateLimit.Burst))
+		g.limiters[client] = limiter
+	}
+	g.mu.Unlock()
+
+	reservation := limiter.Reserve()
+	if delay := reservation.Delay(); delay > 0 {
+		reservation.Cancel()
+		w.Header().Set("Retry-After", fmt.Sprintf("%d", int(math.Ceil(delay.Seconds()))))
+		writeError(w, http.StatusTooManyRequests, "Rate limit reached for requests.")
+		return false
+	}
+	return true
+}
+
+// serveModels lists the models routed by the gateway.
+func (g *Gateway) serveModels(w http.ResponseWriter) {
+	modelsList := nimmodels.ModelsV1List{Object: nimmodels.ObjectTypeList, Data: []nimmodels.ModelsV1Info{}}
+	g.mu.RLock()
+	for _, model := range g.models {
+		modelsList.Data = append(modelsList.Data, nimmodels.ModelsV1Info{Id: model, Object: nimmodels.ObjectTypeModel})
+	}
+	g.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(modelsList)
+}
+
+// serveModelRequest proxies the request to the backends serving the model of its JSON body, in fallback order.
+func (g *Gateway) serveModelRequest(w http.ResponseWriter, req *http.Request) {
+	body, model, ok := readModelRequest(w, req)
+	if !ok {
+		return
+	}
+	if model == "" {
+		writeError(w, http.StatusBadRequest, "You must provide a model parameter.")
+		return
+	}
+
+	g.mu.RLock()
+	backends := g.routes[model]
+	g.mu.RUnlock()
+	if len(backends) == 0 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The model `%s` does not exist.", model))
+		return
+	}
+
+	logger := log.FromContext(req.Context())
+	for i, backend := range backends {
+		last := i == len(backends)-1
+		fallback := false
+		proxy := &httputil.ReverseProxy{
+			Rewrite: func(pr *httputil.ProxyRequest) {
+				pr.SetURL(backend)
+				pr.SetXForwarded()
+				// The API key of the client is for the gateway only
+				pr.Out.Header.Del("Authorization")
+			},
+			Transport: g.transport,
+			// Flush streamed completions immediately.
+			FlushInterval: -1,
+			ModifyResponse: func(resp *http.Response) error {
+				if !last && isFallbackStatus(resp.StatusCode) {
+					return errFallback
+				}
+				return nil
+			},
+			ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+				if !last && req.Context().Err() == nil {
+					logger.V(2).Info("Falling back to the next backend", "model", model, "backend", backend.String(), "error", err.Error())
+					fallback = true
+					return
+				}
+				writeError(w, http.StatusBadGateway, fmt.Sprintf("The model `%s` is unavailable.", model))
+			},
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+		proxy.ServeHTTP(w, req)
+		if !fallback {
+			return
+		}
+	}
+}
+
+// isFallbackStatus returns true if the backend response status asks to try another backend.
+func isFallbackStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
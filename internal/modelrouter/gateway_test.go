@@ -0,0 +1,170 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelrouter
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/k8s-nim-operator/internal/nimmodels"
+)
+
+// newGateway returns a gateway loaded with the given config and API keys.
+func newGateway(t *testing.T, config GatewayConfig, apiKeys map[string]string) *Gateway {
+	t.Helper()
+	dir := t.TempDir()
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to encode config: %v", err)
+	}
+	configFile := filepath.Join(dir, "gateway.json")
+	if err := os.WriteFile(configFile, data, 0o600); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	apiKeysDir := ""
+	if apiKeys != nil {
+		apiKeysDir = filepath.Join(dir, "api-keys")
+		if err := os.Mkdir(apiKeysDir, 0o700); err != nil {
+			t.Fatalf("failed to create API keys dir: %v", err)
+		}
+		for name, apiKey := range apiKeys {
+			if err := os.WriteFile(filepath.Join(apiKeysDir, name), []byte(apiKey), 0o600); err != nil {
+				t.Fatalf("failed to write API key: %v", err)
+			}
+		}
+	}
+
+	gateway := NewGateway(configFile, apiKeysDir)
+	if err := gateway.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return gateway
+}
+
+func serveCompletion(gateway *Gateway, model, apiKey string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader(`{"model":"`+model+`"}`))
+	if apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+	recorder := httptest.NewRecorder()
+	gateway.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestGatewayFallback(t *testing.T) {
+	overloaded := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(overloaded.Close)
+	llama := newNIMServer(t, "meta/llama-3.1-8b-instruct")
+	mistral := newNIMServer(t, "mistralai/mistral-7b-instruct-v0.3")
+
+	gateway := newGateway(t, GatewayConfig{Routes: []GatewayRoute{
+		{Model: "meta/llama-3.1-8b-instruct", Backends: []string{overloaded.URL, llama.URL}},
+		{Model: "mistralai/mistral-7b-instruct-v0.3", Backends: []string{mistral.URL}},
+	}}, nil)
+
+	recorder := httptest.NewRecorder()
+	gateway.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, nimmodels.ModelsV1URI, nil))
+	var modelsList nimmodels.ModelsV1List
+	if err := json.Unmarshal(recorder.Body.Bytes(), &modelsList); err != nil {
+		t.Fatalf("failed to decode models: %v", err)
+	}
+	if len(modelsList.Data) != 2 || modelsList.Data[0].Id != "meta/llama-3.1-8b-instruct" || modelsList.Data[1].Id != "mistralai/mistral-7b-instruct-v0.3" {
+		t.Errorf("models = %+v, want both models", modelsList.Data)
+	}
+
+	recorder = serveCompletion(gateway, "meta/llama-3.1-8b-instruct", "")
+	if recorder.Code != http.StatusOK || !strings.HasPrefix(recorder.Body.String(), "meta/llama-3.1-8b-instruct:") {
+		t.Errorf("completion = %d %q, want the response of the second backend", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = serveCompletion(gateway, "mistralai/mistral-7b-instruct-v0.3", "")
+	if recorder.Code != http.StatusOK || !strings.HasPrefix(recorder.Body.String(), "mistralai/mistral-7b-instruct-v0.3:") {
+		t.Errorf("completion = %d %q, want the response of the mistral backend", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = serveCompletion(gateway, "unknown", "")
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("unknown model = %d, want %d", recorder.Code, http.StatusNotFound)
+	}
+
+	// The last backend response is returned as is
+	gateway = newGateway(t, GatewayConfig{Routes: []GatewayRoute{
+		{Model: "meta/llama-3.1-8b-instruct", Backends: []string{overloaded.URL}},
+	}}, nil)
+	recorder = serveCompletion(gateway, "meta/llama-3.1-8b-instruct", "")
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("overloaded model = %d, want %d", recorder.Code, http.StatusServiceUnavailable)
+	}
+}
+
+func TestGatewayAuth(t *testing.T) {
+	llama := newNIMServer(t, "meta/llama-3.1-8b-instruct")
+	gateway := newGateway(t, GatewayConfig{Routes: []GatewayRoute{
+		{Model: "meta/llama-3.1-8b-instruct", Backends: []string{llama.URL}},
+	}}, map[string]string{"team-a": "key-a\n"})
+
+	recorder := httptest.NewRecorder()
+	gateway.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, HealthReadyURI, nil))
+	if recorder.Code != http.StatusOK {
+		t.Errorf("ready = %d, want %d", recorder.Code, http.StatusOK)
+	}
+
+	for _, apiKey := range []string{"", "key-b"} {
+		recorder = serveCompletion(gateway, "meta/llama-3.1-8b-instruct", apiKey)
+		if recorder.Code != http.StatusUnauthorized {
+			t.Errorf("completion with API key %q = %d, want %d", apiKey, recorder.Code, http.StatusUnauthorized)
+		}
+	}
+
+	recorder = serveCompletion(gateway, "meta/llama-3.1-8b-instruct", "key-a")
+	if recorder.Code != http.StatusOK {
+		t.Errorf("completion with valid API key = %d, want %d", recorder.Code, http.StatusOK)
+	}
+}
+
+func TestGatewayRateLimit(t *testing.T) {
+	llama := newNIMServer(t, "meta/llama-3.1-8b-instruct")
+	gateway := newGateway(t, GatewayConfig{
+		Routes: []GatewayRoute{
+			{Model: "meta/llama-3.1-8b-instruct", Backends: []string{llama.URL}},
+		},
+		RateLimit: &GatewayRateLimit{RequestsPerMinute: 1, Burst: 2},
+	}, map[string]string{"team-a": "key-a", "team-b": "key-b"})
+
+	for i := 0; i < 2; i++ {
+		if recorder := serveCompletion(gateway, "meta/llama-3.1-8b-instruct", "key-a"); recorder.Code != http.StatusOK {
+			t.Errorf("request %d within burst = %d, want %d", i, recorder.Code, http.StatusOK)
+		}
+	}
+	recorder := serveCompletion(gateway, "meta/llama-3.1-8b-instruct", "key-a")
+	if recorder.Code != http.StatusTooManyRequests || recorder.Header().Get("Retry-After") == "" {
+		t.Errorf("request over burst = %d, want %d with Retry-After", recorder.Code, http.StatusTooManyRequests)
+	}
+
+	// Clients are rate limited independently
+	if recorder := serveCompletion(gateway, "meta/llama-3.1-8b-instruct", "key-b"); recorder.Code != http.StatusOK {
+		t.Errorf("request of another client = %d, want %d", recorder.Code, http.StatusOK)
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelrouter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/NVIDIA/k8s-nim-operator/internal/nimmodels"
+)
+
+const (
+	// HealthLiveURI is the liveness endpoint of the router.
+	HealthLiveURI = "/v1/health/live"
+	// HealthReadyURI is the readiness endpoint of the router, ready once the models of all the backends are discovered.
+	HealthReadyURI = "/v1/health/ready"
+
+	// maxRequestBodySize bounds the request bodies buffered to read the requested model.
+	maxRequestBodySize = 32 << 20
+)
+
+// backend is a NIM endpoint served by the router.
+type backend struct {
+	url    *url.URL
+	proxy  *httputil.ReverseProxy
+	models []nimmodels.ModelsV1Info
+}
+
+// Router is an OpenAI compatible reverse proxy routing the requests to the backend serving the requested model.
+// Requests without a model are routed to the first backend.
+type Router struct {
+	backends []*backend
+
+	mu     sync.RWMutex
+	routes map[string]*backend
+	ready  bool
+}
+
+// NewRouter returns a router for the given backend URLs, e.g. http://127.0.0.1:8100.
+func NewRouter(backendURLs []string) (*Router, error) {
+	if len(backendURLs) == 0 {
+		return nil, fmt.Errorf("at least one backend is required")
+	}
+	router := &Router{routes: map[string]*backend{}}
+	for _, backendURL := range backendURLs {
+		u, err := url.Parse(backendURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid backend %q: %w", backendURL, err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid backend %q: scheme and host are required", backendURL)
+		}
+		proxy := httputil.NewSingleHostReverseProxy(u)
+		// Flush streamed completions immediately.
+		proxy.FlushInterval = -1
+		router.backends = append(router.backends, &backend{url: u, proxy: proxy})
+	}
+	return router, nil
+}
+
+// Refresh discovers the models served by each backend from its /v1/models endpoint.
+// The models of a backend that cannot be listed are kept from the previous refresh.
+func (r *Router) Refresh(ctx context.Context) error {
+	var errs []error
+	models := make([][]nimmodels.ModelsV1Info, len(r.backends))
+	for i, b := range r.backends {
+		modelsList, err := nimmodels.ListModelsV1(ctx, b.url.Host, b.url.Scheme)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list models of backend %s: %w", b.url, err))
+			continue
+		}
+		models[i] = modelsList.Data
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	routes := map[string]*backend{}
+	for i, b := range r.backends {
+		if models[i] != nil {
+			b.models = models[i]
+		}
+		for _, model := range b.models {
+			// The first backend serving a model takes its requests.
+			if _, ok := routes[model.Id]; !ok {
+				routes[model.Id] = b
+			}
+		}
+	}
+	r.routes = routes
+	r.ready = len(errs) == 0
+	return errors.Join(errs...)
+}
+
+// Run refreshes the models of the backends at the given interval until the context is done.
+func (r *Router) Run(ctx context.Context, interval time.Duration) {
+	logger := log.FromContext(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := r.Refresh(ctx); err != nil {
+			logger.Info("WARN: Model discovery failed, will retry", "error", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch {
+	case req.URL.Path == HealthLiveURI:
+		w.WriteHeader(http.StatusOK)
+	case req.URL.Path == HealthReadyURI:
+		r.mu.RLock()
+		ready := r.ready
+		r.mu.RUnlock()
+		if !ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	case req.Method == http.MethodGet && req.URL.Path == nimmodels.ModelsV1URI:
+		r.serveModels(w)
+	case req.Method == http.MethodPost:
+		r.serveModelRequest(w, req)
+	default:
+		r.backends[0].proxy.ServeHTTP(w, req)
+	}
+}
+
+// serveModels lists the models of all the backends.
+func (r *Router) serveModels(w http.ResponseWriter) {
+	modelsList := nimmodels.ModelsV1List{Object: nimmodels.ObjectTypeList, Data: []nimmodels.ModelsV1Info{}}
+	r.mu.RLock()
+	for _, b := range r.backends {
+		modelsList.Data = append(modelsList.Data, b.models...)
+	}
+	r.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(modelsList)
+}
+
+// serveModelRequest proxies the request to the backend serving the model of its JSON body.
+func (r *Router) serveModelRequest(w http.ResponseWriter, req *http.Request) {
+	body, model, ok := readModelRequest(w, req)
+	if !ok {
+		return
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	// Requests without a JSON body or a model go to the first backend.
+	if model == "" {
+		r.backends[0].proxy.ServeHTTP(w, req)
+		return
+	}
+
+	r.mu.RLock()
+	b, ok := r.routes[model]
+	r.mu.RUnlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The model `%s` does not exist.", model))
+		return
+	}
+	b.proxy.ServeHTTP(w, req)
+}
+
+// readModelRequest reads the request body and the requested model, if any.
+// False is returned when the body cannot be read, the error response being written.
+func readModelRequest(w http.ResponseWriter, req *http.Request) ([]byte, string, bool) {
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxRequestBodySize+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("failed to read request body: %v", err))
+		return nil, "", false
+	}
+	if len(body) > maxRequestBodySize {
+		writeError(w, http.StatusRequestEntityTooLarge, "request body is too large")
+		return nil, "", false
+	}
+
+	var modelRequest struct {
+		Model string `json:"model"`
+	}
+	_ = json.Unmarshal(body, &modelRequest)
+	return body, modelRequest.Model, true
+}
+
+// writeError writes an OpenAI compatible error response.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"object":  "error",
+		"message": message,
+		"code":    statusCode,
+	})
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelrouter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/k8s-nim-operator/internal/nimmodels"
+)
+
+// newNIMServer returns a fake NIM serving the given model and echoing the name of the model on completions.
+func newNIMServer(t *testing.T, model string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case nimmodels.ModelsV1URI:
+			_ = json.NewEncoder(w).Encode(nimmodels.ModelsV1List{
+				Object: nimmodels.ObjectTypeList,
+				Data:   []nimmodels.ModelsV1Info{{Id: model, Object: nimmodels.ObjectTypeModel, Root: &model}},
+			})
+		default:
+			body, _ := io.ReadAll(req.Body)
+			_, _ = w.Write([]byte(model + ":" + string(body)))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRouter(t *testing.T) {
+	llama := newNIMServer(t, "meta/llama-3.1-8b-instruct")
+	mistral := newNIMServer(t, "mistralai/mistral-7b-instruct-v0.3")
+
+	router, err := NewRouter([]string{llama.URL, mistral.URL})
+	if err != nil {
+		t.Fatalf("NewRouter() error = %v", err)
+	}
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, HealthReadyURI, nil))
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("ready before refresh = %d, want %d", recorder.Code, http.StatusServiceUnavailable)
+	}
+
+	if err := router.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, HealthReadyURI, nil))
+	if recorder.Code != http.StatusOK {
+		t.Errorf("ready after refresh = %d, want %d", recorder.Code, http.StatusOK)
+	}
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, nimmodels.ModelsV1URI, nil))
+	var modelsList nimmodels.ModelsV1List
+	if err := json.Unmarshal(recorder.Body.Bytes(), &modelsList); err != nil {
+		t.Fatalf("failed to decode models: %v", err)
+	}
+	if len(modelsList.Data) != 2 || modelsList.Data[0].Id != "meta/llama-3.1-8b-instruct" || modelsList.Data[1].Id != "mistralai/mistral-7b-instruct-v0.3" {
+		t.Errorf("models = %+v, want the models of both backends in order", modelsList.Data)
+	}
+
+	tests := []struct {
+		name       string
+		body       string
+		wantCode   int
+		wantPrefix string
+	}{
+		{name: "first model", body: `{"model":"meta/llama-3.1-8b-instruct"}`, wantCode: http.StatusOK, wantPrefix: "meta/llama-3.1-8b-instruct:"},
+		{name: "second model", body: `{"model":"mistralai/mistral-7b-instruct-v0.3"}`, wantCode: http.StatusOK, wantPrefix: "mistralai/mistral-7b-instruct-v0.3:"},
+		{name: "no model", body: `{}`, wantCode: http.StatusOK, wantPrefix: "meta/llama-3.1-8b-instruct:"},
+		{name: "unknown model", body: `{"model":"unknown"}`, wantCode: http.StatusNotFound, wantPrefix: `{"code":404`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader(tt.body)))
+			if recorder.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", recorder.Code, tt.wantCode)
+			}
+			if !strings.HasPrefix(recorder.Body.String(), tt.wantPrefix) {
+				t.Errorf("body = %q, want prefix %q", recorder.Body.String(), tt.wantPrefix)
+			}
+			if tt.wantCode == http.StatusOK && !strings.HasSuffix(recorder.Body.String(), tt.body) {
+				t.Errorf("body = %q, want the request body forwarded", recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestNewRouterInvalidBackends(t *testing.T) {
+	for _, backends := range [][]string{nil, {"127.0.0.1:8100"}, {"http://"}} {
+		if _, err := NewRouter(backends); err == nil {
+			t.Errorf("NewRouter(%v) did not fail", backends)
+		}
+	}
+}
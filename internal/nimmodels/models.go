@@ -17,6 +17,7 @@ limitations under the License.
 package nimmodels
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -140,3 +141,52 @@ func GetMetadataV1(ctx context.Context, nimServiceEndpoint string, scheme string
 
 	return &info, nil
 }
+
+// InvokeV1 sends a request with an optional JSON body to a NIM service endpoint and returns the response status code.
+// Unlike the other API helpers, a non-OK response is not an error so that the caller can check the expected status code.
+func InvokeV1(ctx context.Context, nimServiceEndpoint string, scheme string, method string, uri string, body []byte, timeout time.Duration) (int, error) {
+	logger := log.FromContext(ctx)
+
+	url := getURL(nimServiceEndpoint, uri, scheme)
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	if len(body) > 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := http.Client{
+		Timeout: timeout,
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		logger.Error(err, "Request failed", "method", method, "url", url)
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		logger.Error(err, "Failed to read response", "url", url)
+		return 0, err
+	}
+	logger.V(4).Info("DEBUG: API response", "endpoint", url, "status", resp.StatusCode, "body", string(respBody))
+
+	return resp.StatusCode, nil
+}
+
+// GetLoRAAdapterIDs returns the ids of the LoRA adapters in the models list.
+// NIM lists each loaded adapter as a model with the base model as its root.
+func GetLoRAAdapterIDs(modelsList *ModelsV1List) []string {
+	var adapters []string
+	for _, model := range modelsList.Data {
+		if model.Object != ObjectTypeModel || model.Root == nil {
+			continue
+		}
+		if *model.Root != model.Id {
+			adapters = append(adapters, model.Id)
+		}
+	}
+	return adapters
+}
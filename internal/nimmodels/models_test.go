@@ -0,0 +1,112 @@
+package nimmodels
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestGetLoRAAdapterIDs(t *testing.T) {
+	tests := []struct {
+		description string
+		models      []ModelsV1Info
+		expected    []string
+	}{
+		{
+			description: "base model only",
+			models: []ModelsV1Info{
+				{Id: "meta/llama3-8b-instruct", Object: ObjectTypeModel, Root: ptr.To("meta/llama3-8b-instruct")},
+			},
+			expected: nil,
+		},
+		{
+			description: "base model with adapters",
+			models: []ModelsV1Info{
+				{Id: "llama3-8b-math", Object: ObjectTypeModel, Root: ptr.To("meta/llama3-8b-instruct")},
+				{Id: "meta/llama3-8b-instruct", Object: ObjectTypeModel, Root: ptr.To("meta/llama3-8b-instruct")},
+				{Id: "llama3-8b-squad", Object: ObjectTypeModel, Root: ptr.To("meta/llama3-8b-instruct")},
+			},
+			expected: []string{"llama3-8b-math", "llama3-8b-squad"},
+		},
+		{
+			description: "models without root",
+			models: []ModelsV1Info{
+				{Id: "dummy-model", Object: ObjectTypeModel},
+				{Id: "dummy-adapter", Object: "dummy", Root: ptr.To("dummy-model")},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			adapters := GetLoRAAdapterIDs(&ModelsV1List{Object: ObjectTypeList, Data: test.models})
+			if !reflect.DeepEqual(adapters, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, adapters)
+			}
+		})
+	}
+}
+
+func TestInvokeV1(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch {
+		case r.URL.Path == "/v1/embeddings" && r.Method == http.MethodPost && r.Header.Get("Content-Type") == "application/json" && len(body) > 0:
+			w.WriteHeader(http.StatusOK)
+		case r.URL.Path == "/v1/health/ready" && r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+	endpoint := strings.TrimPrefix(server.URL, "http://")
+
+	tests := []struct {
+		description string
+		method      string
+		uri         string
+		body        []byte
+		expected    int
+	}{
+		{
+			description: "post with body",
+			method:      http.MethodPost,
+			uri:         "/v1/embeddings",
+			body:        []byte(`{"input": ["hello"]}`),
+			expected:    http.StatusOK,
+		},
+		{
+			description: "get without body",
+			method:      http.MethodGet,
+			uri:         "/v1/health/ready",
+			expected:    http.StatusOK,
+		},
+		{
+			description: "non-OK response is returned without error",
+			method:      http.MethodPost,
+			uri:         "/v1/embeddings",
+			expected:    http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			statusCode, err := InvokeV1(context.TODO(), endpoint, "http", test.method, test.uri, test.body, 5*time.Second)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if statusCode != test.expected {
+				t.Errorf("expected %d, got %d", test.expected, statusCode)
+			}
+		})
+	}
+}
@@ -40,4 +40,6 @@ type NIMManifestInterface interface {
 	GetProfileModel(profileID string) string
 	GetProfileTags(profileID string) map[string]string
 	GetProfileRelease(profileID string) string
+	GetProfileSources(profileID string) []string
+	GetProfileFiles(profileID string) map[string][]string
 }
@@ -90,6 +90,11 @@ func (s *Src) UnmarshalYAML(unmarshal func(interface{}) error) error {
 						s.Files = append(s.Files, File{Name: fileName})
 					}
 				}
+			} else if fileMap, ok := file.(map[string]interface{}); ok {
+				// Files of a manifest serialized from this struct
+				if fileName, ok := fileMap["name"].(string); ok {
+					s.Files = append(s.Files, File{Name: fileName})
+				}
 			}
 		}
 	}
@@ -171,6 +176,33 @@ func (manifest NIMManifest) GetProfileRelease(profileID string) string {
 	return manifest[profileID].Release
 }
 
+// GetProfileSources returns the repositories the model files of the profile are downloaded from.
+func (manifest NIMManifest) GetProfileSources(profileID string) []string {
+	sources := []string{}
+	for _, component := range manifest[profileID].Workspace.Components {
+		if component.Src.RepoID != "" && !slices.Contains(sources, component.Src.RepoID) {
+			sources = append(sources, component.Src.RepoID)
+		}
+	}
+	return sources
+}
+
+// GetProfileFiles returns the model files of the profile, by repository they are downloaded from.
+func (manifest NIMManifest) GetProfileFiles(profileID string) map[string][]string {
+	files := map[string][]string{}
+	for _, component := range manifest[profileID].Workspace.Components {
+		if component.Src.RepoID == "" {
+			continue
+		}
+		for _, file := range component.Src.Files {
+			if !slices.Contains(files[component.Src.RepoID], file.Name) {
+				files[component.Src.RepoID] = append(files[component.Src.RepoID], file.Name)
+			}
+		}
+	}
+	return files
+}
+
 func isOptimizedEngine(engine string) bool {
 	return engine != "" && strings.Contains(strings.ToLower(engine), BackendTypeTensorRT)
 }
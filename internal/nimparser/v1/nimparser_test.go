@@ -20,6 +20,7 @@ import (
 	"path/filepath"
 
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 
@@ -47,6 +48,68 @@ var _ = Describe("NIMParser", func() {
 			Expect(profile.Tags["precision"]).To(Equal("fp16"))
 			Expect(profile.ContainerURL).To(Equal("nvcr.io/nim/meta/llama3-70b-instruct:1.0.0"))
 		})
+		It("should list the model sources of a profile", func() {
+			data := []byte(`
+0f3de1afe11d355e01657424a267fbaad19bfea3143a9879307c49aed8299db0:
+  model: meta/llama3-70b-instruct
+  release: '1.0.0'
+  workspace: !workspace
+    components:
+    - dst: ''
+      src:
+        files:
+          - !name 'config.json'
+        repo_id: ngc://nim/meta/llama3-70b-instruct:hf
+    - dst: ''
+      src:
+        files:
+          - !name 'tokenizer.json'
+        repo_id: ngc://nim/meta/llama3-70b-instruct:hf
+    - dst: trtllm_engine
+      src:
+        files:
+          - !name 'rank0.engine'
+        repo_id: ngc://nim/meta/llama3-70b-instruct:0.10.0+a1-l40sx8-fp16
+`)
+			nimparser := NIMParser{}
+			config, err := nimparser.ParseModelManifestFromRawOutput(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.GetProfileSources("0f3de1afe11d355e01657424a267fbaad19bfea3143a9879307c49aed8299db0")).To(Equal([]string{
+				"ngc://nim/meta/llama3-70b-instruct:hf",
+				"ngc://nim/meta/llama3-70b-instruct:0.10.0+a1-l40sx8-fp16",
+			}))
+			Expect(config.GetProfileSources("unknown")).To(BeEmpty())
+		})
+
+		It("should keep the model files of a profile when serialized", func() {
+			data := []byte(`
+0f3de1afe11d355e01657424a267fbaad19bfea3143a9879307c49aed8299db0:
+  model: meta/llama3-70b-instruct
+  release: '1.0.0'
+  workspace: !workspace
+    components:
+    - dst: trtllm_engine
+      src:
+        files:
+          - !name 'config.json'
+          - !name 'rank0.engine'
+        repo_id: ngc://nim/meta/llama3-70b-instruct:0.10.0+a1-l40sx8-fp16
+`)
+			nimparser := NIMParser{}
+			config, err := nimparser.ParseModelManifestFromRawOutput(data)
+			Expect(err).NotTo(HaveOccurred())
+			expected := map[string][]string{
+				"ngc://nim/meta/llama3-70b-instruct:0.10.0+a1-l40sx8-fp16": {"config.json", "rank0.engine"},
+			}
+			Expect(config.GetProfileFiles("0f3de1afe11d355e01657424a267fbaad19bfea3143a9879307c49aed8299db0")).To(Equal(expected))
+
+			// The manifest is stored serialized in a ConfigMap
+			serialized, err := yaml.Marshal(config)
+			Expect(err).NotTo(HaveOccurred())
+			config, err = nimparser.ParseModelManifestFromRawOutput(serialized)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.GetProfileFiles("0f3de1afe11d355e01657424a267fbaad19bfea3143a9879307c49aed8299db0")).To(Equal(expected))
+		})
 		It("should parse a model profile for vllm engine files correctly", func() {
 
 			filePath := filepath.Join("testdata", "manifest_vllm_v1.yaml")
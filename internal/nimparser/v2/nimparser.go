@@ -17,8 +17,10 @@ limitations under the License.
 package v2
 
 import (
+	"net/url"
 	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -145,6 +147,52 @@ func (manifest NIMManifest) GetProfileRelease(profileID string) string {
 	return ""
 }
 
+// GetProfileSources returns the repositories the model files of the profile are downloaded from.
+func (manifest NIMManifest) GetProfileSources(profileID string) []string {
+	sources := []string{}
+	for _, profile := range manifest.Profiles {
+		if profileID != profile.ID {
+			continue
+		}
+		for _, file := range profile.Workspace.Files {
+			// File URIs select a file from the repository, e.g. ngc://nim/meta/model:version?file=config.json
+			source, _, _ := strings.Cut(file.Uri, "?")
+			if source != "" && !slices.Contains(sources, source) {
+				sources = append(sources, source)
+			}
+		}
+	}
+	slices.Sort(sources)
+	return sources
+}
+
+// GetProfileFiles returns the model files of the profile, by repository they are downloaded from.
+func (manifest NIMManifest) GetProfileFiles(profileID string) map[string][]string {
+	files := map[string][]string{}
+	for _, profile := range manifest.Profiles {
+		if profileID != profile.ID {
+			continue
+		}
+		for name, file := range profile.Workspace.Files {
+			source, query, _ := strings.Cut(file.Uri, "?")
+			if source == "" {
+				continue
+			}
+			// The file within the repository defaults to the workspace file name
+			if values, err := url.ParseQuery(query); err == nil && values.Get("file") != "" {
+				name = values.Get("file")
+			}
+			if !slices.Contains(files[source], name) {
+				files[source] = append(files[source], name)
+			}
+		}
+	}
+	for source := range files {
+		slices.Sort(files[source])
+	}
+	return files
+}
+
 func isOptimizedEngine(engine string) bool {
 	return engine != "" && strings.Contains(strings.ToLower(engine), BackendTypeTensorRT)
 }
@@ -46,6 +46,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	yamlDecoder "k8s.io/apimachinery/pkg/util/yaml"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	lws "sigs.k8s.io/lws/api/leaderworkerset/v1"
 	yamlConverter "sigs.k8s.io/yaml"
 
@@ -74,7 +75,12 @@ type Renderer interface {
 	RoleBinding(params *types.RoleBindingParams) (*rbacv1.RoleBinding, error)
 	SCC(params *types.SCCParams) (*securityv1.SecurityContextConstraints, error)
 	Ingress(params *types.IngressParams) (*networkingv1.Ingress, error)
+	HTTPRoute(params *types.HTTPRouteParams) (*gatewayv1.HTTPRoute, error)
+	GRPCRoute(params *types.GRPCRouteParams) (*gatewayv1.GRPCRoute, error)
+	InferencePool(params *types.InferencePoolParams) (*unstructured.Unstructured, error)
+	InferenceModel(params *types.InferenceModelParams) (*unstructured.Unstructured, error)
 	HPA(params *types.HPAParams) (*autoscalingv2.HorizontalPodAutoscaler, error)
+	ScaledObject(params *types.ScaledObjectParams) (*unstructured.Unstructured, error)
 	ServiceMonitor(params *types.ServiceMonitorParams) (*monitoringv1.ServiceMonitor, error)
 	ConfigMap(params *types.ConfigMapParams) (*corev1.ConfigMap, error)
 	Secret(params *types.SecretParams) (*corev1.Secret, error)
@@ -360,6 +366,64 @@ func (r *textTemplateRenderer) Ingress(params *types.IngressParams) (*networking
 	return ingress, nil
 }
 
+// HTTPRoute renders a Gateway API HTTPRoute spec with the given templating data.
+func (r *textTemplateRenderer) HTTPRoute(params *types.HTTPRouteParams) (*gatewayv1.HTTPRoute, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "httproute.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	route := &gatewayv1.HTTPRoute{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(objs[0].Object, route)
+	if err != nil {
+		return nil, fmt.Errorf("error converting unstructured object to HTTPRoute: %w", err)
+	}
+	return route, nil
+}
+
+// GRPCRoute renders a Gateway API GRPCRoute spec with the given templating data.
+func (r *textTemplateRenderer) GRPCRoute(params *types.GRPCRouteParams) (*gatewayv1.GRPCRoute, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "grpcroute.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	route := &gatewayv1.GRPCRoute{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(objs[0].Object, route)
+	if err != nil {
+		return nil, fmt.Errorf("error converting unstructured object to GRPCRoute: %w", err)
+	}
+	return route, nil
+}
+
+// InferencePool renders a Gateway API Inference Extension InferencePool with the given templating data.
+func (r *textTemplateRenderer) InferencePool(params *types.InferencePoolParams) (*unstructured.Unstructured, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "inferencepool.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return objs[0], nil
+}
+
+// InferenceModel renders a Gateway API Inference Extension InferenceModel with the given templating data.
+func (r *textTemplateRenderer) InferenceModel(params *types.InferenceModelParams) (*unstructured.Unstructured, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "inferencemodel.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return objs[0], nil
+}
+
 // HPA renders spec for HPA with the given templating data.
 func (r *textTemplateRenderer) HPA(params *types.HPAParams) (*autoscalingv2.HorizontalPodAutoscaler, error) {
 	objs, err := r.renderFile(path.Join(r.directory, "hpa.yaml"), &TemplateData{Data: params})
@@ -379,6 +443,18 @@ func (r *textTemplateRenderer) HPA(params *types.HPAParams) (*autoscalingv2.Hori
 	return hpa, nil
 }
 
+// ScaledObject renders a KEDA ScaledObject with the given templating data.
+func (r *textTemplateRenderer) ScaledObject(params *types.ScaledObjectParams) (*unstructured.Unstructured, error) {
+	objs, err := r.renderFile(path.Join(r.directory, "scaledobject.yaml"), &TemplateData{Data: params})
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return objs[0], nil
+}
+
 // ServiceMonitor renders spec for a ServiceMonitor with the given templating data.
 func (r *textTemplateRenderer) ServiceMonitor(params *types.ServiceMonitorParams) (*monitoringv1.ServiceMonitor, error) {
 	objs, err := r.renderFile(path.Join(r.directory, "servicemonitor.yaml"), &TemplateData{Data: params})
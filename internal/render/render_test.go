@@ -33,6 +33,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 	"github.com/NVIDIA/k8s-nim-operator/internal/render"
 	"github.com/NVIDIA/k8s-nim-operator/internal/render/types"
 )
@@ -500,6 +501,101 @@ var _ = Describe("K8s Resources Rendering", func() {
 			Expect(ingress.Namespace).To(Equal("default"))
 		})
 
+		It("should render HTTPRoute template correctly", func() {
+			router := appsv1alpha1.Router{
+				Gateway:   appsv1alpha1.GatewayReference{Name: "test-gateway"},
+				Hostnames: []string{"chart-example.local"},
+			}
+			params := types.HTTPRouteParams{
+				Enabled:   true,
+				Name:      "test-httproute",
+				Namespace: "default",
+				Spec:      router.GenerateGatewayHTTPRouteSpec("test-service", 8000),
+			}
+
+			r := render.NewRenderer(templatesDir)
+			route, err := r.HTTPRoute(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(route.Name).To(Equal("test-httproute"))
+			Expect(route.Namespace).To(Equal("default"))
+			Expect(route.Spec.ParentRefs).To(HaveLen(1))
+			Expect(string(route.Spec.ParentRefs[0].Name)).To(Equal("test-gateway"))
+			Expect(route.Spec.Hostnames).To(HaveLen(1))
+			Expect(string(route.Spec.Rules[0].BackendRefs[0].Name)).To(Equal("test-service"))
+			Expect(int32(*route.Spec.Rules[0].BackendRefs[0].Port)).To(Equal(int32(8000)))
+		})
+
+		It("should render GRPCRoute template correctly", func() {
+			router := appsv1alpha1.Router{
+				Gateway: appsv1alpha1.GatewayReference{Name: "test-gateway"},
+			}
+			params := types.GRPCRouteParams{
+				Enabled:   true,
+				Name:      "test-grpcroute",
+				Namespace: "default",
+				Spec:      router.GenerateGatewayGRPCRouteSpec("test-service", 8001),
+			}
+
+			r := render.NewRenderer(templatesDir)
+			route, err := r.GRPCRoute(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(route.Name).To(Equal("test-grpcroute"))
+			Expect(route.Namespace).To(Equal("default"))
+			Expect(int32(*route.Spec.Rules[0].BackendRefs[0].Port)).To(Equal(int32(8001)))
+		})
+
+		It("should not render routes when disabled", func() {
+			r := render.NewRenderer(templatesDir)
+			route, err := r.HTTPRoute(&types.HTTPRouteParams{Name: "test-httproute", Namespace: "default"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(route).To(BeNil())
+		})
+
+		It("should render InferencePool template correctly", func() {
+			params := types.InferencePoolParams{
+				Enabled:                   true,
+				Name:                      "test-pool",
+				Namespace:                 "default",
+				SelectorLabels:            map[string]string{appsv1alpha1.InferencePoolLabelKey: "test-pool"},
+				TargetPort:                8000,
+				EndpointPickerName:        "test-epp",
+				EndpointPickerPort:        9002,
+				EndpointPickerFailureMode: "FailClose",
+			}
+
+			r := render.NewRenderer(templatesDir)
+			pool, err := r.InferencePool(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pool.GetKind()).To(Equal("InferencePool"))
+			Expect(pool.GetName()).To(Equal("test-pool"))
+			Expect(pool.GetNamespace()).To(Equal("default"))
+			targetPort, _, _ := unstructured.NestedInt64(pool.Object, "spec", "targetPortNumber")
+			Expect(targetPort).To(Equal(int64(8000)))
+			eppPort, _, _ := unstructured.NestedInt64(pool.Object, "spec", "extensionRef", "portNumber")
+			Expect(eppPort).To(Equal(int64(9002)))
+		})
+
+		It("should render InferenceModel template correctly", func() {
+			params := types.InferenceModelParams{
+				Enabled:     true,
+				Name:        "test-pool-llama",
+				Namespace:   "default",
+				ModelName:   "meta/llama-3.1-8b-instruct",
+				Criticality: "Critical",
+				PoolName:    "test-pool",
+			}
+
+			r := render.NewRenderer(templatesDir)
+			model, err := r.InferenceModel(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(model.GetKind()).To(Equal("InferenceModel"))
+			Expect(model.GetName()).To(Equal("test-pool-llama"))
+			modelName, _, _ := unstructured.NestedString(model.Object, "spec", "modelName")
+			Expect(modelName).To(Equal("meta/llama-3.1-8b-instruct"))
+			poolRef, _, _ := unstructured.NestedString(model.Object, "spec", "poolRef", "name")
+			Expect(poolRef).To(Equal("test-pool"))
+		})
+
 		It("should render HPA template correctly", func() {
 			minRep := int32(1)
 			params := types.HPAParams{
@@ -525,6 +621,74 @@ var _ = Describe("K8s Resources Rendering", func() {
 			Expect(hpa.Spec.MaxReplicas).To(Equal(int32(10)))
 		})
 
+		It("should render KEDA ScaledObject template correctly", func() {
+			params := types.ScaledObjectParams{
+				Enabled:               true,
+				Name:                  "test-scaledobject",
+				Namespace:             "default",
+				ScaleTargetAPIVersion: "apps/v1",
+				ScaleTargetKind:       "Deployment",
+				ScaleTargetName:       "test-deployment",
+				MinReplicas:           0,
+				MaxReplicas:           4,
+				CooldownPeriod:        ptr.To[int32](600),
+				Triggers: []types.ScaledObjectTrigger{
+					{
+						Name:                "trigger-0",
+						MetricType:          "AverageValue",
+						ServerAddress:       "http://prometheus.monitoring:9090",
+						Query:               `sum(num_requests_waiting{namespace="default",service="test"})`,
+						Threshold:           "10",
+						ActivationThreshold: "1",
+					},
+				},
+			}
+
+			r := render.NewRenderer(templatesDir)
+			scaledObject, err := r.ScaledObject(&params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scaledObject.GetKind()).To(Equal("ScaledObject"))
+			Expect(scaledObject.GetName()).To(Equal("test-scaledobject"))
+			minReplicas, _, _ := unstructured.NestedInt64(scaledObject.Object, "spec", "minReplicaCount")
+			Expect(minReplicas).To(Equal(int64(0)))
+			maxReplicas, _, _ := unstructured.NestedInt64(scaledObject.Object, "spec", "maxReplicaCount")
+			Expect(maxReplicas).To(Equal(int64(4)))
+			cooldown, _, _ := unstructured.NestedInt64(scaledObject.Object, "spec", "cooldownPeriod")
+			Expect(cooldown).To(Equal(int64(600)))
+			_, found, _ := unstructured.NestedFieldNoCopy(scaledObject.Object, "spec", "pollingInterval")
+			Expect(found).To(BeFalse())
+			triggers, _, _ := unstructured.NestedSlice(scaledObject.Object, "spec", "triggers")
+			Expect(triggers).To(HaveLen(1))
+			metadata, _, _ := unstructured.NestedStringMap(triggers[0].(map[string]interface{}), "metadata")
+			Expect(metadata).To(HaveKeyWithValue("query", `sum(num_requests_waiting{namespace="default",service="test"})`))
+			Expect(metadata).To(HaveKeyWithValue("threshold", "10"))
+			Expect(metadata).To(HaveKeyWithValue("activationThreshold", "1"))
+		})
+
+		It("should render KEDA ScaledObject with one replica when minReplicas is unset", func() {
+			nimService := &appsv1alpha1.NIMService{}
+			nimService.Name = "test"
+			nimService.Namespace = "default"
+			nimService.Spec.Scale = appsv1alpha1.Autoscaling{
+				Enabled: ptr.To(true),
+				Backend: appsv1alpha1.AutoscalingBackendKEDA,
+				KEDA: &appsv1alpha1.KEDAScaledObjectSpec{
+					MaxReplicas:             4,
+					PrometheusServerAddress: "http://prometheus.monitoring:9090",
+					Triggers: []appsv1alpha1.KEDAPrometheusTrigger{
+						{Metric: appsv1alpha1.NIMMetricQueueDepth, Threshold: "10"},
+					},
+				},
+			}
+
+			r := render.NewRenderer(templatesDir)
+			scaledObject, err := r.ScaledObject(nimService.GetScaledObjectParams())
+			Expect(err).NotTo(HaveOccurred())
+			minReplicas, found, _ := unstructured.NestedInt64(scaledObject.Object, "spec", "minReplicaCount")
+			Expect(found).To(BeTrue())
+			Expect(minReplicas).To(Equal(int64(1)))
+		})
+
 		It("should render HPA template and sort metrics spec correctly", func() {
 			minRep := int32(1)
 			params := types.HPAParams{
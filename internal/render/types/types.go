@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 // DaemonsetParams holds the parameters for rendering a Daemonset template.
@@ -171,6 +172,9 @@ type ServiceParams struct {
 	Labels         map[string]string
 	Annotations    map[string]string
 	SelectorLabels map[string]string
+	// ClusterIP is set to None for headless services.
+	ClusterIP                string
+	PublishNotReadyAddresses bool
 }
 
 // ServiceAccountParams holds the parameters for rendering a ServiceAccount template.
@@ -225,6 +229,52 @@ type IngressPath struct {
 	ServiceType string
 }
 
+// HTTPRouteParams holds the parameters for rendering a Gateway API HTTPRoute template.
+type HTTPRouteParams struct {
+	Enabled     bool
+	Name        string
+	Namespace   string
+	Labels      map[string]string
+	Annotations map[string]string
+	Spec        gatewayv1.HTTPRouteSpec
+}
+
+// GRPCRouteParams holds the parameters for rendering a Gateway API GRPCRoute template.
+type GRPCRouteParams struct {
+	Enabled     bool
+	Name        string
+	Namespace   string
+	Labels      map[string]string
+	Annotations map[string]string
+	Spec        gatewayv1.GRPCRouteSpec
+}
+
+// InferencePoolParams holds the parameters for rendering a Gateway API Inference Extension InferencePool template.
+type InferencePoolParams struct {
+	Enabled                   bool
+	Name                      string
+	Namespace                 string
+	Labels                    map[string]string
+	Annotations               map[string]string
+	SelectorLabels            map[string]string
+	TargetPort                int32
+	EndpointPickerName        string
+	EndpointPickerPort        int32
+	EndpointPickerFailureMode string
+}
+
+// InferenceModelParams holds the parameters for rendering a Gateway API Inference Extension InferenceModel template.
+type InferenceModelParams struct {
+	Enabled     bool
+	Name        string
+	Namespace   string
+	Labels      map[string]string
+	Annotations map[string]string
+	ModelName   string
+	Criticality string
+	PoolName    string
+}
+
 // HPAParams holds the parameters for rendering a HorizontalPodAutoscaler template.
 type HPAParams struct {
 	Enabled     bool
@@ -235,6 +285,34 @@ type HPAParams struct {
 	HPASpec     autoscalingv2.HorizontalPodAutoscalerSpec
 }
 
+// ScaledObjectParams holds the parameters for rendering a KEDA ScaledObject template.
+type ScaledObjectParams struct {
+	Enabled               bool
+	Name                  string
+	Namespace             string
+	Labels                map[string]string
+	Annotations           map[string]string
+	ScaleTargetAPIVersion string
+	ScaleTargetKind       string
+	ScaleTargetName       string
+	MinReplicas           int32
+	MaxReplicas           int32
+	PollingInterval       *int32
+	CooldownPeriod        *int32
+	Behavior              *autoscalingv2.HorizontalPodAutoscalerBehavior
+	Triggers              []ScaledObjectTrigger
+}
+
+// ScaledObjectTrigger holds the parameters for rendering a Prometheus trigger of a KEDA ScaledObject.
+type ScaledObjectTrigger struct {
+	Name                string
+	MetricType          string
+	ServerAddress       string
+	Query               string
+	Threshold           string
+	ActivationThreshold string
+}
+
 // ServiceMonitorParams holds the parameters for rendering a ServiceMonitor template.
 type ServiceMonitorParams struct {
 	Enabled       bool
@@ -272,44 +350,46 @@ type SecretParams struct {
 
 // InferenceServiceParams holds the parameters for rendering a InferenceService template.
 type InferenceServiceParams struct {
-	Name               string
-	Namespace          string
-	Labels             map[string]string
-	Annotations        map[string]string
-	PodAnnotations     map[string]string
-	SelectorLabels     map[string]string
-	MinReplicas        *int32
-	MaxReplicas        *int32
-	ScaleMetricType    string
-	ScaleMetric        string
-	ScaleTarget        *int32
-	ContainerName      string
-	Args               []string
-	Command            []string
-	Image              string
-	ImagePullSecrets   []string
-	ImagePullPolicy    string
-	SchedulerName      string
-	Volumes            []corev1.Volume
-	VolumeMounts       []corev1.VolumeMount
-	Env                []corev1.EnvVar
-	Resources          *corev1.ResourceRequirements
-	NodeSelector       map[string]string
-	Tolerations        []corev1.Toleration
-	Affinity           *corev1.PodAffinity
-	LivenessProbe      *corev1.Probe
-	ReadinessProbe     *corev1.Probe
-	StartupProbe       *corev1.Probe
-	ServiceAccountName string
-	NIMCachePVC        string
-	UserID             *int64
-	GroupID            *int64
-	RuntimeClassName   string
-	OrchestratorType   string
-	Ports              []corev1.ContainerPort
-	InitContainers     []corev1.Container
-	PodResourceClaims  []corev1.PodResourceClaim
-	DeploymentMode     string
+	Name            string
+	Namespace       string
+	Labels          map[string]string
+	Annotations     map[string]string
+	PodAnnotations  map[string]string
+	SelectorLabels  map[string]string
+	MinReplicas     *int32
+	MaxReplicas     *int32
+	ScaleMetricType string
+	ScaleMetric     string
+	ScaleTarget     *int32
+	// ContainerConcurrency is the hard limit of concurrent requests per replica in Serverless mode.
+	ContainerConcurrency *int64
+	ContainerName        string
+	Args                 []string
+	Command              []string
+	Image                string
+	ImagePullSecrets     []string
+	ImagePullPolicy      string
+	SchedulerName        string
+	Volumes              []corev1.Volume
+	VolumeMounts         []corev1.VolumeMount
+	Env                  []corev1.EnvVar
+	Resources            *corev1.ResourceRequirements
+	NodeSelector         map[string]string
+	Tolerations          []corev1.Toleration
+	Affinity             *corev1.PodAffinity
+	LivenessProbe        *corev1.Probe
+	ReadinessProbe       *corev1.Probe
+	StartupProbe         *corev1.Probe
+	ServiceAccountName   string
+	NIMCachePVC          string
+	UserID               *int64
+	GroupID              *int64
+	RuntimeClassName     string
+	OrchestratorType     string
+	Ports                []corev1.ContainerPort
+	InitContainers       []corev1.Container
+	PodResourceClaims    []corev1.PodResourceClaim
+	DeploymentMode       string
 }
 
 type DRADeviceParams struct {
@@ -317,6 +397,9 @@ type DRADeviceParams struct {
 	Count           uint32
 	DeviceClassName string
 	CELExpressions  []string
+	DriverName      string
+	// OpaqueConfig is the driver specific config of the requested devices, if any.
+	OpaqueConfig map[string]any
 }
 
 type ResourceClaimTemplateParams struct {
@@ -0,0 +1,79 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+)
+
+// ValidateGatewayRouteResource returns an error if the given Gateway API route resource is not served by the cluster.
+func ValidateGatewayRouteResource(discoveryClient discovery.DiscoveryInterface, resource string) error {
+	crdExists, err := k8sutil.CRDExists(discoveryClient, gatewayv1.SchemeGroupVersion.WithResource(resource))
+	if err != nil {
+		return fmt.Errorf("failed to check if %s CRD exists: %w", resource, err)
+	}
+	if !crdExists {
+		return fmt.Errorf("gateway API %s are not supported on this cluster, please ensure %s API group is enabled", resource, gatewayv1.SchemeGroupVersion)
+	}
+	return nil
+}
+
+// GetGatewayRouteEndpoint returns the external endpoint of a route once it is accepted by the referenced Gateway.
+// The first route hostname is preferred, falling back to the first address of the Gateway.
+func GetGatewayRouteEndpoint(ctx context.Context, k8sClient client.Client, router *appsv1alpha1.Router, route *gatewayv1.HTTPRoute) (string, error) {
+	if !isGatewayRouteAccepted(router, route) {
+		return "", nil
+	}
+	if len(router.Hostnames) > 0 {
+		return router.Hostnames[0], nil
+	}
+
+	gatewayNamespace := router.Gateway.Namespace
+	if gatewayNamespace == "" {
+		gatewayNamespace = route.GetNamespace()
+	}
+	gateway := &gatewayv1.Gateway{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: router.Gateway.Name, Namespace: gatewayNamespace}, gateway); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	if len(gateway.Status.Addresses) == 0 {
+		return "", nil
+	}
+	return gateway.Status.Addresses[0].Value, nil
+}
+
+func isGatewayRouteAccepted(router *appsv1alpha1.Router, route *gatewayv1.HTTPRoute) bool {
+	for _, parent := range route.Status.Parents {
+		if string(parent.ParentRef.Name) != router.Gateway.Name {
+			continue
+		}
+		if meta.IsStatusConditionTrue(parent.Conditions, string(gatewayv1.RouteConditionAccepted)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+
+	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+)
+
+// KEDAScaledObjectGVK is the group version kind of KEDA ScaledObjects.
+var KEDAScaledObjectGVK = schema.GroupVersionKind{Group: "keda.sh", Version: "v1alpha1", Kind: "ScaledObject"}
+
+// NewKEDAScaledObject returns an empty KEDA ScaledObject to get or watch.
+func NewKEDAScaledObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(KEDAScaledObjectGVK)
+	return obj
+}
+
+// ValidateKEDAScaledObjectResource returns an error if KEDA ScaledObjects are not served by the cluster.
+func ValidateKEDAScaledObjectResource(discoveryClient discovery.DiscoveryInterface) error {
+	crdExists, err := k8sutil.CRDExists(discoveryClient, KEDAScaledObjectGVK.GroupVersion().WithResource("scaledobjects"))
+	if err != nil {
+		return fmt.Errorf("failed to check if scaledobjects CRD exists: %w", err)
+	}
+	if !crdExists {
+		return fmt.Errorf("KEDA autoscaling is not supported on this cluster, please ensure %s API group is enabled", KEDAScaledObjectGVK.GroupVersion())
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/nimsource"
+)
+
+const (
+	loraStoreVolumeName = "lora-store"
+)
+
+// NamedLoRAAdapter is a LoRA adapter resolved to the PVC it is mounted from
+// or the HuggingFace compatible source it is downloaded from.
+type NamedLoRAAdapter struct {
+	Name string
+	// VolumeName is the name of the pod volume or init container of the adapter.
+	VolumeName string
+	// PVC is set for adapters mounted from a PVC or a NIMCache.
+	PVC *appsv1alpha1.PersistentVolumeClaim
+	// Source is set for adapters downloaded from HuggingFace Hub, NeMo DataStore or a NemoCustomizer.
+	Source nimsource.HFInterface
+}
+
+// ResolveLoRAAdapters resolves the LoRA adapters of the NIMService.
+// It returns an error if a referenced NIMCache is not ready or a referenced NemoCustomizer does not exist.
+func ResolveLoRAAdapters(ctx context.Context, k8sClient client.Client, nimService *appsv1alpha1.NIMService) ([]NamedLoRAAdapter, error) {
+	if !nimService.IsLoRAEnabled() {
+		return nil, nil
+	}
+
+	adapters := make([]NamedLoRAAdapter, 0, len(nimService.Spec.LoRA.Adapters))
+	for idx, adapter := range nimService.Spec.LoRA.Adapters {
+		named := NamedLoRAAdapter{
+			Name:       adapter.Name,
+			VolumeName: fmt.Sprintf("lora-%d", idx),
+		}
+
+		switch {
+		case adapter.PVC != nil:
+			named.PVC = &appsv1alpha1.PersistentVolumeClaim{
+				Name:    adapter.PVC.Name,
+				SubPath: adapter.PVC.SubPath,
+			}
+		case adapter.NIMCache != nil:
+			nimCache := &appsv1alpha1.NIMCache{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Name: adapter.NIMCache.Name, Namespace: nimService.GetNamespace()}, nimCache); err != nil {
+				return nil, fmt.Errorf("failed to get NIMCache %s for LoRA adapter %s: %w", adapter.NIMCache.Name, adapter.Name, err)
+			}
+			if nimCache.Status.State != appsv1alpha1.NimCacheStatusReady || nimCache.Status.PVC == "" {
+				return nil, fmt.Errorf("NIMCache %s for LoRA adapter %s is not ready", adapter.NIMCache.Name, adapter.Name)
+			}
+			named.PVC = &appsv1alpha1.PersistentVolumeClaim{
+				Name:    nimCache.Status.PVC,
+				SubPath: path.Join(nimCache.Spec.Storage.PVC.SubPath, adapter.NIMCache.Path),
+			}
+		case adapter.HF != nil:
+			named.Source = adapter.HF
+		case adapter.DataStore != nil:
+			named.Source = adapter.DataStore
+		case adapter.NemoCustomizer != nil:
+			source, err := getNemoCustomizerLoRASource(ctx, k8sClient, nimService.GetNamespace(), adapter.NemoCustomizer)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve LoRA adapter %s: %w", adapter.Name, err)
+			}
+			named.Source = source
+		default:
+			return nil, fmt.Errorf("no source defined for LoRA adapter %s", adapter.Name)
+		}
+		adapters = append(adapters, named)
+	}
+	return adapters, nil
+}
+
+// getNemoCustomizerLoRASource returns the NeMo DataStore source of an adapter produced by a NemoCustomizer.
+func getNemoCustomizerLoRASource(ctx context.Context, k8sClient client.Client, namespace string, src *appsv1alpha1.LoRANemoCustomizerSource) (*appsv1alpha1.NemoDataStoreSource, error) {
+	customizer := &appsv1alpha1.NemoCustomizer{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: src.Name, Namespace: namespace}, customizer); err != nil {
+		return nil, fmt.Errorf("failed to get NemoCustomizer %s: %w", src.Name, err)
+	}
+
+	dsNamespace := src.Namespace
+	if dsNamespace == "" {
+		dsNamespace = "default"
+	}
+	return &appsv1alpha1.NemoDataStoreSource{
+		Endpoint:  fmt.Sprintf("%s/v1/hf", strings.TrimSuffix(customizer.Spec.Datastore.Endpoint, "/")),
+		Namespace: dsNamespace,
+		DSHFCommonFields: appsv1alpha1.DSHFCommonFields{
+			ModelName:   ptr.To(src.ModelName),
+			AuthSecret:  src.AuthSecret,
+			ModelPuller: src.ModelPuller,
+			PullSecret:  src.PullSecret,
+			Revision:    src.Revision,
+		},
+	}, nil
+}
+
+// GetLoRAVolumes returns the pod volumes for the LoRA adapter store.
+func GetLoRAVolumes(adapters []NamedLoRAAdapter) []corev1.Volume {
+	if len(adapters) == 0 {
+		return nil
+	}
+
+	volumes := []corev1.Volume{
+		{
+			Name: loraStoreVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	}
+	for _, adapter := range adapters {
+		if adapter.PVC == nil {
+			continue
+		}
+		volumes = append(volumes, corev1.Volume{
+			Name: adapter.VolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: adapter.PVC.Name,
+					ReadOnly:  true,
+				},
+			},
+		})
+	}
+	return volumes
+}
+
+// GetLoRAVolumeMounts returns the NIM container volume mounts for the LoRA adapter store.
+// Each adapter is available in its own directory under appsv1alpha1.DefaultLoRAStorePath.
+func GetLoRAVolumeMounts(adapters []NamedLoRAAdapter) []corev1.VolumeMount {
+	if len(adapters) == 0 {
+		return nil
+	}
+
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      loraStoreVolumeName,
+			MountPath: appsv1alpha1.DefaultLoRAStorePath,
+		},
+	}
+	for _, adapter := range adapters {
+		if adapter.PVC == nil {
+			continue
+		}
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      adapter.VolumeName,
+			MountPath: path.Join(appsv1alpha1.DefaultLoRAStorePath, adapter.Name),
+			SubPath:   adapter.PVC.SubPath,
+			ReadOnly:  true,
+		})
+	}
+	return volumeMounts
+}
+
+// GetLoRAInitContainers returns the init containers downloading the LoRA adapters into the adapter store.
+func GetLoRAInitContainers(nimService *appsv1alpha1.NIMService, adapters []NamedLoRAAdapter) []corev1.Container {
+	var initContainers []corev1.Container
+	for _, adapter := range adapters {
+		if adapter.Source == nil {
+			continue
+		}
+		container := corev1.Container{
+			Name:    fmt.Sprintf("download-%s", adapter.VolumeName),
+			Image:   adapter.Source.GetModelPuller(),
+			Command: nimsource.HFDownloadToCacheCommand(adapter.Source, path.Join(appsv1alpha1.DefaultLoRAStorePath, adapter.Name)),
+			Env: []corev1.EnvVar{
+				{
+					Name:  "HF_ENDPOINT",
+					Value: adapter.Source.GetEndpoint(),
+				},
+				{
+					Name:  "HF_HUB_OFFLINE",
+					Value: "0",
+				},
+				{
+					Name:  "HF_HOME",
+					Value: "/tmp/hf",
+				},
+			},
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      loraStoreVolumeName,
+					MountPath: appsv1alpha1.DefaultLoRAStorePath,
+				},
+			},
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To[bool](false),
+				Capabilities: &corev1.Capabilities{
+					Drop: []corev1.Capability{"ALL"},
+				},
+				RunAsNonRoot: ptr.To[bool](true),
+				RunAsGroup:   nimService.GetGroupID(),
+				RunAsUser:    nimService.GetUserID(),
+			},
+		}
+		if adapter.Source.GetAuthSecret() != "" {
+			container.EnvFrom = []corev1.EnvFromSource{
+				{
+					SecretRef: &corev1.SecretEnvSource{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: adapter.Source.GetAuthSecret(),
+						},
+					},
+				},
+			}
+		}
+		initContainers = append(initContainers, container)
+	}
+	return initContainers
+}
+
+// GetLoRAImagePullSecrets returns the image pull secrets of the LoRA adapter download images.
+func GetLoRAImagePullSecrets(adapters []NamedLoRAAdapter) []string {
+	var pullSecrets []string
+	for _, adapter := range adapters {
+		if adapter.Source == nil || adapter.Source.GetPullSecret() == "" {
+			continue
+		}
+		pullSecrets = append(pullSecrets, adapter.Source.GetPullSecret())
+	}
+	return pullSecrets
+}
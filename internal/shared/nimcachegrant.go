@@ -0,0 +1,241 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+)
+
+const (
+	// SharedNIMCacheLabelKey is the label set on PVs mirroring a NIMCache volume, with the name of the NIMCache.
+	SharedNIMCacheLabelKey = "apps.nvidia.com/shared-nimcache"
+	// SharedNIMCacheNamespaceLabelKey is the label set on PVs mirroring a NIMCache volume, with the namespace of the NIMCache.
+	SharedNIMCacheNamespaceLabelKey = "apps.nvidia.com/shared-nimcache-namespace"
+)
+
+// IsNIMCacheGranted returns true if NIMServices in fromNamespace are allowed to use the given NIMCache.
+// A NIMCache in the same namespace is always allowed, while a NIMCache in another namespace must be allowed
+// by a NIMCacheGrant in the namespace of the NIMCache.
+func IsNIMCacheGranted(ctx context.Context, reader client.Reader, fromNamespace string, nimCache types.NamespacedName) (bool, error) {
+	if fromNamespace == nimCache.Namespace {
+		return true, nil
+	}
+
+	grants := &appsv1alpha1.NIMCacheGrantList{}
+	if err := reader.List(ctx, grants, client.InNamespace(nimCache.Namespace)); err != nil {
+		return false, fmt.Errorf("failed to list NIMCacheGrants in namespace %s: %w", nimCache.Namespace, err)
+	}
+	for i := range grants.Items {
+		if grants.Items[i].Allows(fromNamespace, nimCache.Name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ReconcileSharedNIMCachePVC makes the PVC of a NIMCache in another namespace available to the NIMService.
+// PVCs cannot be mounted across namespaces, so a PV mirroring the volume bound to the NIMCache PVC is created
+// along with a PVC bound to it in the NIMService namespace. The mirrored PV retains the volume on deletion.
+//
+// The mirror is named after the volume it mirrors, so that the NIMService rolls onto a new mirror when it switches
+// to another NIMCache, the mirrors of the volumes it no longer uses being deleted.
+func ReconcileSharedNIMCachePVC(ctx context.Context, k8sClient client.Client, scheme *runtime.Scheme, nimService *appsv1alpha1.NIMService, nimCache *appsv1alpha1.NIMCache) (*appsv1alpha1.PersistentVolumeClaim, error) {
+	if nimCache.Status.PVC == "" {
+		return nil, fmt.Errorf("missing PVC for the nimcache instance %s/%s", nimCache.GetNamespace(), nimCache.GetName())
+	}
+
+	sourcePVC := &corev1.PersistentVolumeClaim{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: nimCache.Status.PVC, Namespace: nimCache.GetNamespace()}, sourcePVC); err != nil {
+		return nil, fmt.Errorf("failed to get PVC %s for the nimcache instance %s/%s: %w", nimCache.Status.PVC, nimCache.GetNamespace(), nimCache.GetName(), err)
+	}
+	if sourcePVC.Spec.VolumeName == "" {
+		return nil, fmt.Errorf("PVC %s for the nimcache instance %s/%s is not bound", sourcePVC.Name, nimCache.GetNamespace(), nimCache.GetName())
+	}
+	sourcePV := &corev1.PersistentVolume{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: sourcePVC.Spec.VolumeName}, sourcePV); err != nil {
+		return nil, fmt.Errorf("failed to get PV %s for the nimcache instance %s/%s: %w", sourcePVC.Spec.VolumeName, nimCache.GetNamespace(), nimCache.GetName(), err)
+	}
+
+	pvcName := nimService.GetSharedNIMCachePVCName(sourcePV.Name)
+	pvName := nimService.GetSharedNIMCachePVName(sourcePV.Name)
+	if err := deleteSharedNIMCachePVs(ctx, k8sClient, nimService, pvName); err != nil {
+		return nil, err
+	}
+
+	labels := getSharedNIMCachePVLabels(nimService, nimCache)
+	pv := &corev1.PersistentVolume{}
+	err := k8sClient.Get(ctx, types.NamespacedName{Name: pvName}, pv)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		if pv.DeletionTimestamp != nil {
+			return nil, fmt.Errorf("waiting for PV %s of a previous volume for the nimcache instance %s/%s to be deleted", pvName, nimCache.GetNamespace(), nimCache.GetName())
+		}
+		// Recreate the mirror when the PV backing the NIMCache was recreated with another volume
+		if !equality.Semantic.DeepEqual(pv.Spec.PersistentVolumeSource, sourcePV.Spec.PersistentVolumeSource) {
+			if err := deleteSharedNIMCachePV(ctx, k8sClient, pv); err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("recreating PV %s mirroring a previous volume for the nimcache instance %s/%s", pvName, nimCache.GetNamespace(), nimCache.GetName())
+		}
+		// NIMCaches sharing a volume share its mirror
+		if !equality.Semantic.DeepEqual(pv.Labels, labels) {
+			pv.Labels = labels
+			if err := k8sClient.Update(ctx, pv); err != nil {
+				return nil, fmt.Errorf("failed to update PV %s for the nimcache instance %s/%s: %w", pvName, nimCache.GetNamespace(), nimCache.GetName(), err)
+			}
+		}
+	}
+	if k8serrors.IsNotFound(err) {
+		pv = &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   pvName,
+				Labels: labels,
+			},
+			Spec: corev1.PersistentVolumeSpec{
+				PersistentVolumeSource: *sourcePV.Spec.PersistentVolumeSource.DeepCopy(),
+				Capacity:               sourcePV.Spec.Capacity.DeepCopy(),
+				AccessModes:            sourcePV.Spec.AccessModes,
+				VolumeMode:             sourcePV.Spec.VolumeMode,
+				MountOptions:           sourcePV.Spec.MountOptions,
+				NodeAffinity:           sourcePV.Spec.NodeAffinity.DeepCopy(),
+				StorageClassName:       sourcePV.Spec.StorageClassName,
+				// Never delete the volume backing the NIMCache
+				PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+				ClaimRef: &corev1.ObjectReference{
+					Kind:       "PersistentVolumeClaim",
+					APIVersion: "v1",
+					Name:       pvcName,
+					Namespace:  nimService.GetNamespace(),
+				},
+			},
+		}
+		if err := k8sClient.Create(ctx, pv); err != nil {
+			return nil, fmt.Errorf("failed to create PV %s for the nimcache instance %s/%s: %w", pvName, nimCache.GetNamespace(), nimCache.GetName(), err)
+		}
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	err = k8sClient.Get(ctx, types.NamespacedName{Name: pvcName, Namespace: nimService.GetNamespace()}, pvc)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil && pvc.DeletionTimestamp != nil {
+		return nil, fmt.Errorf("waiting for PVC %s of a previous volume for the nimcache instance %s/%s to be deleted", pvcName, nimCache.GetNamespace(), nimCache.GetName())
+	}
+	if k8serrors.IsNotFound(err) {
+		pvc = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pvcName,
+				Namespace: nimService.GetNamespace(),
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: pv.Spec.AccessModes,
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: pv.Spec.Capacity[corev1.ResourceStorage],
+					},
+				},
+				VolumeName:       pvName,
+				VolumeMode:       pv.Spec.VolumeMode,
+				StorageClassName: ptr.To(pv.Spec.StorageClassName),
+			},
+		}
+		if err := controllerutil.SetControllerReference(nimService, pvc, scheme); err != nil {
+			return nil, err
+		}
+		if err := k8sClient.Create(ctx, pvc); err != nil {
+			return nil, fmt.Errorf("failed to create PVC %s for the nimcache instance %s/%s: %w", pvcName, nimCache.GetNamespace(), nimCache.GetName(), err)
+		}
+	}
+
+	return &appsv1alpha1.PersistentVolumeClaim{
+		Name:    pvcName,
+		SubPath: nimCache.Spec.Storage.PVC.SubPath,
+	}, nil
+}
+
+// DeleteSharedNIMCachePV deletes the PVs mirroring the volume of a NIMCache in another namespace and their PVCs, if any.
+// It is called once the NIMService no longer uses a NIMCache from another namespace, or is no longer granted it.
+func DeleteSharedNIMCachePV(ctx context.Context, k8sClient client.Client, nimService *appsv1alpha1.NIMService) error {
+	return deleteSharedNIMCachePVs(ctx, k8sClient, nimService, "")
+}
+
+// deleteSharedNIMCachePVs deletes the PVs mirroring a NIMCache volume for the NIMService and their PVCs, but the PV
+// named keep. The PVCs are released by the kubelet once no pod mounts them anymore.
+func deleteSharedNIMCachePVs(ctx context.Context, k8sClient client.Client, nimService *appsv1alpha1.NIMService, keep string) error {
+	pvs := &corev1.PersistentVolumeList{}
+	if err := k8sClient.List(ctx, pvs, client.MatchingLabels{
+		"app.kubernetes.io/managed-by": "k8s-nim-operator",
+		"app.kubernetes.io/instance":   nimService.GetName(),
+	}, client.HasLabels{SharedNIMCacheLabelKey}); err != nil {
+		return fmt.Errorf("failed to list PVs mirroring NIMCache volumes: %w", err)
+	}
+	for i := range pvs.Items {
+		pv := &pvs.Items[i]
+		if pv.Name == keep || pv.Spec.ClaimRef == nil || pv.Spec.ClaimRef.Namespace != nimService.GetNamespace() {
+			continue
+		}
+		if err := deleteSharedNIMCachePV(ctx, k8sClient, pv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteSharedNIMCachePV deletes a PV mirroring a NIMCache volume along with the PVC bound to it.
+func deleteSharedNIMCachePV(ctx context.Context, k8sClient client.Client, pv *corev1.PersistentVolume) error {
+	if pv.Spec.ClaimRef != nil {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pv.Spec.ClaimRef.Name,
+				Namespace: pv.Spec.ClaimRef.Namespace,
+			},
+		}
+		if err := k8sClient.Delete(ctx, pvc); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete PVC %s: %w", pvc.Name, err)
+		}
+	}
+	if err := k8sClient.Delete(ctx, pv); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete PV %s: %w", pv.Name, err)
+	}
+	return nil
+}
+
+// getSharedNIMCachePVLabels returns the labels of the PV mirroring the volume of a NIMCache for the NIMService.
+func getSharedNIMCachePVLabels(nimService *appsv1alpha1.NIMService, nimCache *appsv1alpha1.NIMCache) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/managed-by":  "k8s-nim-operator",
+		"app.kubernetes.io/instance":    nimService.GetName(),
+		SharedNIMCacheLabelKey:          nimCache.GetName(),
+		SharedNIMCacheNamespaceLabelKey: nimCache.GetNamespace(),
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+)
+
+var _ = Describe("NIMCacheGrant tests", func() {
+	var (
+		ctx        context.Context
+		scheme     *runtime.Scheme
+		k8sClient  client.Client
+		nimService *appsv1alpha1.NIMService
+		nimCache   *appsv1alpha1.NIMCache
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(appsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		nimCache = &appsv1alpha1.NIMCache{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared-cache", Namespace: "models"},
+			Spec: appsv1alpha1.NIMCacheSpec{
+				Storage: appsv1alpha1.NIMCacheStorage{
+					PVC: appsv1alpha1.PersistentVolumeClaim{SubPath: "llama"},
+				},
+			},
+			Status: appsv1alpha1.NIMCacheStatus{PVC: "shared-cache-pvc"},
+		}
+		nimService = &appsv1alpha1.NIMService{
+			ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "team-a", UID: "uid-llm"},
+			Spec: appsv1alpha1.NIMServiceSpec{
+				Storage: appsv1alpha1.NIMServiceStorage{
+					NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: "shared-cache", Namespace: "models"},
+				},
+			},
+		}
+		grant := &appsv1alpha1.NIMCacheGrant{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: "models"},
+			Spec: appsv1alpha1.NIMCacheGrantSpec{
+				From: []appsv1alpha1.NIMCacheGrantFrom{{Namespace: "team-a"}},
+				To:   []appsv1alpha1.NIMCacheGrantTo{{Name: "shared-cache"}},
+			},
+		}
+		sourcePVC := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared-cache-pvc", Namespace: "models"},
+			Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "pv-nfs"},
+		}
+		sourcePV := &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-nfs"},
+			Spec: corev1.PersistentVolumeSpec{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceStorage: apiresource.MustParse("50Gi"),
+				},
+				AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+				StorageClassName: "nfs",
+				PersistentVolumeSource: corev1.PersistentVolumeSource{
+					NFS: &corev1.NFSVolumeSource{Server: "nfs.local", Path: "/exports/models"},
+				},
+				PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+			},
+		}
+		k8sClient = fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(grant, sourcePVC, sourcePV).
+			Build()
+	})
+
+	DescribeTable("should check whether a NIMCache is granted",
+		func(fromNamespace string, nimCache types.NamespacedName, expected bool) {
+			granted, err := IsNIMCacheGranted(ctx, k8sClient, fromNamespace, nimCache)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(granted).To(Equal(expected))
+		},
+		Entry("same namespace", "models", types.NamespacedName{Name: "other-cache", Namespace: "models"}, true),
+		Entry("granted namespace and nimcache", "team-a", types.NamespacedName{Name: "shared-cache", Namespace: "models"}, true),
+		Entry("nimcache not listed in grant", "team-a", types.NamespacedName{Name: "other-cache", Namespace: "models"}, false),
+		Entry("namespace not listed in grant", "team-b", types.NamespacedName{Name: "shared-cache", Namespace: "models"}, false),
+		Entry("no grant in nimcache namespace", "team-a", types.NamespacedName{Name: "shared-cache", Namespace: "other"}, false),
+	)
+
+	It("should mirror the NIMCache volume into the NIMService namespace", func() {
+		pvName := nimService.GetSharedNIMCachePVName("pv-nfs")
+		pvcName := nimService.GetSharedNIMCachePVCName("pv-nfs")
+		modelPVC, err := ReconcileSharedNIMCachePVC(ctx, k8sClient, scheme, nimService, nimCache)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(modelPVC.Name).To(Equal(pvcName))
+		Expect(modelPVC.SubPath).To(Equal("llama"))
+
+		pv := &corev1.PersistentVolume{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: pvName}, pv)).To(Succeed())
+		Expect(pv.Spec.NFS).To(Equal(&corev1.NFSVolumeSource{Server: "nfs.local", Path: "/exports/models"}))
+		Expect(pv.Spec.PersistentVolumeReclaimPolicy).To(Equal(corev1.PersistentVolumeReclaimRetain))
+		Expect(pv.Spec.ClaimRef.Name).To(Equal(pvcName))
+		Expect(pv.Spec.ClaimRef.Namespace).To(Equal("team-a"))
+		Expect(pv.Labels).To(HaveKeyWithValue(SharedNIMCacheLabelKey, "shared-cache"))
+		Expect(pv.Labels).To(HaveKeyWithValue(SharedNIMCacheNamespaceLabelKey, "models"))
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: pvcName, Namespace: "team-a"}, pvc)).To(Succeed())
+		Expect(pvc.Spec.VolumeName).To(Equal(pvName))
+		Expect(*pvc.Spec.StorageClassName).To(Equal("nfs"))
+		Expect(pvc.Spec.Resources.Requests[corev1.ResourceStorage]).To(Equal(apiresource.MustParse("50Gi")))
+		Expect(pvc.OwnerReferences).To(HaveLen(1))
+		Expect(pvc.OwnerReferences[0].Name).To(Equal("llm"))
+
+		// Reconciling again is a no-op
+		_, err = ReconcileSharedNIMCachePVC(ctx, k8sClient, scheme, nimService, nimCache)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(DeleteSharedNIMCachePV(ctx, k8sClient, nimService)).To(Succeed())
+		err = k8sClient.Get(ctx, types.NamespacedName{Name: pvName}, pv)
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		err = k8sClient.Get(ctx, types.NamespacedName{Name: pvcName, Namespace: "team-a"}, pvc)
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		// The volume backing the NIMCache is left untouched
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "pv-nfs"}, pv)).To(Succeed())
+		// Deleting again ignores the missing PV
+		Expect(DeleteSharedNIMCachePV(ctx, k8sClient, nimService)).To(Succeed())
+	})
+
+	It("should replace the mirror when the NIMService switches to the volume of another NIMCache", func() {
+		_, err := ReconcileSharedNIMCachePVC(ctx, k8sClient, scheme, nimService, nimCache)
+		Expect(err).ToNot(HaveOccurred())
+
+		otherCache := &appsv1alpha1.NIMCache{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-cache", Namespace: "models"},
+			Status:     appsv1alpha1.NIMCacheStatus{PVC: "other-cache-pvc"},
+		}
+		Expect(k8sClient.Create(ctx, &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-cache-pvc", Namespace: "models"},
+			Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "pv-other"},
+		})).To(Succeed())
+		Expect(k8sClient.Create(ctx, &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-other"},
+			Spec: corev1.PersistentVolumeSpec{
+				PersistentVolumeSource: corev1.PersistentVolumeSource{
+					NFS: &corev1.NFSVolumeSource{Server: "nfs.local", Path: "/exports/other"},
+				},
+			},
+		})).To(Succeed())
+
+		modelPVC, err := ReconcileSharedNIMCachePVC(ctx, k8sClient, scheme, nimService, otherCache)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(modelPVC.Name).To(Equal(nimService.GetSharedNIMCachePVCName("pv-other")))
+
+		pv := &corev1.PersistentVolume{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nimService.GetSharedNIMCachePVName("pv-other")}, pv)).To(Succeed())
+		Expect(pv.Spec.NFS.Path).To(Equal("/exports/other"))
+		Expect(pv.Labels).To(HaveKeyWithValue(SharedNIMCacheLabelKey, "other-cache"))
+
+		// The mirror of the previous volume is deleted
+		err = k8sClient.Get(ctx, types.NamespacedName{Name: nimService.GetSharedNIMCachePVName("pv-nfs")}, pv)
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		err = k8sClient.Get(ctx, types.NamespacedName{Name: nimService.GetSharedNIMCachePVCName("pv-nfs"), Namespace: "team-a"}, &corev1.PersistentVolumeClaim{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should recreate the mirror when the NIMCache volume source changed", func() {
+		_, err := ReconcileSharedNIMCachePVC(ctx, k8sClient, scheme, nimService, nimCache)
+		Expect(err).ToNot(HaveOccurred())
+
+		sourcePV := &corev1.PersistentVolume{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "pv-nfs"}, sourcePV)).To(Succeed())
+		sourcePV.Spec.NFS.Path = "/exports/models-v2"
+		Expect(k8sClient.Update(ctx, sourcePV)).To(Succeed())
+
+		// The stale mirror is deleted first
+		_, err = ReconcileSharedNIMCachePVC(ctx, k8sClient, scheme, nimService, nimCache)
+		Expect(err).To(HaveOccurred())
+		pv := &corev1.PersistentVolume{}
+		err = k8sClient.Get(ctx, types.NamespacedName{Name: nimService.GetSharedNIMCachePVName("pv-nfs")}, pv)
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+		_, err = ReconcileSharedNIMCachePVC(ctx, k8sClient, scheme, nimService, nimCache)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nimService.GetSharedNIMCachePVName("pv-nfs")}, pv)).To(Succeed())
+		Expect(pv.Spec.NFS.Path).To(Equal("/exports/models-v2"))
+	})
+
+	It("should fail when the NIMCache PVC is not bound", func() {
+		nimCache.Status.PVC = "missing-pvc"
+		_, err := ReconcileSharedNIMCachePVC(ctx, k8sClient, scheme, nimService, nimCache)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,130 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+)
+
+const (
+	// ObjectStoreHydrateContainerName is the name of the init container downloading the model from the object store.
+	ObjectStoreHydrateContainerName = "hydrate-model-store"
+)
+
+// ObjectStoreSyncCommand returns the command syncing src to dst, either of which can be an S3 URI.
+func ObjectStoreSyncCommand(objectStore *appsv1alpha1.NIMCacheObjectStore, src, dst string) []string {
+	return []string{"aws", "s3", "sync", src, dst, "--endpoint-url", objectStore.Endpoint, "--only-show-errors"}
+}
+
+// GetObjectStoreEnv returns the environment variables to access the object store with the aws cli.
+func GetObjectStoreEnv(objectStore *appsv1alpha1.NIMCacheObjectStore) []corev1.EnvVar {
+	accessKeyIDKey := objectStore.Credentials.AccessKeyIDKey
+	if accessKeyIDKey == "" {
+		accessKeyIDKey = "AWS_ACCESS_KEY_ID"
+	}
+	secretAccessKeyKey := objectStore.Credentials.SecretAccessKeyKey
+	if secretAccessKeyKey == "" {
+		secretAccessKeyKey = "AWS_SECRET_ACCESS_KEY"
+	}
+
+	envVars := []corev1.EnvVar{
+		{
+			Name: "AWS_ACCESS_KEY_ID",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: objectStore.Credentials.SecretName,
+					},
+					Key: accessKeyIDKey,
+				},
+			},
+		},
+		{
+			Name: "AWS_SECRET_ACCESS_KEY",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: objectStore.Credentials.SecretName,
+					},
+					Key: secretAccessKeyKey,
+				},
+			},
+		},
+		{
+			// aws cli needs a writable home directory when running as non-root
+			Name:  "HOME",
+			Value: "/tmp",
+		},
+		{
+			Name:  "AWS_EC2_METADATA_DISABLED",
+			Value: "true",
+		},
+	}
+	if objectStore.Region != "" {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "AWS_DEFAULT_REGION",
+			Value: objectStore.Region,
+		})
+	}
+	return envVars
+}
+
+// GetObjectStoreInitContainers returns the init containers hydrating the NIMService model store
+// from the object store the NIMCache is cached in.
+func GetObjectStoreInitContainers(nimService *appsv1alpha1.NIMService, nimCache *appsv1alpha1.NIMCache, modelPVC appsv1alpha1.PersistentVolumeClaim) []corev1.Container {
+	if nimCache == nil || !nimCache.IsObjectStoreEnabled() {
+		return nil
+	}
+
+	objectStore := nimCache.Spec.Storage.ObjectStore
+	return []corev1.Container{
+		{
+			Name:    ObjectStoreHydrateContainerName,
+			Image:   objectStore.Image,
+			Command: ObjectStoreSyncCommand(objectStore, nimCache.GetObjectStoreURI(), utils.DefaultModelStorePath),
+			Env:     GetObjectStoreEnv(objectStore),
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      "model-store",
+					MountPath: utils.DefaultModelStorePath,
+					SubPath:   modelPVC.SubPath,
+				},
+			},
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To[bool](false),
+				Capabilities: &corev1.Capabilities{
+					Drop: []corev1.Capability{"ALL"},
+				},
+				RunAsNonRoot: ptr.To[bool](true),
+				RunAsGroup:   nimService.GetGroupID(),
+				RunAsUser:    nimService.GetUserID(),
+			},
+		},
+	}
+}
+
+// GetObjectStoreImagePullSecrets returns the image pull secrets of the object store image.
+func GetObjectStoreImagePullSecrets(nimCache *appsv1alpha1.NIMCache) []string {
+	if nimCache == nil || !nimCache.IsObjectStoreEnabled() || nimCache.Spec.Storage.ObjectStore.PullSecret == "" {
+		return nil
+	}
+	return []string{nimCache.Spec.Storage.ObjectStore.PullSecret}
+}
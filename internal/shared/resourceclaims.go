@@ -19,6 +19,9 @@ package shared
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	resourcev1beta2 "k8s.io/api/resource/v1beta2"
@@ -27,13 +30,23 @@ import (
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
 	k8sutilcel "github.com/NVIDIA/k8s-nim-operator/internal/k8sutil/cel"
+	rendertypes "github.com/NVIDIA/k8s-nim-operator/internal/render/types"
 	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
 )
 
 const (
 	podClaimNamePrefix = "claim"
+
+	// nvidiaDRAConfigAPIVersion is the API version of the opaque device configs of the NVIDIA DRA driver.
+	nvidiaDRAConfigAPIVersion = "resource.nvidia.com/v1beta1"
+
+	// autoDRADeviceName is the name of the device request of auto-generated resource claim templates.
+	autoDRADeviceName = "gpu"
 )
 
+// gpuMemoryTagRegex matches the memory variant in the gpu tag of a NIM profile, e.g. 80GB in A100_SXM4_80GB.
+var gpuMemoryTagRegex = regexp.MustCompile(`^([0-9]+)gb$`)
+
 type DraResourceFieldType int
 
 const (
@@ -87,9 +100,15 @@ func UpdateContainerResourceClaims(containers []corev1.Container, resources []Na
 }
 
 func GenerateNamedDRAResources(nimService *appsv1alpha1.NIMService) []NamedDRAResource {
+	return GenerateNamedDRAResourcesForWorkload(nimService.Name, nimService.Spec.DRAResources)
+}
+
+// GenerateNamedDRAResourcesForWorkload names the DRA resources of a workload, the pod claim and the
+// generated resource claim template names being unique to the workload name.
+func GenerateNamedDRAResourcesForWorkload(workloadName string, draResources []appsv1alpha1.DRAResource) []NamedDRAResource {
 	nameCache := make(map[string]int)
-	namedDraResources := make([]NamedDRAResource, len(nimService.Spec.DRAResources))
-	for idx, resource := range nimService.Spec.DRAResources {
+	namedDraResources := make([]NamedDRAResource, len(draResources))
+	for idx, resource := range draResources {
 		namedDraResources[idx] = NamedDRAResource{
 			DRAResource: resource,
 		}
@@ -103,10 +122,13 @@ func GenerateNamedDRAResources(nimService *appsv1alpha1.NIMService) []NamedDRARe
 			namedDraResources[idx].ResourceName = *resource.ResourceClaimTemplateName
 		case ShouldCreateDRAResource(resource):
 			namedDraResources[idx].FieldType = DRAResourceFieldTypeClaimTemplate
-			namedDraResources[idx].ResourceName = generateUniqueDRAResourceName(nimService.Name, resource.ClaimCreationSpec.GetNamePrefix(), idx)
+			namedDraResources[idx].ResourceName = generateUniqueDRAResourceName(workloadName, resource.ClaimCreationSpec.GetNamePrefix(), idx)
+		case resource.Auto != nil:
+			namedDraResources[idx].FieldType = DRAResourceFieldTypeClaimTemplate
+			namedDraResources[idx].ResourceName = generateUniqueDRAResourceName(workloadName, resource.Auto.GetNamePrefix(), idx)
 		}
 
-		namedDraResources[idx].Name = generateUniquePodClaimName(nameCache, nimService.Name, namedDraResources[idx].ResourceName, namedDraResources[idx].FieldType)
+		namedDraResources[idx].Name = generateUniquePodClaimName(nameCache, workloadName, namedDraResources[idx].ResourceName, namedDraResources[idx].FieldType)
 	}
 	return namedDraResources
 }
@@ -181,10 +203,31 @@ func generateDRAResourceStatus(ctx context.Context, client client.Client, namesp
 		claimStatus := getDRAResourceClaimStatus(&claim)
 		claimTemplateStatus.ResourceClaimStatuses = append(claimTemplateStatus.ResourceClaimStatuses, *claimStatus)
 	}
+	if ShouldCreateDRAResource(resource.DRAResource) {
+		claimTemplateStatus.Devices = getDRADeviceRequestStatuses(resource.ClaimCreationSpec)
+	}
 	status.ResourceClaimTemplateStatus = &claimTemplateStatus
 	return status, nil
 }
 
+func getDRADeviceRequestStatuses(spec *appsv1alpha1.DRAClaimCreationSpec) []appsv1alpha1.DRADeviceRequestStatus {
+	statuses := make([]appsv1alpha1.DRADeviceRequestStatus, len(spec.Devices))
+	for idx, device := range spec.Devices {
+		statuses[idx] = appsv1alpha1.DRADeviceRequestStatus{
+			Name:            device.Name,
+			Count:           device.Count,
+			DeviceClassName: device.GetDeviceClassName(),
+		}
+		if device.MIG != nil {
+			statuses[idx].MIGProfile = device.MIG.Profile
+		}
+		if device.Sharing != nil {
+			statuses[idx].SharingStrategy = device.Sharing.Strategy
+		}
+	}
+	return statuses
+}
+
 func getDRAResourceClaimStatus(resourceClaim *resourcev1beta2.ResourceClaim) *appsv1alpha1.DRAResourceClaimStatusInfo {
 	claimStatus := &appsv1alpha1.DRAResourceClaimStatusInfo{
 		Name:  resourceClaim.GetName(),
@@ -197,9 +240,203 @@ func ShouldCreateDRAResource(resource appsv1alpha1.DRAResource) bool {
 	return resource.ClaimCreationSpec != nil
 }
 
+// HasAutoDRAResources returns true if any of the DRA resources is to be derived from the model profile.
+func HasAutoDRAResources(resources []NamedDRAResource) bool {
+	for _, resource := range resources {
+		if resource.Auto != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveAutoDRAResources returns the DRA resources with the claim creation spec of the auto DRA resources
+// derived from the given model profile.
+func ResolveAutoDRAResources(resources []NamedDRAResource, profile *appsv1alpha1.NIMProfile) ([]NamedDRAResource, error) {
+	resolved := make([]NamedDRAResource, len(resources))
+	for idx, resource := range resources {
+		resolved[idx] = resource
+		if resource.Auto == nil {
+			continue
+		}
+		claimCreationSpec, err := GetAutoDRAClaimCreationSpec(resource.Auto, profile)
+		if err != nil {
+			return nil, err
+		}
+		resolved[idx].ClaimCreationSpec = claimCreationSpec
+		pinDerivedDRAResourceName(&resolved[idx])
+	}
+	return resolved, nil
+}
+
+// GetAutoDRAClaimCreationSpec returns the claim creation spec of an auto DRA resource, derived from the tags of the
+// model profile: as many devices as its tensor parallelism, matching the GPU product and memory it is optimized for.
+func GetAutoDRAClaimCreationSpec(auto *appsv1alpha1.DRAAutoClaimSpec, profile *appsv1alpha1.NIMProfile) (*appsv1alpha1.DRAClaimCreationSpec, error) {
+	count := uint32(1)
+	tp, err := utils.GetTensorParallelismByProfileTags(profile.Config)
+	if err != nil {
+		return nil, err
+	}
+	if tp != "" {
+		tensorParallelism, err := strconv.ParseUint(tp, 10, 32)
+		if err != nil || tensorParallelism == 0 {
+			return nil, fmt.Errorf("invalid tensor parallelism %q in profile %s", tp, profile.Name)
+		}
+		count = uint32(tensorParallelism)
+	}
+
+	device := appsv1alpha1.DRADeviceSpec{
+		Name:            autoDRADeviceName,
+		Count:           count,
+		DeviceClassName: auto.GetDeviceClassName(),
+		DriverName:      auto.GetDriverName(),
+		// The NVIDIA DRA driver does not publish the PCI device ID of the GPUs to match the gpu_device tag against,
+		// the devices are matched on the product and memory of the gpu tag instead.
+		CELExpressions: getGPUTagCELExpressions(profile.Config["gpu"], auto.GetDriverName()),
+	}
+	return &appsv1alpha1.DRAClaimCreationSpec{
+		GenerateName: auto.GenerateName,
+		Devices:      []appsv1alpha1.DRADeviceSpec{device},
+	}, nil
+}
+
+// getGPUTagCELExpressions returns the CEL expressions matching the devices of the GPU product a profile is optimized for,
+// e.g. the NVIDIA A100-SXM4-80GB for the A100_SXM4_80GB gpu tag.
+func getGPUTagCELExpressions(gpu string, driverName string) []string {
+	if gpu == "" {
+		return nil
+	}
+
+	productTokens, memory := getGPUTagTokens(gpu)
+	productName := fmt.Sprintf("device.attributes[%q].productName.lowerAscii()", driverName)
+	var exprs []string
+	if len(productTokens) > 0 {
+		exprs = append(exprs, fmt.Sprintf("%s.matches(%q)", productName, getGPUProductPattern(productTokens)))
+	}
+	// The memory variant is either part of the product name or the GPU memory capacity
+	if memory != "" {
+		exprs = append(exprs, fmt.Sprintf("%s.contains(%q) || device.capacity[%q].memory.compareTo(quantity(%q)) >= 0",
+			productName, memory+"gb", driverName, memory+"G"))
+	}
+	return exprs
+}
+
+// getGPUTagTokens splits a gpu tag into the tokens of the product name, quoted for regular expressions,
+// and the memory in GB of the variant, if any.
+func getGPUTagTokens(gpu string) ([]string, string) {
+	var productTokens []string
+	var memory string
+	for _, token := range strings.FieldsFunc(strings.ToLower(gpu), func(r rune) bool { return r == '_' || r == '-' || r == ' ' }) {
+		if match := gpuMemoryTagRegex.FindStringSubmatch(token); match != nil {
+			memory = match[1]
+			continue
+		}
+		productTokens = append(productTokens, regexp.QuoteMeta(token))
+	}
+	return productTokens, memory
+}
+
+// getGPUProductPattern returns the regular expression matching the product names holding the given tokens in order,
+// each token being a whole word so that e.g. the A10 does not match the A100 nor the L4 the L40S.
+func getGPUProductPattern(tokens []string) string {
+	return "(^|[^a-z0-9])" + strings.Join(tokens, "[^a-z0-9](.*[^a-z0-9])?") + "([^a-z0-9]|$)"
+}
+
+// SizeDRAResources returns the DRA resources with the MIG partitions and shared GPUs of their generated
+// resource claim templates sized for the tensor parallelism of the model profile, each rank requiring its own device.
+func SizeDRAResources(resources []NamedDRAResource, tensorParallelism int) []NamedDRAResource {
+	sized := make([]NamedDRAResource, len(resources))
+	for idx, resource := range resources {
+		sized[idx] = resource
+		if !ShouldCreateDRAResource(resource.DRAResource) {
+			continue
+		}
+		sized[idx].ClaimCreationSpec = resource.ClaimCreationSpec.DeepCopy()
+		var resized bool
+		for i := range sized[idx].ClaimCreationSpec.Devices {
+			device := &sized[idx].ClaimCreationSpec.Devices[i]
+			if device.IsPartitioned() && int(device.Count) < tensorParallelism {
+				device.Count = uint32(tensorParallelism)
+				resized = true
+			}
+		}
+		if resized {
+			pinDerivedDRAResourceName(&sized[idx])
+		}
+	}
+	return sized
+}
+
+// pinDerivedDRAResourceName suffixes the name of the resource claim template generated for a DRA resource with
+// a hash of the devices derived for it from the model profile. Resource claim templates being immutable, a new
+// template is created when the profile changes.
+func pinDerivedDRAResourceName(resource *NamedDRAResource) {
+	devicesHash := utils.GetTruncatedStringHash(utils.DeepHashObject(resource.ClaimCreationSpec.Devices), 8)
+	resource.ResourceName = fmt.Sprintf("%s-%s", resource.ResourceName, devicesHash)
+}
+
+// GetDRADeviceParams returns the params to render a device request of a generated resource claim template.
+func GetDRADeviceParams(device appsv1alpha1.DRADeviceSpec) (rendertypes.DRADeviceParams, error) {
+	exprs, err := GetDRADeviceCELExpressions(device)
+	if err != nil {
+		return rendertypes.DRADeviceParams{}, err
+	}
+	return rendertypes.DRADeviceParams{
+		Name:            device.Name,
+		Count:           device.Count,
+		DeviceClassName: device.GetDeviceClassName(),
+		CELExpressions:  exprs,
+		DriverName:      device.DriverName,
+		OpaqueConfig:    getDRADeviceOpaqueConfig(device),
+	}, nil
+}
+
+// getDRADeviceOpaqueConfig returns the NVIDIA DRA driver config to share the requested GPUs or MIG partitions.
+func getDRADeviceOpaqueConfig(device appsv1alpha1.DRADeviceSpec) map[string]any {
+	if device.Sharing == nil {
+		return nil
+	}
+
+	sharing := map[string]any{
+		"strategy": string(device.Sharing.Strategy),
+	}
+	switch device.Sharing.Strategy {
+	case appsv1alpha1.DRADeviceSharingStrategyTimeSlicing:
+		if device.Sharing.TimeSlicingInterval != "" {
+			sharing["timeSlicingConfig"] = map[string]any{
+				"interval": string(device.Sharing.TimeSlicingInterval),
+			}
+		}
+	case appsv1alpha1.DRADeviceSharingStrategyMPS:
+		mpsConfig := map[string]any{}
+		if device.Sharing.MPSActiveThreadPercentage != nil {
+			mpsConfig["defaultActiveThreadPercentage"] = int64(*device.Sharing.MPSActiveThreadPercentage)
+		}
+		if device.Sharing.MPSPinnedMemoryLimit != nil {
+			mpsConfig["defaultPinnedDeviceMemoryLimit"] = device.Sharing.MPSPinnedMemoryLimit.String()
+		}
+		if len(mpsConfig) > 0 {
+			sharing["mpsConfig"] = mpsConfig
+		}
+	}
+
+	kind := "GpuConfig"
+	if device.MIG != nil {
+		kind = "MigDeviceConfig"
+	}
+	return map[string]any{
+		"apiVersion": nvidiaDRAConfigAPIVersion,
+		"kind":       kind,
+		"sharing":    sharing,
+	}
+}
+
 func GetDRADeviceCELExpressions(device appsv1alpha1.DRADeviceSpec) ([]string, error) {
 	celExpressions := make([]string, 0)
 	celExpressions = append(celExpressions, fmt.Sprintf("device.driver == %q", device.DriverName))
+	if device.MIG != nil && device.MIG.Profile != "" {
+		celExpressions = append(celExpressions, fmt.Sprintf("device.attributes[%q].profile == %q", device.DriverName, device.MIG.Profile))
+	}
 	if len(device.CELExpressions) > 0 {
 		if len(device.AttributeSelectors) > 0 || len(device.CapacitySelectors) > 0 {
 			return nil, fmt.Errorf("CELExpressions must not be set if attributeSelectors or capacitySelectors are set")
@@ -3,6 +3,8 @@ package shared
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -816,5 +818,199 @@ var _ = Describe("DRA resourceclaim tests", func() {
 			Expect(expressions[1]).To(Equal(`device.attributes["gpu.nvidia.com"].foo >= 8`))
 			Expect(expressions[2]).To(Equal(`(device.capacity["gpu.nvidia.com"].bar).compareTo(quantity("8")) >= 0`))
 		})
+
+		It("should return driver expression and MIG profile expression when MIG partitions are requested", func() {
+			device := appsv1alpha1.DRADeviceSpec{
+				DriverName: "gpu.nvidia.com",
+				MIG:        &appsv1alpha1.DRAMIGSpec{Profile: "1g.10gb"},
+			}
+
+			expressions, err := GetDRADeviceCELExpressions(device)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expressions).To(Equal([]string{
+				`device.driver == "gpu.nvidia.com"`,
+				`device.attributes["gpu.nvidia.com"].profile == "1g.10gb"`,
+			}))
+		})
+	})
+
+	Describe("GetDRADeviceParams", func() {
+		It("should not set an opaque config for full GPUs", func() {
+			params, err := GetDRADeviceParams(appsv1alpha1.DRADeviceSpec{
+				Name:            "gpu",
+				Count:           2,
+				DeviceClassName: "gpu.nvidia.com",
+				DriverName:      "gpu.nvidia.com",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(params.DeviceClassName).To(Equal("gpu.nvidia.com"))
+			Expect(params.Count).To(Equal(uint32(2)))
+			Expect(params.OpaqueConfig).To(BeNil())
+		})
+
+		It("should compile time-sliced GPUs into a GPU config", func() {
+			params, err := GetDRADeviceParams(appsv1alpha1.DRADeviceSpec{
+				Name:            "gpu",
+				Count:           1,
+				DeviceClassName: "gpu.nvidia.com",
+				DriverName:      "gpu.nvidia.com",
+				Sharing: &appsv1alpha1.DRADeviceSharingSpec{
+					Strategy:            appsv1alpha1.DRADeviceSharingStrategyTimeSlicing,
+					TimeSlicingInterval: appsv1alpha1.DRATimeSlicingIntervalLong,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(params.DriverName).To(Equal("gpu.nvidia.com"))
+			Expect(params.OpaqueConfig).To(Equal(map[string]any{
+				"apiVersion": "resource.nvidia.com/v1beta1",
+				"kind":       "GpuConfig",
+				"sharing": map[string]any{
+					"strategy":          "TimeSlicing",
+					"timeSlicingConfig": map[string]any{"interval": "Long"},
+				},
+			}))
+		})
+
+		It("should request MIG partitions shared through MPS from the MIG device class", func() {
+			params, err := GetDRADeviceParams(appsv1alpha1.DRADeviceSpec{
+				Name:            "gpu",
+				Count:           1,
+				DeviceClassName: "gpu.nvidia.com",
+				DriverName:      "gpu.nvidia.com",
+				MIG:             &appsv1alpha1.DRAMIGSpec{Profile: "3g.40gb"},
+				Sharing: &appsv1alpha1.DRADeviceSharingSpec{
+					Strategy:                  appsv1alpha1.DRADeviceSharingStrategyMPS,
+					MPSActiveThreadPercentage: ptr.To[int32](50),
+					MPSPinnedMemoryLimit:      apiresource.NewQuantity(10*1024*1024*1024, apiresource.BinarySI),
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(params.DeviceClassName).To(Equal("mig.nvidia.com"))
+			Expect(params.CELExpressions).To(ContainElement(`device.attributes["gpu.nvidia.com"].profile == "3g.40gb"`))
+			Expect(params.OpaqueConfig).To(Equal(map[string]any{
+				"apiVersion": "resource.nvidia.com/v1beta1",
+				"kind":       "MigDeviceConfig",
+				"sharing": map[string]any{
+					"strategy": "MPS",
+					"mpsConfig": map[string]any{
+						"defaultActiveThreadPercentage":  int64(50),
+						"defaultPinnedDeviceMemoryLimit": "10Gi",
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("SizeDRAResources", func() {
+		It("should size the MIG partitions and shared GPUs for the tensor parallelism", func() {
+			claimCreationSpec := &appsv1alpha1.DRAClaimCreationSpec{
+				Devices: []appsv1alpha1.DRADeviceSpec{
+					{Name: "gpu", Count: 1},
+					{Name: "mig", Count: 1, MIG: &appsv1alpha1.DRAMIGSpec{Profile: "1g.10gb"}},
+					{Name: "shared", Count: 4, Sharing: &appsv1alpha1.DRADeviceSharingSpec{Strategy: appsv1alpha1.DRADeviceSharingStrategyTimeSlicing}},
+				},
+			}
+			resources := []NamedDRAResource{
+				{Name: "claim", DRAResource: appsv1alpha1.DRAResource{ResourceClaimName: ptr.To("test-claim")}},
+				{Name: "template", DRAResource: appsv1alpha1.DRAResource{ClaimCreationSpec: claimCreationSpec}, ResourceName: "test-template"},
+			}
+
+			sized := SizeDRAResources(resources, 2)
+			Expect(sized[0]).To(Equal(resources[0]))
+			Expect(sized[1].ClaimCreationSpec.Devices[0].Count).To(Equal(uint32(1)))
+			Expect(sized[1].ClaimCreationSpec.Devices[1].Count).To(Equal(uint32(2)))
+			Expect(sized[1].ClaimCreationSpec.Devices[2].Count).To(Equal(uint32(4)))
+			// The NIMService spec is left untouched
+			Expect(claimCreationSpec.Devices[1].Count).To(Equal(uint32(1)))
+			// The resized template is given a new name as resource claim templates are immutable
+			Expect(sized[1].ResourceName).To(HavePrefix("test-template-"))
+			Expect(SizeDRAResources(resources, 4)[1].ResourceName).NotTo(Equal(sized[1].ResourceName))
+			Expect(SizeDRAResources(resources, 1)[1].ResourceName).To(Equal("test-template"))
+		})
+	})
+
+	Describe("GetAutoDRAClaimCreationSpec", func() {
+		It("should derive the devices from the profile tags", func() {
+			profile := &appsv1alpha1.NIMProfile{
+				Name:   "test-profile",
+				Config: map[string]string{"tp": "2", "gpu": "A100_SXM4_80GB", "gpu_device": "20b2:10de"},
+			}
+
+			claimCreationSpec, err := GetAutoDRAClaimCreationSpec(&appsv1alpha1.DRAAutoClaimSpec{GenerateName: "auto"}, profile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimCreationSpec.GenerateName).To(Equal("auto"))
+			Expect(claimCreationSpec.Devices).To(HaveLen(1))
+			device := claimCreationSpec.Devices[0]
+			Expect(device.Count).To(Equal(uint32(2)))
+			Expect(device.DeviceClassName).To(Equal(appsv1alpha1.DefaultDRADeviceClassName))
+			Expect(device.DriverName).To(Equal(appsv1alpha1.DefaultDRADriverName))
+			Expect(device.CELExpressions).To(Equal([]string{
+				`device.attributes["gpu.nvidia.com"].productName.lowerAscii().matches("(^|[^a-z0-9])a100[^a-z0-9](.*[^a-z0-9])?sxm4([^a-z0-9]|$)")`,
+				`device.attributes["gpu.nvidia.com"].productName.lowerAscii().contains("80gb") || device.capacity["gpu.nvidia.com"].memory.compareTo(quantity("80G")) >= 0`,
+			}))
+
+			// The derived expressions must be valid CEL
+			expressions, err := GetDRADeviceCELExpressions(device)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expressions).To(HaveLen(3))
+		})
+
+		It("should request a single device without selectors for profiles without tags", func() {
+			claimCreationSpec, err := GetAutoDRAClaimCreationSpec(&appsv1alpha1.DRAAutoClaimSpec{}, &appsv1alpha1.NIMProfile{Name: "test-profile"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimCreationSpec.Devices).To(HaveLen(1))
+			Expect(claimCreationSpec.Devices[0].Count).To(Equal(uint32(1)))
+			Expect(claimCreationSpec.Devices[0].CELExpressions).To(BeEmpty())
+		})
+
+		It("should return error for an invalid tensor parallelism", func() {
+			profile := &appsv1alpha1.NIMProfile{Name: "test-profile", Config: map[string]string{"tp": "0"}}
+
+			_, err := GetAutoDRAClaimCreationSpec(&appsv1alpha1.DRAAutoClaimSpec{}, profile)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid tensor parallelism"))
+		})
+	})
+
+	DescribeTable("should match the GPU products of the gpu tag as whole words",
+		func(gpu string, productName string, expected bool) {
+			tokens, _ := getGPUTagTokens(gpu)
+			Expect(regexp.MustCompile(getGPUProductPattern(tokens)).MatchString(strings.ToLower(productName))).To(Equal(expected))
+		},
+		Entry("A10 on A10", "A10", "NVIDIA A10", true),
+		Entry("A10 on A100", "A10", "NVIDIA A100-SXM4-80GB", false),
+		Entry("A100 on A100", "A100_SXM4_80GB", "NVIDIA A100-SXM4-80GB", true),
+		Entry("A100 SXM4 on A100 PCIe", "A100_SXM4_80GB", "NVIDIA A100 80GB PCIe", false),
+		Entry("L4 on L4", "L4", "NVIDIA L4", true),
+		Entry("L4 on L40S", "L4", "NVIDIA L40S", false),
+		Entry("L40S on L40S", "L40S", "NVIDIA L40S", true),
+		Entry("H100 on H100", "H100", "NVIDIA H100 80GB HBM3", true),
+		Entry("H100 NVL on H100", "H100_NVL", "NVIDIA H100 80GB HBM3", false),
+		Entry("H100 NVL on H100 NVL", "H100_NVL", "NVIDIA H100 NVL", true),
+		Entry("H100 on H100 NVL", "H100", "NVIDIA H100 NVL", true),
+	)
+
+	Describe("ResolveAutoDRAResources", func() {
+		It("should only resolve the auto DRA resources", func() {
+			resources := []NamedDRAResource{
+				{Name: "claim", DRAResource: appsv1alpha1.DRAResource{ResourceClaimName: ptr.To("test-claim")}},
+				{Name: "auto", DRAResource: appsv1alpha1.DRAResource{Auto: &appsv1alpha1.DRAAutoClaimSpec{}}, ResourceName: "test-template"},
+			}
+			profile := &appsv1alpha1.NIMProfile{Name: "test-profile", Config: map[string]string{"tp": "4"}}
+
+			resolved, err := ResolveAutoDRAResources(resources, profile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved[0]).To(Equal(resources[0]))
+			Expect(resolved[1].ClaimCreationSpec).NotTo(BeNil())
+			Expect(resolved[1].ClaimCreationSpec.Devices[0].Count).To(Equal(uint32(4)))
+			Expect(resources[1].ClaimCreationSpec).To(BeNil())
+			Expect(resolved[1].ResourceName).To(HavePrefix("test-template-"))
+
+			// A different profile resolves to a different resource claim template
+			profile = &appsv1alpha1.NIMProfile{Name: "test-profile-2", Config: map[string]string{"tp": "2"}}
+			resolvedAgain, err := ResolveAutoDRAResources(resources, profile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolvedAgain[1].ResourceName).NotTo(Equal(resolved[1].ResourceName))
+		})
 	})
 })
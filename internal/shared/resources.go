@@ -0,0 +1,68 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
+)
+
+// GetProfileGPUResources returns a copy of the resources with the GPUs required by the optimized profile,
+// the number of GPUs being the tensor parallelism of the profile, unless GPU resources are explicitly provided.
+func GetProfileGPUResources(resources *corev1.ResourceRequirements, profile *appsv1alpha1.NIMProfile) (*corev1.ResourceRequirements, error) {
+	// TODO: Make the resource name configurable
+	const gpuResourceName = corev1.ResourceName("nvidia.com/gpu")
+
+	if resources != nil {
+		if _, ok := resources.Requests[gpuResourceName]; ok {
+			return resources, nil
+		}
+		if _, ok := resources.Limits[gpuResourceName]; ok {
+			return resources, nil
+		}
+	}
+
+	gpuQuantity := apiResource.MustParse("1")
+	tensorParallelism, err := utils.GetTensorParallelismByProfileTags(profile.Config)
+	if err != nil {
+		return nil, err
+	}
+	if tensorParallelism != "" {
+		gpuQuantity, err = apiResource.ParseQuantity(tensorParallelism)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resources == nil {
+		resources = &corev1.ResourceRequirements{}
+	} else {
+		resources = resources.DeepCopy()
+	}
+	if resources.Requests == nil {
+		resources.Requests = corev1.ResourceList{}
+	}
+	if resources.Limits == nil {
+		resources.Limits = corev1.ResourceList{}
+	}
+	resources.Requests[gpuResourceName] = gpuQuantity
+	resources.Limits[gpuResourceName] = gpuQuantity
+	return resources, nil
+}
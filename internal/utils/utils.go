@@ -34,12 +34,15 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/dump"
 	"k8s.io/apimachinery/pkg/util/rand"
 	lwsv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
 
 	utilversion "k8s.io/apimachinery/pkg/util/version"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 // TODO: Move constants to a separate file and move the UpdateObject functions to k8sutil package.
@@ -318,7 +321,7 @@ func DeepHashObject(objToWrite any) string {
 }
 
 func UpdateObject(obj client.Object, desired client.Object) client.Object {
-	if obj == nil || desired == nil || !reflect.DeepEqual(obj.GetObjectKind(), desired.GetObjectKind()) || obj.GetName() != desired.GetName() || obj.GetNamespace() != desired.GetNamespace() {
+	if obj == nil || desired == nil || obj.GetObjectKind().GroupVersionKind() != desired.GetObjectKind().GroupVersionKind() || obj.GetName() != desired.GetName() || obj.GetNamespace() != desired.GetNamespace() {
 		panic("invalid input to UpdateObject")
 	}
 
@@ -341,6 +344,10 @@ func UpdateObject(obj client.Object, desired client.Object) client.Object {
 		return updateServiceMonitor(castedObj, desired.(*monitoringv1.ServiceMonitor)) //nolint:forcetypeassert
 	case *networkingv1.Ingress:
 		return updateIngress(castedObj, desired.(*networkingv1.Ingress)) //nolint:forcetypeassert
+	case *gatewayv1.HTTPRoute:
+		return updateHTTPRoute(castedObj, desired.(*gatewayv1.HTTPRoute)) //nolint:forcetypeassert
+	case *gatewayv1.GRPCRoute:
+		return updateGRPCRoute(castedObj, desired.(*gatewayv1.GRPCRoute)) //nolint:forcetypeassert
 	case *rbacv1.Role:
 		return updateRole(castedObj, desired.(*rbacv1.Role)) //nolint:forcetypeassert
 	case *rbacv1.RoleBinding:
@@ -349,6 +356,8 @@ func UpdateObject(obj client.Object, desired client.Object) client.Object {
 		return updateLeaderWorkerSet(castedObj, desired.(*lwsv1.LeaderWorkerSet)) //nolint:forcetypeassert
 	case *kservev1beta1.InferenceService:
 		return updateInferenceService(castedObj, desired.(*kservev1beta1.InferenceService)) //nolint:forcetypeassert
+	case *unstructured.Unstructured:
+		return updateUnstructured(castedObj, desired.(*unstructured.Unstructured)) //nolint:forcetypeassert
 	default:
 		panic("unsupported obj type")
 	}
@@ -430,6 +439,20 @@ func updateIngress(obj, desired *networkingv1.Ingress) *networkingv1.Ingress {
 	return obj
 }
 
+func updateHTTPRoute(obj, desired *gatewayv1.HTTPRoute) *gatewayv1.HTTPRoute {
+	obj.SetAnnotations(desired.GetAnnotations())
+	obj.SetLabels(desired.GetLabels())
+	obj.Spec = *desired.Spec.DeepCopy()
+	return obj
+}
+
+func updateGRPCRoute(obj, desired *gatewayv1.GRPCRoute) *gatewayv1.GRPCRoute {
+	obj.SetAnnotations(desired.GetAnnotations())
+	obj.SetLabels(desired.GetLabels())
+	obj.Spec = *desired.Spec.DeepCopy()
+	return obj
+}
+
 func updateRole(obj, desired *rbacv1.Role) *rbacv1.Role {
 	obj.SetAnnotations(desired.GetAnnotations())
 	obj.SetLabels(desired.GetLabels())
@@ -456,6 +479,14 @@ func updateInferenceService(obj, desired *kservev1beta1.InferenceService) *kserv
 	return obj
 }
 
+// updateUnstructured updates resources rendered without typed API bindings, such as KEDA ScaledObjects.
+func updateUnstructured(obj, desired *unstructured.Unstructured) *unstructured.Unstructured {
+	obj.SetAnnotations(desired.GetAnnotations())
+	obj.SetLabels(desired.GetLabels())
+	obj.Object["spec"] = runtime.DeepCopyJSONValue(desired.Object["spec"])
+	return obj
+}
+
 // GetTensorParallelismByProfileTags retrieves the tensor parallelism value from the provided tags.
 func GetTensorParallelismByProfileTags(tags map[string]string) (string, error) {
 	// List of possible keys for tensor parallelism
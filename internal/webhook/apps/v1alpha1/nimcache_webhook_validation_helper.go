@@ -18,10 +18,13 @@ package v1alpha1
 
 import (
 	"fmt"
+	"net/url"
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/robfig/cron/v3"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -132,13 +135,102 @@ func validateNIMCacheStorageConfiguration(storage *appsv1alpha1.NIMCacheStorage,
 	errList := field.ErrorList{}
 
 	// Spec.Storage must not be empty
-	if reflect.DeepEqual(storage.PVC, appsv1alpha1.PersistentVolumeClaim{}) {
+	if reflect.DeepEqual(storage.PVC, appsv1alpha1.PersistentVolumeClaim{}) && storage.ObjectStore == nil {
 		errList = append(errList, field.Required(fldPath, "must not be empty"))
 		// Don't validate PVC configuration if storage is completely empty
 		return errList
 	}
 
-	errList = append(errList, validatePVCConfiguration(&storage.PVC, fldPath.Child("pvc"))...)
+	// The PVC is optional when caching into an object store
+	if !reflect.DeepEqual(storage.PVC, appsv1alpha1.PersistentVolumeClaim{}) {
+		errList = append(errList, validatePVCConfiguration(&storage.PVC, fldPath.Child("pvc"))...)
+	}
+
+	if storage.ObjectStore != nil {
+		errList = append(errList, validateObjectStoreConfiguration(storage.ObjectStore, fldPath.Child("objectStore"))...)
+	}
+
+	return errList
+}
+
+func validateObjectStoreConfiguration(objectStore *appsv1alpha1.NIMCacheObjectStore, fldPath *field.Path) field.ErrorList {
+	errList := field.ErrorList{}
+
+	endpoint, err := url.Parse(objectStore.Endpoint)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		errList = append(errList, field.Invalid(fldPath.Child("endpoint"), objectStore.Endpoint, "must be a valid http or https URL"))
+	}
+
+	if objectStore.Bucket == "" {
+		errList = append(errList, field.Required(fldPath.Child("bucket"), "must be provided"))
+	}
+
+	if objectStore.Credentials.SecretName == "" {
+		errList = append(errList, field.Required(fldPath.Child("credentials").Child("secretName"), "must be provided"))
+	}
+
+	return errList
+}
+
+// validateRefreshConfiguration validates the checks for updates of the upstream model manifest.
+func validateRefreshConfiguration(spec *appsv1alpha1.NIMCacheSpec, fldPath *field.Path) field.ErrorList {
+	errList := field.ErrorList{}
+	refresh := spec.Refresh
+	if refresh == nil {
+		return errList
+	}
+
+	// The model manifest is only available for optimized NIMs
+	if spec.Source.NGC == nil || spec.Source.NGC.ModelEndpoint != nil {
+		errList = append(errList, field.Forbidden(fldPath, "is only supported for optimized NIMs from NGC"))
+	}
+
+	switch {
+	case refresh.Interval != nil && refresh.Schedule != nil:
+		errList = append(errList, field.Invalid(fldPath, "interval and schedule", "only one of interval or schedule must be defined"))
+	case refresh.Interval != nil:
+		if refresh.Interval.Duration < time.Minute {
+			errList = append(errList, field.Invalid(fldPath.Child("interval"), refresh.Interval.Duration.String(), "must be at least 1m"))
+		}
+	case refresh.Schedule != nil:
+		if _, err := cron.ParseStandard(*refresh.Schedule); err != nil {
+			errList = append(errList, field.Invalid(fldPath.Child("schedule"), *refresh.Schedule, fmt.Sprintf("must be a valid cron schedule: %v", err)))
+		}
+	default:
+		errList = append(errList, field.Required(fldPath, "one of interval or schedule must be defined"))
+	}
+
+	return errList
+}
+
+// validateRetentionConfiguration validates the garbage collection of the cached profiles.
+func validateRetentionConfiguration(spec *appsv1alpha1.NIMCacheSpec, fldPath *field.Path) field.ErrorList {
+	errList := field.ErrorList{}
+	retention := spec.Retention
+	if retention == nil {
+		return errList
+	}
+
+	// Profiles are only listed in the model manifest of optimized NIMs
+	if spec.Source.NGC == nil || spec.Source.NGC.ModelEndpoint != nil {
+		errList = append(errList, field.Forbidden(fldPath, "is only supported for optimized NIMs from NGC"))
+	}
+	if spec.Storage.PVC.Name == "" && (spec.Storage.PVC.Create == nil || !*spec.Storage.PVC.Create) {
+		errList = append(errList, field.Forbidden(fldPath, "is only supported for NIMCaches stored in a PVC"))
+	}
+
+	if retention.KeepReleases == nil && retention.UnreferencedFor == nil {
+		errList = append(errList, field.Required(fldPath, "at least one of keepReleases or unreferencedFor must be defined"))
+	}
+	if retention.KeepReleases != nil && *retention.KeepReleases < 1 {
+		errList = append(errList, field.Invalid(fldPath.Child("keepReleases"), *retention.KeepReleases, "must be at least 1"))
+	}
+	if retention.UnreferencedFor != nil && retention.UnreferencedFor.Duration < 0 {
+		errList = append(errList, field.Invalid(fldPath.Child("unreferencedFor"), retention.UnreferencedFor.Duration.String(), "must not be negative"))
+	}
+	if retention.Interval != nil && retention.Interval.Duration < time.Minute {
+		errList = append(errList, field.Invalid(fldPath.Child("interval"), retention.Interval.Duration.String(), "must be at least 1m"))
+	}
 
 	return errList
 }
@@ -220,6 +312,8 @@ func validateNIMCacheSpec(spec *appsv1alpha1.NIMCacheSpec, fldPath *field.Path)
 	errList = append(errList, validateNIMSourceConfiguration(&spec.Source, fldPath.Child("source"))...)
 	errList = append(errList, validateNIMCacheStorageConfiguration(&spec.Storage, fldPath.Child("storage"))...)
 	errList = append(errList, validateProxyConfiguration(spec.Proxy, fldPath.Child("proxy"))...)
+	errList = append(errList, validateRefreshConfiguration(spec, fldPath.Child("refresh"))...)
+	errList = append(errList, validateRetentionConfiguration(spec, fldPath.Child("retention"))...)
 
 	return errList
 }
@@ -227,7 +321,14 @@ func validateNIMCacheSpec(spec *appsv1alpha1.NIMCacheSpec, fldPath *field.Path)
 func validateImmutableNIMCacheSpec(oldNIMCache, newNIMCache *appsv1alpha1.NIMCache, fldPath *field.Path) field.ErrorList {
 	errList := field.ErrorList{}
 
-	if !equality.Semantic.DeepEqual(oldNIMCache.Spec, newNIMCache.Spec) {
+	// The refresh and retention policies can be changed at any time
+	oldSpec := oldNIMCache.Spec.DeepCopy()
+	newSpec := newNIMCache.Spec.DeepCopy()
+	oldSpec.Refresh = nil
+	newSpec.Refresh = nil
+	oldSpec.Retention = nil
+	newSpec.Retention = nil
+	if !equality.Semantic.DeepEqual(oldSpec, newSpec) {
 		errList = append(errList, field.Forbidden(fldPath.Child("spec"), "is immutable once the object is created"))
 	}
 
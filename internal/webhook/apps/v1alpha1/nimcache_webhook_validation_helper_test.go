@@ -18,9 +18,12 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 )
@@ -179,6 +182,41 @@ func TestValidateNIMCacheStorageConfiguration(t *testing.T) {
 			},
 			wantErrs: 0,
 		},
+		{
+			name: "object store without pvc",
+			storage: &appsv1alpha1.NIMCacheStorage{
+				ObjectStore: &appsv1alpha1.NIMCacheObjectStore{
+					Endpoint:    "http://minio.minio:9000",
+					Bucket:      "nim-cache",
+					Credentials: appsv1alpha1.ObjectStoreAccessKeys{SecretName: "minio-creds"},
+				},
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "object store invalid endpoint and missing credentials",
+			storage: &appsv1alpha1.NIMCacheStorage{
+				ObjectStore: &appsv1alpha1.NIMCacheObjectStore{
+					Endpoint: "minio:9000",
+					Bucket:   "nim-cache",
+				},
+			},
+			wantErrs: 2,
+		},
+		{
+			name: "object store with invalid staging pvc",
+			storage: &appsv1alpha1.NIMCacheStorage{
+				PVC: appsv1alpha1.PersistentVolumeClaim{
+					Create: &falseVal,
+				},
+				ObjectStore: &appsv1alpha1.NIMCacheObjectStore{
+					Endpoint:    "https://s3.us-east-1.amazonaws.com",
+					Bucket:      "nim-cache",
+					Credentials: appsv1alpha1.ObjectStoreAccessKeys{SecretName: "s3-creds"},
+				},
+			},
+			wantErrs: 1,
+		},
 	}
 
 	for _, tc := range tests {
@@ -338,6 +376,16 @@ func TestValidateImmutableNIMCacheSpec(t *testing.T) {
 			newObj:   buildCache("20Gi"),
 			wantErrs: 1,
 		},
+		{
+			name:   "refresh changed",
+			oldObj: buildCache("10Gi"),
+			newObj: func() *appsv1alpha1.NIMCache {
+				c := buildCache("10Gi")
+				c.Spec.Refresh = &appsv1alpha1.NIMCacheRefresh{Interval: &metav1.Duration{Duration: 24 * time.Hour}}
+				return c
+			}(),
+			wantErrs: 0,
+		},
 	}
 
 	for _, tc := range tests {
@@ -353,3 +401,182 @@ func TestValidateImmutableNIMCacheSpec(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateRefreshConfiguration covers the checks for updates of the upstream model manifest.
+func TestValidateRefreshConfiguration(t *testing.T) {
+	fldPath := field.NewPath("spec").Child("refresh")
+	ngcSource := appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{ModelPuller: "nvcr.io/nim/llm:1.0", PullSecret: "ngc-secret"}}
+
+	tests := []struct {
+		name     string
+		spec     *appsv1alpha1.NIMCacheSpec
+		wantErrs int
+	}{
+		{
+			name:     "refresh unset",
+			spec:     &appsv1alpha1.NIMCacheSpec{Source: ngcSource},
+			wantErrs: 0,
+		},
+		{
+			name: "valid interval",
+			spec: &appsv1alpha1.NIMCacheSpec{
+				Source:  ngcSource,
+				Refresh: &appsv1alpha1.NIMCacheRefresh{Interval: &metav1.Duration{Duration: 24 * time.Hour}, AutoUpdate: true},
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "valid schedule",
+			spec: &appsv1alpha1.NIMCacheSpec{
+				Source:  ngcSource,
+				Refresh: &appsv1alpha1.NIMCacheRefresh{Schedule: ptr.To("0 2 * * *")},
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "invalid schedule",
+			spec: &appsv1alpha1.NIMCacheSpec{
+				Source:  ngcSource,
+				Refresh: &appsv1alpha1.NIMCacheRefresh{Schedule: ptr.To("every night")},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "interval too short",
+			spec: &appsv1alpha1.NIMCacheSpec{
+				Source:  ngcSource,
+				Refresh: &appsv1alpha1.NIMCacheRefresh{Interval: &metav1.Duration{Duration: time.Second}},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "both interval and schedule",
+			spec: &appsv1alpha1.NIMCacheSpec{
+				Source:  ngcSource,
+				Refresh: &appsv1alpha1.NIMCacheRefresh{Interval: &metav1.Duration{Duration: time.Hour}, Schedule: ptr.To("@daily")},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "neither interval nor schedule",
+			spec: &appsv1alpha1.NIMCacheSpec{
+				Source:  ngcSource,
+				Refresh: &appsv1alpha1.NIMCacheRefresh{},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "huggingface source",
+			spec: &appsv1alpha1.NIMCacheSpec{
+				Source:  appsv1alpha1.NIMSource{HF: &appsv1alpha1.HuggingFaceHubSource{}},
+				Refresh: &appsv1alpha1.NIMCacheRefresh{Interval: &metav1.Duration{Duration: time.Hour}},
+			},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := validateRefreshConfiguration(tc.spec, fldPath)
+			if got := len(errs); got != tc.wantErrs {
+				t.Logf("Validation errors:")
+				for i, err := range errs {
+					t.Logf("  %d: %s", i+1, err.Error())
+				}
+				t.Fatalf("got %d errs, want %d", got, tc.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateRetentionConfiguration(t *testing.T) {
+	fldPath := field.NewPath("spec").Child("retention")
+	ngcSource := appsv1alpha1.NIMSource{NGC: &appsv1alpha1.NGCSource{ModelPuller: "nvcr.io/nim/llm:1.0", PullSecret: "ngc-secret"}}
+	pvcStorage := appsv1alpha1.NIMCacheStorage{PVC: appsv1alpha1.PersistentVolumeClaim{Create: ptr.To(true), Size: "50Gi"}}
+
+	tests := []struct {
+		name     string
+		spec     *appsv1alpha1.NIMCacheSpec
+		wantErrs int
+	}{
+		{
+			name:     "retention unset",
+			spec:     &appsv1alpha1.NIMCacheSpec{Source: ngcSource, Storage: pvcStorage},
+			wantErrs: 0,
+		},
+		{
+			name: "valid keep releases and unreferenced duration",
+			spec: &appsv1alpha1.NIMCacheSpec{
+				Source:  ngcSource,
+				Storage: pvcStorage,
+				Retention: &appsv1alpha1.NIMCacheRetention{
+					KeepReleases:    ptr.To[int32](2),
+					UnreferencedFor: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+					Interval:        &metav1.Duration{Duration: time.Hour},
+				},
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "neither keep releases nor unreferenced duration",
+			spec: &appsv1alpha1.NIMCacheSpec{
+				Source:    ngcSource,
+				Storage:   pvcStorage,
+				Retention: &appsv1alpha1.NIMCacheRetention{},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "keep no release",
+			spec: &appsv1alpha1.NIMCacheSpec{
+				Source:    ngcSource,
+				Storage:   pvcStorage,
+				Retention: &appsv1alpha1.NIMCacheRetention{KeepReleases: ptr.To[int32](0)},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "interval too short",
+			spec: &appsv1alpha1.NIMCacheSpec{
+				Source:  ngcSource,
+				Storage: pvcStorage,
+				Retention: &appsv1alpha1.NIMCacheRetention{
+					KeepReleases: ptr.To[int32](1),
+					Interval:     &metav1.Duration{Duration: time.Second},
+				},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "object store only",
+			spec: &appsv1alpha1.NIMCacheSpec{
+				Source:    ngcSource,
+				Storage:   appsv1alpha1.NIMCacheStorage{ObjectStore: &appsv1alpha1.NIMCacheObjectStore{Endpoint: "https://s3.amazonaws.com", Bucket: "models"}},
+				Retention: &appsv1alpha1.NIMCacheRetention{KeepReleases: ptr.To[int32](1)},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "huggingface source",
+			spec: &appsv1alpha1.NIMCacheSpec{
+				Source:    appsv1alpha1.NIMSource{HF: &appsv1alpha1.HuggingFaceHubSource{}},
+				Storage:   pvcStorage,
+				Retention: &appsv1alpha1.NIMCacheRetention{KeepReleases: ptr.To[int32](1)},
+			},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := validateRetentionConfiguration(tc.spec, fldPath)
+			if got := len(errs); got != tc.wantErrs {
+				t.Logf("Validation errors:")
+				for i, err := range errs {
+					t.Logf("  %d: %s", i+1, err.Error())
+				}
+				t.Fatalf("got %d errs, want %d", got, tc.wantErrs)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var nimpipelinelog = logf.Log.WithName("webhooks").WithName("NIMPipeline")
+
+// SetupNIMPipelineWebhookWithManager registers the webhook for NIMPipeline in the manager.
+func SetupNIMPipelineWebhookWithManager(mgr ctrl.Manager) error {
+	validator, err := NewNIMPipelineCustomValidator(mgr.GetAPIReader())
+	if err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).For(&appsv1alpha1.NIMPipeline{}).
+		WithValidator(validator).
+		Complete()
+}
+
+// NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
+// Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
+// +kubebuilder:webhook:path=/validate-apps-nvidia-com-v1alpha1-nimpipeline,mutating=false,failurePolicy=fail,sideEffects=None,groups=apps.nvidia.com,resources=nimpipelines,verbs=create;update,versions=v1alpha1,name=vnimpipeline-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// NIMPipelineCustomValidator struct is responsible for validating the NIMPipeline resource
+// when it is created or updated. Each service spec is validated after merging the pipeline defaults,
+// the same way as the NIMService deployed from it.
+type NIMPipelineCustomValidator struct {
+	k8sVersion string
+	// client is used to look up NIMCacheGrants for NIMCaches referenced from other namespaces.
+	client client.Reader
+}
+
+var _ webhook.CustomValidator = &NIMPipelineCustomValidator{}
+
+// NewNIMPipelineCustomValidator fetches and caches the Kubernetes version.
+func NewNIMPipelineCustomValidator(reader client.Reader) (*NIMPipelineCustomValidator, error) {
+	nimServiceValidator, err := NewNIMServiceCustomValidator(reader)
+	if err != nil {
+		return nil, err
+	}
+	return &NIMPipelineCustomValidator{k8sVersion: nimServiceValidator.k8sVersion, client: reader}, nil
+}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type NIMPipeline.
+func (v *NIMPipelineCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	nimpipeline, ok := obj.(*appsv1alpha1.NIMPipeline)
+	if !ok {
+		return nil, fmt.Errorf("expected a NIMPipeline object but got %T", obj)
+	}
+	nimpipelinelog.V(4).Info("Validation for NIMPipeline upon creation", "name", nimpipeline.GetName())
+
+	fldPath := field.NewPath("nimpipeline").Child("spec")
+	errList := validateNIMPipelineMemberNames(nimpipeline, fldPath)
+	errList = append(errList, validateNIMPipelineServices(ctx, v.client, nimpipeline, fldPath.Child("services"), v.k8sVersion)...)
+	errList = append(errList, validateNIMPipelineVerification(nimpipeline, fldPath.Child("verification"))...)
+	if len(errList) > 0 {
+		return nil, errList.ToAggregate()
+	}
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type NIMPipeline.
+func (v *NIMPipelineCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	nimpipeline, ok := newObj.(*appsv1alpha1.NIMPipeline)
+	if !ok {
+		return nil, fmt.Errorf("expected a NIMPipeline object for the newObj but got %T", newObj)
+	}
+	nimpipelinelog.V(4).Info("Validation for NIMPipeline upon update", "name", nimpipeline.GetName())
+
+	fldPath := field.NewPath("nimpipeline").Child("spec")
+	errList := validateNIMPipelineMemberNames(nimpipeline, fldPath)
+	errList = append(errList, validateNIMPipelineServices(ctx, v.client, nimpipeline, fldPath.Child("services"), v.k8sVersion)...)
+	errList = append(errList, validateNIMPipelineVerification(nimpipeline, fldPath.Child("verification"))...)
+	if len(errList) > 0 {
+		return nil, errList.ToAggregate()
+	}
+
+	return nil, nil
+}
+
+func (v *NIMPipelineCustomValidator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	// No deletion-time validation logic for NIMPipeline. Returning nil allows deletes without extra checks.
+	return nil, nil
+}
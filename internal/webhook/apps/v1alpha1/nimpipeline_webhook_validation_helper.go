@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+)
+
+// validateNIMPipelineServices validates the spec of every enabled service of the pipeline after merging the
+// pipeline defaults into it, so that a pipeline is rejected for the same reasons as the NIMServices it deploys.
+func validateNIMPipelineServices(ctx context.Context, reader client.Reader, nimPipeline *appsv1alpha1.NIMPipeline, fldPath *field.Path, kubeVersion string) field.ErrorList {
+	errList := field.ErrorList{}
+	for i := range nimPipeline.Spec.Services {
+		service := &nimPipeline.Spec.Services[i]
+		servicePath := fldPath.Index(i)
+		if service.Enabled == nil || !*service.Enabled {
+			continue
+		}
+
+		spec, err := nimPipeline.GetServiceSpec(service)
+		if err != nil {
+			errList = append(errList, field.Invalid(servicePath.Child("spec"), service.Name, err.Error()))
+			continue
+		}
+		nimService := &appsv1alpha1.NIMService{
+			ObjectMeta: metav1.ObjectMeta{Name: service.Name, Namespace: nimPipeline.GetNamespace()},
+			Spec:       *spec,
+		}
+		errList = append(errList, validateNIMServiceSpec(&nimService.Spec, servicePath.Child("spec"), kubeVersion)...)
+		errList = append(errList, validateNIMCacheGrant(ctx, reader, nimService, servicePath.Child("spec").Child("storage").Child("nimCache"))...)
+	}
+	return errList
+}
+
+// nimPipelineMemberFields maps the kinds of the pipeline services to their list in the pipeline spec.
+var nimPipelineMemberFields = map[string]string{
+	appsv1alpha1.NIMServiceKind:      "services",
+	appsv1alpha1.NemoGuardrailKind:   "guardrails",
+	appsv1alpha1.NemoEvaluatorKind:   "evaluators",
+	appsv1alpha1.NemoCustomizerKind:  "customizers",
+	appsv1alpha1.NemoDatastoreKind:   "datastores",
+	appsv1alpha1.NemoEntitystoreKind: "entitystores",
+}
+
+// validateNIMPipelineMemberNames ensures that service names are set and unique across all services of the pipeline,
+// NIMServices and NeMo microservices alike, as they name the deployed resources and their dependencies.
+func validateNIMPipelineMemberNames(nimPipeline *appsv1alpha1.NIMPipeline, fldPath *field.Path) field.ErrorList {
+	errList := field.ErrorList{}
+	names := map[string]bool{}
+	indices := map[string]int{}
+	for _, member := range nimPipeline.GetMembers() {
+		namePath := fldPath.Child(nimPipelineMemberFields[member.Kind]).Index(indices[member.Kind]).Child("name")
+		indices[member.Kind]++
+		if member.Name == "" {
+			errList = append(errList, field.Required(namePath, "is required"))
+			continue
+		}
+		if names[member.Name] {
+			errList = append(errList, field.Duplicate(namePath, member.Name))
+		}
+		names[member.Name] = true
+	}
+	return errList
+}
+
+// validateNIMPipelineVerification ensures that each verification step targets an enabled service of the pipeline.
+func validateNIMPipelineVerification(nimPipeline *appsv1alpha1.NIMPipeline, fldPath *field.Path) field.ErrorList {
+	errList := field.ErrorList{}
+	verification := nimPipeline.Spec.Verification
+	if verification == nil {
+		return errList
+	}
+
+	enabledServices := map[string]bool{}
+	for _, member := range nimPipeline.GetMembers() {
+		enabledServices[member.Name] = member.Enabled
+	}
+
+	names := map[string]bool{}
+	for i, step := range verification.Steps {
+		stepPath := fldPath.Child("steps").Index(i)
+		if names[step.Name] {
+			errList = append(errList, field.Duplicate(stepPath.Child("name"), step.Name))
+		}
+		names[step.Name] = true
+
+		enabled, exists := enabledServices[step.Service]
+		if !exists {
+			errList = append(errList, field.NotFound(stepPath.Child("service"), step.Service))
+		} else if !enabled {
+			errList = append(errList, field.Invalid(stepPath.Child("service"), step.Service, "must be an enabled service of the pipeline"))
+		}
+		if step.Method == "GET" && step.Body != "" {
+			errList = append(errList, field.Invalid(stepPath.Child("body"), step.Body, "must not be set for GET requests"))
+		}
+	}
+	return errList
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
+)
+
+// TestValidateNIMPipelineServices covers validation of the service specs merged with the pipeline defaults.
+func TestValidateNIMPipelineServices(t *testing.T) {
+	fldPath := field.NewPath("nimpipeline").Child("spec").Child("services")
+
+	service := func(name string, enabled bool, authSecret string) appsv1alpha1.NIMServicePipelineSpec {
+		return appsv1alpha1.NIMServicePipelineSpec{
+			Name:    name,
+			Enabled: ptr.To(enabled),
+			Spec: appsv1alpha1.NIMServiceSpec{
+				Image:      appsv1alpha1.Image{Repository: "repo", Tag: "latest"},
+				AuthSecret: authSecret,
+				Storage: appsv1alpha1.NIMServiceStorage{
+					NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: name + "-cache"},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		defaults *appsv1alpha1.NIMServicePipelineDefaults
+		services []appsv1alpha1.NIMServicePipelineSpec
+		wantErrs int
+	}{
+		{
+			name:     "auth secret set on the service",
+			services: []appsv1alpha1.NIMServicePipelineSpec{service("llm", true, "ngc-api-secret")},
+			wantErrs: 0,
+		},
+		{
+			name:     "valid defaults",
+			defaults: &appsv1alpha1.NIMServicePipelineDefaults{NodeSelector: map[string]string{"nvidia.com/gpu.present": "true"}},
+			services: []appsv1alpha1.NIMServicePipelineSpec{service("llm", true, "ngc-api-secret"), service("embedding", true, "ngc-api-secret")},
+			wantErrs: 0,
+		},
+		{
+			name:     "missing auth secret",
+			services: []appsv1alpha1.NIMServicePipelineSpec{service("llm", true, ""), service("embedding", true, "ngc-api-secret")},
+			wantErrs: 1,
+		},
+		{
+			name:     "disabled service is not validated",
+			services: []appsv1alpha1.NIMServicePipelineSpec{service("llm", false, "")},
+			wantErrs: 0,
+		},
+		{
+			name: "invalid merged spec",
+			defaults: &appsv1alpha1.NIMServicePipelineDefaults{
+				Scale: appsv1alpha1.Autoscaling{
+					Enabled: ptr.To(true),
+				},
+			},
+			services: []appsv1alpha1.NIMServicePipelineSpec{service("llm", true, "ngc-api-secret")},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			nimPipeline := &appsv1alpha1.NIMPipeline{
+				Spec: appsv1alpha1.NIMPipelineSpec{
+					Defaults: tc.defaults,
+					Services: tc.services,
+				},
+			}
+			errs := validateNIMPipelineServices(context.TODO(), nil, nimPipeline, fldPath, "v1.33.0")
+			if got := len(errs); got != tc.wantErrs {
+				t.Logf("Validation errors:")
+				for i, err := range errs {
+					t.Logf("  %d: %s", i+1, err.Error())
+				}
+				t.Fatalf("got %d errs, want %d", got, tc.wantErrs)
+			}
+		})
+	}
+}
+
+// TestValidateNIMPipelineMemberNames covers service names unique across NIMServices and NeMo microservices.
+func TestValidateNIMPipelineMemberNames(t *testing.T) {
+	fldPath := field.NewPath("nimpipeline").Child("spec")
+
+	tests := []struct {
+		name     string
+		spec     appsv1alpha1.NIMPipelineSpec
+		wantErrs int
+	}{
+		{
+			name: "unique names",
+			spec: appsv1alpha1.NIMPipelineSpec{
+				Services:   []appsv1alpha1.NIMServicePipelineSpec{{Name: "llm"}, {Name: "embedding"}},
+				Guardrails: []appsv1alpha1.NemoGuardrailPipelineSpec{{Name: "guardrail"}},
+				Datastores: []appsv1alpha1.NemoDatastorePipelineSpec{{Name: "datastore"}},
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "duplicate service names",
+			spec: appsv1alpha1.NIMPipelineSpec{
+				Services: []appsv1alpha1.NIMServicePipelineSpec{{Name: "llm"}, {Name: "llm"}},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "duplicate names across kinds",
+			spec: appsv1alpha1.NIMPipelineSpec{
+				Services:     []appsv1alpha1.NIMServicePipelineSpec{{Name: "llm"}},
+				Guardrails:   []appsv1alpha1.NemoGuardrailPipelineSpec{{Name: "llm"}},
+				Entitystores: []appsv1alpha1.NemoEntitystorePipelineSpec{{Name: "store"}},
+				Datastores:   []appsv1alpha1.NemoDatastorePipelineSpec{{Name: "store"}},
+			},
+			wantErrs: 2,
+		},
+		{
+			name: "missing name",
+			spec: appsv1alpha1.NIMPipelineSpec{
+				Evaluators: []appsv1alpha1.NemoEvaluatorPipelineSpec{{}},
+			},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := validateNIMPipelineMemberNames(&appsv1alpha1.NIMPipeline{Spec: tc.spec}, fldPath)
+			if got := len(errs); got != tc.wantErrs {
+				t.Logf("Validation errors:")
+				for i, err := range errs {
+					t.Logf("  %d: %s", i+1, err.Error())
+				}
+				t.Fatalf("got %d errs, want %d", got, tc.wantErrs)
+			}
+		})
+	}
+}
+
+// TestValidateNIMPipelineVerification covers the service references of the verification steps.
+func TestValidateNIMPipelineVerification(t *testing.T) {
+	fldPath := field.NewPath("nimpipeline").Child("spec").Child("verification")
+
+	services := []appsv1alpha1.NIMServicePipelineSpec{
+		{Name: "embedding", Enabled: ptr.To(true)},
+		{Name: "llm", Enabled: ptr.To(true)},
+		{Name: "reranking", Enabled: ptr.To(false)},
+	}
+
+	tests := []struct {
+		name     string
+		steps    []appsv1alpha1.NIMPipelineVerificationStep
+		wantErrs int
+	}{
+		{
+			name: "valid chain",
+			steps: []appsv1alpha1.NIMPipelineVerificationStep{
+				{Name: "embed", Service: "embedding", Path: "/v1/embeddings", Body: `{"input": ["hello"]}`},
+				{Name: "chat", Service: "llm", Path: "/v1/chat/completions", Body: `{"messages": []}`},
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "unknown service",
+			steps: []appsv1alpha1.NIMPipelineVerificationStep{
+				{Name: "embed", Service: "unknown", Path: "/v1/embeddings"},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "disabled service",
+			steps: []appsv1alpha1.NIMPipelineVerificationStep{
+				{Name: "rerank", Service: "reranking", Path: "/v1/ranking"},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "duplicate step names",
+			steps: []appsv1alpha1.NIMPipelineVerificationStep{
+				{Name: "embed", Service: "embedding", Path: "/v1/embeddings"},
+				{Name: "embed", Service: "llm", Path: "/v1/models"},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "body on GET request",
+			steps: []appsv1alpha1.NIMPipelineVerificationStep{
+				{Name: "models", Service: "llm", Path: "/v1/models", Method: "GET", Body: "{}"},
+			},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			nimPipeline := &appsv1alpha1.NIMPipeline{
+				Spec: appsv1alpha1.NIMPipelineSpec{
+					Services:     services,
+					Verification: &appsv1alpha1.NIMPipelineVerification{Steps: tc.steps},
+				},
+			}
+			errs := validateNIMPipelineVerification(nimPipeline, fldPath)
+			if got := len(errs); got != tc.wantErrs {
+				t.Logf("Validation errors:")
+				for i, err := range errs {
+					t.Logf("  %d: %s", i+1, err.Error())
+				}
+				t.Fatalf("got %d errs, want %d", got, tc.wantErrs)
+			}
+		})
+	}
+}
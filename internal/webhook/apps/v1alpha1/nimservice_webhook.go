@@ -25,6 +25,7 @@ import (
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -38,7 +39,7 @@ var nimservicelog = logf.Log.WithName("webhooks").WithName("NIMService")
 
 // SetupNIMServiceWebhookWithManager registers the webhook for NIMService in the manager.
 func SetupNIMServiceWebhookWithManager(mgr ctrl.Manager) error {
-	validator, err := NewNIMServiceCustomValidator()
+	validator, err := NewNIMServiceCustomValidator(mgr.GetAPIReader())
 	if err != nil {
 		return err
 	}
@@ -63,12 +64,14 @@ func SetupNIMServiceWebhookWithManager(mgr ctrl.Manager) error {
 // as this struct is used only for temporary operations and does not need to be deeply copied.
 type NIMServiceCustomValidator struct {
 	k8sVersion string
+	// client is used to look up NIMCacheGrants for NIMCaches referenced from other namespaces.
+	client client.Reader
 }
 
 var _ webhook.CustomValidator = &NIMServiceCustomValidator{}
 
 // NewNIMServiceCustomValidator fetches and caches the Kubernetes version.
-func NewNIMServiceCustomValidator() (*NIMServiceCustomValidator, error) {
+func NewNIMServiceCustomValidator(reader client.Reader) (*NIMServiceCustomValidator, error) {
 	config, err := rest.InClusterConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get in-cluster config: %v", err)
@@ -81,11 +84,11 @@ func NewNIMServiceCustomValidator() (*NIMServiceCustomValidator, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to get Kubernetes server version: %v", err)
 	}
-	return &NIMServiceCustomValidator{k8sVersion: versionInfo.GitVersion}, nil
+	return &NIMServiceCustomValidator{k8sVersion: versionInfo.GitVersion, client: reader}, nil
 }
 
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type NIMService.
-func (v *NIMServiceCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (v *NIMServiceCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	nimservice, ok := obj.(*appsv1alpha1.NIMService)
 	if !ok {
 		return nil, fmt.Errorf("expected a NIMService object but got %T", obj)
@@ -96,6 +99,7 @@ func (v *NIMServiceCustomValidator) ValidateCreate(_ context.Context, obj runtim
 
 	// Perform comprehensive spec validation via helper.
 	errList := validateNIMServiceSpec(&nimservice.Spec, fldPath, v.k8sVersion)
+	errList = append(errList, validateNIMCacheGrant(ctx, v.client, nimservice, fldPath.Child("storage").Child("nimCache"))...)
 
 	if len(errList) > 0 {
 		return nil, errList.ToAggregate()
@@ -105,7 +109,7 @@ func (v *NIMServiceCustomValidator) ValidateCreate(_ context.Context, obj runtim
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type NIMService.
-func (v *NIMServiceCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+func (v *NIMServiceCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	nimservice, ok := newObj.(*appsv1alpha1.NIMService)
 	if !ok {
 		return nil, fmt.Errorf("expected a NIMService object for the newObj but got %T", newObj)
@@ -115,6 +119,7 @@ func (v *NIMServiceCustomValidator) ValidateUpdate(_ context.Context, oldObj, ne
 	fldPath := field.NewPath("nimservice").Child("spec")
 	// Start with structural validation to ensure the updated object is well formed.
 	errList := validateNIMServiceSpec(&nimservice.Spec, fldPath, v.k8sVersion)
+	errList = append(errList, validateNIMCacheGrant(ctx, v.client, nimservice, fldPath.Child("storage").Child("nimCache"))...)
 
 	// All fields of NIMService.Spec are mutable, except for:
 	// - Spec.MultiNode
@@ -17,21 +17,28 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"regexp"
 	"slices"
 	"strings"
 
+	kserveconstants "github.com/kserve/kserve/pkg/constants"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/blang/semver/v4"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 	"github.com/NVIDIA/k8s-nim-operator/internal/k8sutil"
+	"github.com/NVIDIA/k8s-nim-operator/internal/shared"
 	"github.com/NVIDIA/k8s-nim-operator/internal/utils"
 )
 
@@ -60,6 +67,21 @@ var validDRAResourceQuantitySelectorOps = []appsv1alpha1.DRAResourceQuantitySele
 	appsv1alpha1.DRAResourceQuantitySelectorOpLessThanOrEqual,
 }
 
+var validDRADeviceSharingStrategies = []appsv1alpha1.DRADeviceSharingStrategy{
+	appsv1alpha1.DRADeviceSharingStrategyTimeSlicing,
+	appsv1alpha1.DRADeviceSharingStrategyMPS,
+}
+
+var validDRATimeSlicingIntervals = []appsv1alpha1.DRATimeSlicingInterval{
+	appsv1alpha1.DRATimeSlicingIntervalDefault,
+	appsv1alpha1.DRATimeSlicingIntervalShort,
+	appsv1alpha1.DRATimeSlicingIntervalMedium,
+	appsv1alpha1.DRATimeSlicingIntervalLong,
+}
+
+// migProfileRegex matches MIG profile names, e.g. 1g.10gb, 1c.3g.40gb or 1g.10gb+me.
+var migProfileRegex = regexp.MustCompile(`^([0-9]+c\.)?[0-9]+g\.[0-9]+gb(\+[a-z]+)*$`)
+
 // validateNIMServiceSpec aggregates all structural validation checks for a NIMService
 // object. It is intended to be invoked by both ValidateCreate and ValidateUpdate to
 // ensure the resource is well-formed before any other validation (e.g. immutability)
@@ -72,11 +94,16 @@ func validateNIMServiceSpec(spec *appsv1alpha1.NIMServiceSpec, fldPath *field.Pa
 	errList = append(errList, validateAuthSecret(&spec.AuthSecret, fldPath.Child("authSecret"))...)
 	errList = append(errList, validateServiceStorageConfiguration(&spec.Storage, fldPath.Child("storage"))...)
 	errList = append(errList, validateExposeConfiguration(&spec.Expose, fldPath.Child("expose").Child("ingress"))...)
+	errList = append(errList, validateRouterConfiguration(spec, fldPath.Child("expose").Child("router"))...)
 	errList = append(errList, validateMetricsConfiguration(&spec.Metrics, fldPath.Child("metrics"))...)
 	errList = append(errList, validateScaleConfiguration(&spec.Scale, fldPath.Child("scale"))...)
 	errList = append(errList, validateResourcesConfiguration(spec.Resources, fldPath.Child("resources"))...)
 	errList = append(errList, validateDRAResourcesConfiguration(spec, fldPath, kubeVersion)...)
 	errList = append(errList, validateKServeConfiguration(spec, fldPath)...)
+	errList = append(errList, validateRolloutConfiguration(spec, fldPath)...)
+	errList = append(errList, validateLoRAConfiguration(spec, fldPath)...)
+	errList = append(errList, validateLLMDConfiguration(spec, fldPath, kubeVersion)...)
+	errList = append(errList, validateMultiModelConfiguration(spec, fldPath)...)
 
 	return errList
 }
@@ -132,7 +159,7 @@ func validateServiceStorageConfiguration(storage *appsv1alpha1.NIMServiceStorage
 	}
 
 	// If NIMCache is non-nil, NIMCache.Name must not be empty
-	if storage.NIMCache.Profile != "" {
+	if storage.NIMCache.Profile != "" || storage.NIMCache.Namespace != "" {
 		if storage.NIMCache.Name == "" {
 			errList = append(errList, field.Required(fldPath.Child("nimCache").Child("name"), fmt.Sprintf("is required when %s is defined", fldPath.Child("nimCache"))))
 		}
@@ -147,11 +174,38 @@ func validateServiceStorageConfiguration(storage *appsv1alpha1.NIMServiceStorage
 }
 
 func validateDRAResourcesConfiguration(spec *appsv1alpha1.NIMServiceSpec, fldPath *field.Path, k8sVersion string) field.ErrorList {
+	scaleEnabled := spec.Scale.Enabled != nil && *spec.Scale.Enabled
+	errList := validateDRAResources(spec.DRAResources, spec.Replicas, scaleEnabled, fldPath, k8sVersion)
+
+	// Auto DRA resources are derived from the NIMCache profile of single-node standalone deployments
+	for i, dra := range spec.DRAResources {
+		if dra.Auto == nil {
+			continue
+		}
+		autoPath := fldPath.Child("draResources").Index(i).Child("auto")
+		switch {
+		case spec.InferencePlatform != "" && spec.InferencePlatform != appsv1alpha1.PlatformTypeStandalone:
+			errList = append(errList, field.Forbidden(autoPath, fmt.Sprintf("can only be set when %s is %s", fldPath.Child("inferencePlatform"), appsv1alpha1.PlatformTypeStandalone)))
+		case spec.MultiNode != nil:
+			errList = append(errList, field.Forbidden(autoPath, fmt.Sprintf("must not be set when %s is set", fldPath.Child("multiNode"))))
+		case spec.Storage.NIMCache.Name == "":
+			errList = append(errList, field.Required(fldPath.Child("storage").Child("nimCache").Child("name"), fmt.Sprintf("is required when %s is set", autoPath)))
+		// The profiles of the NIMCache grow with the engines built for it, so the profile to derive from must be explicit
+		case spec.Storage.NIMCache.Profile == "" && spec.Storage.NIMCache.NIMBuild == "":
+			errList = append(errList, field.Required(fldPath.Child("storage").Child("nimCache").Child("profile"), fmt.Sprintf("is required when %s is set without %s", autoPath, fldPath.Child("storage").Child("nimCache").Child("nimBuild"))))
+		}
+	}
+	return errList
+}
+
+// validateDRAResources validates the DRA resources of a workload with the given replicas, fldPath being the
+// path of the workload spec holding the draResources, replicas and scale fields.
+func validateDRAResources(draResources []appsv1alpha1.DRAResource, replicas int, scaleEnabled bool, fldPath *field.Path, k8sVersion string) field.ErrorList {
 	errList := field.ErrorList{}
 	draResourcesPath := fldPath.Child("draResources")
 
 	// If the length is > 0, check k8s compatibility version
-	if len(spec.DRAResources) > 0 {
+	if len(draResources) > 0 {
 		if !utils.IsVersionGreaterThanOrEqual(k8sVersion, utils.MinSupportedClusterVersionForDRA) {
 			errList = append(errList, field.Forbidden(draResourcesPath, fmt.Sprintf("is not supported by NIM-Operator on this cluster, please upgrade to k8s version '%s' or higher", utils.MinSupportedClusterVersionForDRA)))
 		}
@@ -159,12 +213,13 @@ func validateDRAResourcesConfiguration(spec *appsv1alpha1.NIMServiceSpec, fldPat
 
 	seen := make(map[string]struct{})
 
-	for i, dra := range spec.DRAResources {
+	for i, dra := range draResources {
 		idxPath := draResourcesPath.Index(i)
 
 		hasName := dra.ResourceClaimName != nil && *dra.ResourceClaimName != ""
 		hasTemplate := dra.ResourceClaimTemplateName != nil && *dra.ResourceClaimTemplateName != ""
 		hasSpec := dra.ClaimCreationSpec != nil
+		hasAuto := dra.Auto != nil
 
 		var fieldCount int
 		if hasName {
@@ -176,26 +231,29 @@ func validateDRAResourcesConfiguration(spec *appsv1alpha1.NIMServiceSpec, fldPat
 		if hasSpec {
 			fieldCount++
 		}
+		if hasAuto {
+			fieldCount++
+		}
 
-		// Exactly one of resourceClaimName, resourceClaimTemplateName, or claimCreationSpec must be provided
+		// Exactly one of resourceClaimName, resourceClaimTemplateName, claimCreationSpec, or auto must be provided
 		if fieldCount == 0 {
-			errList = append(errList, field.Required(idxPath, fmt.Sprintf("one of %s, %s, or %s must be provided", fldPath.Child("resourceClaimName"), fldPath.Child("resourceClaimTemplateName"), fldPath.Child("claimCreationSpec"))))
+			errList = append(errList, field.Required(idxPath, fmt.Sprintf("one of %s, %s, %s, or %s must be provided", fldPath.Child("resourceClaimName"), fldPath.Child("resourceClaimTemplateName"), fldPath.Child("claimCreationSpec"), fldPath.Child("auto"))))
 		} else if fieldCount > 1 {
 			errList = append(errList, field.Invalid(
 				idxPath,
 				"multiple dra resource sources defined",
-				fmt.Sprintf("must specify exactly one of %s, %s, or %s", fldPath.Child("resourceClaimName"), fldPath.Child("resourceClaimTemplateName"), fldPath.Child("claimCreationSpec"))))
+				fmt.Sprintf("must specify exactly one of %s, %s, %s, or %s", fldPath.Child("resourceClaimName"), fldPath.Child("resourceClaimTemplateName"), fldPath.Child("claimCreationSpec"), fldPath.Child("auto"))))
 		}
 
 		if hasName {
 			// resourceClaimName: spec.relicas must be <= 1 and spec.scale.enabled must be false.
-			if spec.Replicas > 1 {
+			if replicas > 1 {
 				errList = append(errList, field.Forbidden(
 					idxPath.Child("resourceClaimName"),
 					fmt.Sprintf("must not be set when %s > 1, use %s instead", fldPath.Child("replicas"), idxPath.Child("resourceClaimTemplateName")),
 				))
 			}
-			if spec.Scale.Enabled != nil && *spec.Scale.Enabled {
+			if scaleEnabled {
 				errList = append(errList, field.Forbidden(
 					idxPath.Child("resourceClaimName"),
 					fmt.Sprintf("must not be set when %s is true, use %s instead", fldPath.Child("scale").Child("enabled"), idxPath.Child("resourceClaimTemplateName")),
@@ -266,6 +324,49 @@ func validateDRADeviceSpec(device *appsv1alpha1.DRADeviceSpec, fldPath *field.Pa
 		errList = append(errList, validateDRAResourceQuantitySelector(&selector, fldPath.Child("capacitySelectors").Index(idx))...)
 	}
 
+	if device.MIG != nil && device.MIG.Profile != "" && !migProfileRegex.MatchString(device.MIG.Profile) {
+		errList = append(errList, field.Invalid(fldPath.Child("mig").Child("profile"), device.MIG.Profile, "must be a valid MIG profile, e.g. 1g.10gb"))
+	}
+	if device.Sharing != nil {
+		errList = append(errList, validateDRADeviceSharingSpec(device.Sharing, device.MIG != nil, fldPath.Child("sharing"))...)
+	}
+
+	return errList
+}
+
+func validateDRADeviceSharingSpec(sharing *appsv1alpha1.DRADeviceSharingSpec, isMIG bool, fldPath *field.Path) field.ErrorList {
+	errList := field.ErrorList{}
+	if !slices.Contains(validDRADeviceSharingStrategies, sharing.Strategy) {
+		errList = append(errList, field.Invalid(fldPath.Child("strategy"), sharing.Strategy, fmt.Sprintf("must be one of %v", validDRADeviceSharingStrategies)))
+	}
+
+	if sharing.TimeSlicingInterval != "" {
+		switch {
+		case sharing.Strategy != appsv1alpha1.DRADeviceSharingStrategyTimeSlicing:
+			errList = append(errList, field.Forbidden(fldPath.Child("timeSlicingInterval"), fmt.Sprintf("is only supported when %s is %s", fldPath.Child("strategy"), appsv1alpha1.DRADeviceSharingStrategyTimeSlicing)))
+		case isMIG:
+			errList = append(errList, field.Forbidden(fldPath.Child("timeSlicingInterval"), "is not supported for MIG partitions"))
+		case !slices.Contains(validDRATimeSlicingIntervals, sharing.TimeSlicingInterval):
+			errList = append(errList, field.Invalid(fldPath.Child("timeSlicingInterval"), sharing.TimeSlicingInterval, fmt.Sprintf("must be one of %v", validDRATimeSlicingIntervals)))
+		}
+	}
+
+	if sharing.Strategy != appsv1alpha1.DRADeviceSharingStrategyMPS {
+		if sharing.MPSActiveThreadPercentage != nil {
+			errList = append(errList, field.Forbidden(fldPath.Child("mpsActiveThreadPercentage"), fmt.Sprintf("is only supported when %s is %s", fldPath.Child("strategy"), appsv1alpha1.DRADeviceSharingStrategyMPS)))
+		}
+		if sharing.MPSPinnedMemoryLimit != nil {
+			errList = append(errList, field.Forbidden(fldPath.Child("mpsPinnedMemoryLimit"), fmt.Sprintf("is only supported when %s is %s", fldPath.Child("strategy"), appsv1alpha1.DRADeviceSharingStrategyMPS)))
+		}
+		return errList
+	}
+
+	if sharing.MPSActiveThreadPercentage != nil && (*sharing.MPSActiveThreadPercentage < 1 || *sharing.MPSActiveThreadPercentage > 100) {
+		errList = append(errList, field.Invalid(fldPath.Child("mpsActiveThreadPercentage"), *sharing.MPSActiveThreadPercentage, "must be between 1 and 100"))
+	}
+	if sharing.MPSPinnedMemoryLimit != nil && sharing.MPSPinnedMemoryLimit.Sign() <= 0 {
+		errList = append(errList, field.Invalid(fldPath.Child("mpsPinnedMemoryLimit"), sharing.MPSPinnedMemoryLimit.String(), "must be > 0"))
+	}
 	return errList
 }
 
@@ -362,6 +463,45 @@ func validateExposeConfiguration(expose *appsv1alpha1.Expose, fldPath *field.Pat
 	return errList
 }
 
+// validateRouterConfiguration validates the Gateway API routes of a NIMService.
+func validateRouterConfiguration(spec *appsv1alpha1.NIMServiceSpec, fldPath *field.Path) field.ErrorList {
+	errList := field.ErrorList{}
+	router := spec.Expose.Router
+	if router == nil {
+		return errList
+	}
+
+	// Routes are only managed for the standalone platform, KServe manages its own networking.
+	if spec.InferencePlatform == appsv1alpha1.PlatformTypeKServe {
+		errList = append(errList, field.Forbidden(fldPath, "cannot be set when inferencePlatform is kserve"))
+	}
+	if router.Gateway.Name == "" {
+		errList = append(errList, field.Required(fldPath.Child("gateway").Child("name"), "is required"))
+	}
+	seen := make(map[string]bool, len(router.Hostnames))
+	for i, hostname := range router.Hostnames {
+		if seen[hostname] {
+			errList = append(errList, field.Duplicate(fldPath.Child("hostnames").Index(i), hostname))
+		}
+		seen[hostname] = true
+	}
+
+	if pool := router.InferencePool; pool != nil {
+		poolPath := fldPath.Child("inferencePool")
+		if pool.Name == "" {
+			errList = append(errList, field.Required(poolPath.Child("name"), "is required"))
+		}
+		if pool.EndpointPicker.Name == "" {
+			errList = append(errList, field.Required(poolPath.Child("endpointPicker").Child("name"), "is required"))
+		}
+		// The pool selects pods by label, which would include the worker pods of a multi-node deployment.
+		if spec.MultiNode != nil {
+			errList = append(errList, field.Forbidden(poolPath, "cannot be set when spec.multiNode is set"))
+		}
+	}
+	return errList
+}
+
 // If Spec.Metrics.Enabled is true, Spec.Metrics.ServiceMonitor must not be empty.
 func validateMetricsConfiguration(metrics *appsv1alpha1.Metrics, fldPath *field.Path) field.ErrorList {
 	errList := field.ErrorList{}
@@ -373,13 +513,51 @@ func validateMetricsConfiguration(metrics *appsv1alpha1.Metrics, fldPath *field.
 	return errList
 }
 
-// If Spec.Scale.Enabled is true, Spec.Scale.HPA must be non-empty HorizontalPodAutoScaler.
+// If Spec.Scale.Enabled is true, Spec.Scale.HPA must be non-empty HorizontalPodAutoScaler,
+// or Spec.Scale.KEDA must be defined when the KEDA backend is selected.
 func validateScaleConfiguration(scale *appsv1alpha1.Autoscaling, fldPath *field.Path) field.ErrorList {
 	errList := field.ErrorList{}
-	if scale.Enabled != nil && *scale.Enabled {
-		if reflect.DeepEqual(scale.HPA, appsv1alpha1.HorizontalPodAutoscalerSpec{}) {
-			errList = append(errList, field.Required(fldPath.Child("hpa"), fmt.Sprintf("must be defined if %s is true", fldPath.Child("enabled"))))
+	if scale.Enabled == nil || !*scale.Enabled {
+		return errList
+	}
+
+	if scale.GetBackend() == appsv1alpha1.AutoscalingBackendKEDA {
+		if scale.KEDA == nil {
+			errList = append(errList, field.Required(fldPath.Child("keda"), fmt.Sprintf("must be defined if %s is %s", fldPath.Child("backend"), appsv1alpha1.AutoscalingBackendKEDA)))
+			return errList
 		}
+		errList = append(errList, validateKEDAConfiguration(scale.KEDA, fldPath.Child("keda"))...)
+		return errList
+	}
+
+	if reflect.DeepEqual(scale.HPA, appsv1alpha1.HorizontalPodAutoscalerSpec{}) {
+		errList = append(errList, field.Required(fldPath.Child("hpa"), fmt.Sprintf("must be defined if %s is true", fldPath.Child("enabled"))))
+	}
+	return errList
+}
+
+// validateKEDAConfiguration implements required KEDA ScaledObject validations.
+func validateKEDAConfiguration(keda *appsv1alpha1.KEDAScaledObjectSpec, fldPath *field.Path) field.ErrorList {
+	errList := field.ErrorList{}
+
+	if keda.MinReplicas != nil && *keda.MinReplicas > keda.MaxReplicas {
+		errList = append(errList, field.Invalid(fldPath.Child("minReplicas"), *keda.MinReplicas, fmt.Sprintf("must be less than or equal to %s", fldPath.Child("maxReplicas"))))
+	}
+
+	customQuery := false
+	for i, trigger := range keda.Triggers {
+		if (trigger.Metric == "") == (trigger.Query == "") {
+			errList = append(errList, field.Invalid(fldPath.Child("triggers").Index(i), trigger, "exactly one of metric or query must be set"))
+		}
+		if trigger.Query != "" {
+			customQuery = true
+		}
+	}
+
+	// NIM metrics are only exported by running replicas, so scaling up from zero needs a trigger on a metric
+	// that outlives them, such as the request rate reported by a gateway.
+	if keda.MinReplicas != nil && *keda.MinReplicas == 0 && !customQuery {
+		errList = append(errList, field.Invalid(fldPath.Child("minReplicas"), 0, fmt.Sprintf("scaling to zero requires at least one %s with a query not based on NIM metrics", fldPath.Child("triggers"))))
 	}
 	return errList
 }
@@ -402,10 +580,21 @@ func validateKServeConfiguration(spec *appsv1alpha1.NIMServiceSpec, fldPath *fie
 	platformIsKServe := spec.InferencePlatform == appsv1alpha1.PlatformTypeKServe
 
 	// mode is the value, and annotated is true if the key-value pair exist.
-	mode, annotated := spec.Annotations["serving.kserve.org/deploymentMode"]
+	mode, annotated := spec.Annotations[kserveconstants.DeploymentMode]
+	if spec.KServe != nil && spec.KServe.DeploymentMode != "" {
+		// The explicit deployment mode must not conflict with the annotation.
+		if annotated && !strings.EqualFold(mode, string(spec.KServe.DeploymentMode)) {
+			errList = append(errList, field.Invalid(fldPath.Child("kserve").Child("deploymentMode"), spec.KServe.DeploymentMode, fmt.Sprintf("conflicts with the %s annotation %q", kserveconstants.DeploymentMode, mode)))
+		}
+		mode, annotated = string(spec.KServe.DeploymentMode), true
+	}
 	// If the annotation is absent, kserve defaults to serverless.
 	serverless := !annotated || strings.EqualFold(mode, "serverless")
 
+	if spec.KServe != nil {
+		errList = append(errList, validateKServeSpec(spec, serverless, fldPath)...)
+	}
+
 	// When Spec.InferencePlatform is "kserve" and used in "serverless" mode:
 	if platformIsKServe && serverless {
 		// Spec.Scale (autoscaling) cannot be set.
@@ -424,11 +613,257 @@ func validateKServeConfiguration(spec *appsv1alpha1.NIMServiceSpec, fldPath *fie
 		}
 	}
 
-	// Spec.MultiNode cannot be enabled when inferencePlatform is kserve.
+	// Spec.Scale.Backend cannot be KEDA when inferencePlatform is kserve, which manages its own autoscaler.
+	if platformIsKServe && spec.Scale.GetBackend() == appsv1alpha1.AutoscalingBackendKEDA {
+		errList = append(errList, field.Forbidden(fldPath.Child("scale").Child("backend"), fmt.Sprintf("%s is not supported when %s is %s", appsv1alpha1.AutoscalingBackendKEDA, fldPath.Child("inferencePlatform"), appsv1alpha1.PlatformTypeKServe)))
+	}
+
+	// Spec.MultiNode is only supported by KServe in RawDeployment mode, with a single replica of the predictor group.
 	if platformIsKServe && spec.MultiNode != nil {
-		errList = append(errList, field.Forbidden(fldPath.Child("multiNode"), "cannot be set when KServe runs in serverless mode"))
+		if serverless {
+			errList = append(errList, field.Forbidden(fldPath.Child("multiNode"), "cannot be set when KServe runs in serverless mode"))
+		}
+		if pipeline := spec.MultiNode.Parallelism; pipeline != nil && pipeline.Pipeline != nil && *pipeline.Pipeline < 2 {
+			errList = append(errList, field.Invalid(fldPath.Child("multiNode").Child("parallelism").Child("pipeline"), *pipeline.Pipeline, fmt.Sprintf("must be at least 2 when %s is %s", fldPath.Child("inferencePlatform"), appsv1alpha1.PlatformTypeKServe)))
+		}
+		if spec.Replicas > 1 {
+			errList = append(errList, field.Invalid(fldPath.Child("replicas"), spec.Replicas, fmt.Sprintf("must be 1 when %s is set and %s is %s", fldPath.Child("multiNode"), fldPath.Child("inferencePlatform"), appsv1alpha1.PlatformTypeKServe)))
+		}
+	}
+
+	return errList
+}
+
+// validateKServeSpec validates the KServe specific options of a NIMService.
+func validateKServeSpec(spec *appsv1alpha1.NIMServiceSpec, serverless bool, fldPath *field.Path) field.ErrorList {
+	errList := field.ErrorList{}
+	kservePath := fldPath.Child("kserve")
+
+	if spec.InferencePlatform != appsv1alpha1.PlatformTypeKServe {
+		errList = append(errList, field.Forbidden(kservePath, fmt.Sprintf("can only be set when %s is %s", fldPath.Child("inferencePlatform"), appsv1alpha1.PlatformTypeKServe)))
+		return errList
 	}
 
+	knative := spec.KServe.Serverless
+	if knative == nil {
+		return errList
+	}
+	if !serverless {
+		errList = append(errList, field.Forbidden(kservePath.Child("serverless"), "can only be set when KServe runs in serverless mode"))
+		return errList
+	}
+	if knative.MinScale != nil && knative.MaxScale != nil && *knative.MinScale > *knative.MaxScale {
+		errList = append(errList, field.Invalid(kservePath.Child("serverless").Child("minScale"), *knative.MinScale, fmt.Sprintf("must be less than or equal to %s", kservePath.Child("serverless").Child("maxScale"))))
+	}
+	if knative.ScaleToZeroGracePeriod != nil {
+		if knative.MinScale == nil || *knative.MinScale != 0 {
+			errList = append(errList, field.Forbidden(kservePath.Child("serverless").Child("scaleToZeroGracePeriod"), fmt.Sprintf("can only be set when %s is 0", kservePath.Child("serverless").Child("minScale"))))
+		} else if knative.ScaleToZeroGracePeriod.Duration < 0 {
+			errList = append(errList, field.Invalid(kservePath.Child("serverless").Child("scaleToZeroGracePeriod"), knative.ScaleToZeroGracePeriod.Duration.String(), "must not be negative"))
+		}
+	}
+	return errList
+}
+
+// validateRolloutConfiguration implements required rollout validations.
+func validateRolloutConfiguration(spec *appsv1alpha1.NIMServiceSpec, fldPath *field.Path) field.ErrorList {
+	errList := field.ErrorList{}
+	if spec.Rollout == nil {
+		return errList
+	}
+
+	strategy := spec.Rollout.Strategy
+	if strategy != appsv1alpha1.RolloutStrategyCanary && spec.Rollout.Canary != nil {
+		errList = append(errList, field.Forbidden(fldPath.Child("rollout").Child("canary"), fmt.Sprintf("can only be set when %s is %s", fldPath.Child("rollout").Child("strategy"), appsv1alpha1.RolloutStrategyCanary)))
+	}
+
+	if strategy != appsv1alpha1.RolloutStrategyCanary && strategy != appsv1alpha1.RolloutStrategyBlueGreen {
+		return errList
+	}
+
+	// Progressive rollouts are only supported for single-node standalone deployments.
+	if spec.InferencePlatform == appsv1alpha1.PlatformTypeKServe {
+		errList = append(errList, field.Forbidden(fldPath.Child("rollout").Child("strategy"), fmt.Sprintf("%s is not supported when %s is %s", strategy, fldPath.Child("inferencePlatform"), appsv1alpha1.PlatformTypeKServe)))
+	}
+	if spec.MultiNode != nil {
+		errList = append(errList, field.Forbidden(fldPath.Child("rollout").Child("strategy"), fmt.Sprintf("%s is not supported when %s is set", strategy, fldPath.Child("multiNode"))))
+	}
+
+	return errList
+}
+
+// validateLoRAConfiguration implements required LoRA adapter validations.
+func validateLoRAConfiguration(spec *appsv1alpha1.NIMServiceSpec, fldPath *field.Path) field.ErrorList {
+	errList := field.ErrorList{}
+	if spec.LoRA == nil {
+		return errList
+	}
+
+	// LoRA adapters are only supported for single-node deployments.
+	if spec.MultiNode != nil {
+		errList = append(errList, field.Forbidden(fldPath.Child("lora"), fmt.Sprintf("cannot be set when %s is set", fldPath.Child("multiNode"))))
+	}
+
+	names := map[string]bool{}
+	for i, adapter := range spec.LoRA.Adapters {
+		if names[adapter.Name] {
+			errList = append(errList, field.Duplicate(fldPath.Child("lora").Child("adapters").Index(i).Child("name"), adapter.Name))
+		}
+		names[adapter.Name] = true
+	}
+
+	return errList
+}
+
+// validateLLMDConfiguration implements required llm-d validations.
+// The llm-d platform renders a single-node prefill and decode deployment pair, fronted by the routing sidecar.
+func validateLLMDConfiguration(spec *appsv1alpha1.NIMServiceSpec, fldPath *field.Path, kubeVersion string) field.ErrorList {
+	errList := field.ErrorList{}
+	platformPath := fldPath.Child("inferencePlatform")
+
+	if spec.InferencePlatform != appsv1alpha1.PlatformTypeLLMD {
+		if spec.LLMD != nil {
+			errList = append(errList, field.Forbidden(fldPath.Child("llmd"), fmt.Sprintf("can only be set when %s is %s", platformPath, appsv1alpha1.PlatformTypeLLMD)))
+		}
+		return errList
+	}
+
+	notSupported := fmt.Sprintf("is not supported when %s is %s", platformPath, appsv1alpha1.PlatformTypeLLMD)
+	if spec.MultiNode != nil {
+		errList = append(errList, field.Forbidden(fldPath.Child("multiNode"), notSupported))
+	}
+	if spec.Scale.Enabled != nil && *spec.Scale.Enabled {
+		errList = append(errList, field.Forbidden(fldPath.Child("scale").Child("enabled"), notSupported))
+	}
+	if spec.Rollout != nil && spec.Rollout.Strategy != "" && spec.Rollout.Strategy != appsv1alpha1.RolloutStrategyRollingUpdate {
+		errList = append(errList, field.Forbidden(fldPath.Child("rollout").Child("strategy"), fmt.Sprintf("%s %s", spec.Rollout.Strategy, notSupported)))
+	}
+	if spec.LoRA != nil {
+		errList = append(errList, field.Forbidden(fldPath.Child("lora"), notSupported))
+	}
+	if len(spec.DRAResources) > 0 {
+		errList = append(errList, field.Forbidden(fldPath.Child("draResources"), fmt.Sprintf("%s, use %s and %s instead", notSupported, fldPath.Child("llmd").Child("prefill").Child("draResources"), fldPath.Child("llmd").Child("decode").Child("draResources"))))
+	}
+	if spec.Expose.Router != nil && spec.Expose.Router.InferencePool != nil {
+		errList = append(errList, field.Forbidden(fldPath.Child("expose").Child("router").Child("inferencePool"), notSupported))
+	}
+	if spec.Expose.Service.Port != nil && *spec.Expose.Service.Port == spec.LLMD.GetDecodeTargetPort() {
+		errList = append(errList, field.Invalid(fldPath.Child("llmd").Child("routingSidecar").Child("targetPort"), spec.LLMD.GetDecodeTargetPort(), fmt.Sprintf("must be different from %s", fldPath.Child("expose").Child("service").Child("port"))))
+	}
+	if spec.LLMD != nil {
+		for _, role := range []appsv1alpha1.LLMDRole{appsv1alpha1.LLMDRolePrefill, appsv1alpha1.LLMDRoleDecode} {
+			roleSpec := spec.LLMD.Decode
+			if role == appsv1alpha1.LLMDRolePrefill {
+				roleSpec = spec.LLMD.Prefill
+			}
+			replicas := spec.Replicas
+			if roleSpec.Replicas != nil {
+				replicas = int(*roleSpec.Replicas)
+			}
+			errList = append(errList, validateDRAResources(roleSpec.DRAResources, replicas, false, fldPath.Child("llmd").Child(string(role)), kubeVersion)...)
+			for i, dra := range roleSpec.DRAResources {
+				if dra.Auto != nil {
+					errList = append(errList, field.Forbidden(fldPath.Child("llmd").Child(string(role)).Child("draResources").Index(i).Child("auto"),
+						fmt.Sprintf("can only be set when %s is %s", fldPath.Child("inferencePlatform"), appsv1alpha1.PlatformTypeStandalone)))
+				}
+			}
+		}
+	}
+	return errList
+}
+
+// validateMultiModelConfiguration implements required multi-model validations.
+// The additional models are served by NIM containers of the standalone deployment, behind the model router on the API port.
+func validateMultiModelConfiguration(spec *appsv1alpha1.NIMServiceSpec, fldPath *field.Path) field.ErrorList {
+	errList := field.ErrorList{}
+	if spec.MultiModel == nil {
+		return errList
+	}
+	multiModelPath := fldPath.Child("multiModel")
+
+	notSupported := fmt.Sprintf("is not supported with %s", multiModelPath)
+	if spec.InferencePlatform != "" && spec.InferencePlatform != appsv1alpha1.PlatformTypeStandalone {
+		errList = append(errList, field.Forbidden(multiModelPath, fmt.Sprintf("can only be set when %s is %s", fldPath.Child("inferencePlatform"), appsv1alpha1.PlatformTypeStandalone)))
+	}
+	if spec.MultiNode != nil {
+		errList = append(errList, field.Forbidden(fldPath.Child("multiNode"), notSupported))
+	}
+	if spec.LoRA != nil {
+		errList = append(errList, field.Forbidden(fldPath.Child("lora"), notSupported))
+	}
+	if len(spec.DRAResources) > 0 {
+		errList = append(errList, field.Forbidden(fldPath.Child("draResources"), notSupported))
+	}
+	if spec.Rollout != nil && spec.Rollout.Strategy != "" && spec.Rollout.Strategy != appsv1alpha1.RolloutStrategyRollingUpdate {
+		errList = append(errList, field.Forbidden(fldPath.Child("rollout").Child("strategy"), fmt.Sprintf("%s %s", spec.Rollout.Strategy, notSupported)))
+	}
+	if spec.Expose.Service.GRPCPort != nil {
+		errList = append(errList, field.Forbidden(fldPath.Child("expose").Child("service").Child("grpcPort"), notSupported))
+	}
+	if spec.Expose.Service.MetricsPort != nil {
+		errList = append(errList, field.Forbidden(fldPath.Child("expose").Child("service").Child("metricsPort"), notSupported))
+	}
+
+	// The NIM containers listen on consecutive ports from the base port, the model of the storage first.
+	if port := spec.Expose.Service.Port; port != nil &&
+		*port >= appsv1alpha1.GetMultiModelPort(0) && *port <= appsv1alpha1.GetMultiModelPort(len(spec.MultiModel.Models)) {
+		errList = append(errList, field.Invalid(fldPath.Child("expose").Child("service").Child("port"), *port,
+			fmt.Sprintf("must not be within the ports %d-%d of the NIM containers", appsv1alpha1.GetMultiModelPort(0), appsv1alpha1.GetMultiModelPort(len(spec.MultiModel.Models)))))
+	}
+
+	modelsPath := multiModelPath.Child("models")
+	names := map[string]struct{}{appsv1alpha1.DefaultModelRouterContainerName: {}}
+	for i, model := range spec.MultiModel.Models {
+		idxPath := modelsPath.Index(i)
+		if _, exists := names[model.Name]; exists {
+			errList = append(errList, field.Duplicate(idxPath.Child("name"), model.Name))
+		}
+		names[model.Name] = struct{}{}
+		if model.NIMCache.Name == "" {
+			errList = append(errList, field.Required(idxPath.Child("nimCache").Child("name"), "is required"))
+		}
+		if model.NIMCache.Namespace != "" {
+			errList = append(errList, field.Forbidden(idxPath.Child("nimCache").Child("namespace"), "the NIMCache of an additional model must be in the namespace of the NIMService"))
+		}
+		if model.Image != nil {
+			errList = append(errList, validateImageConfiguration(model.Image, idxPath.Child("image"))...)
+		}
+		errList = append(errList, validateResourcesConfiguration(model.Resources, idxPath.Child("resources"))...)
+	}
+	if spec.MultiModel.Router.Image != nil {
+		errList = append(errList, validateImageConfiguration(spec.MultiModel.Router.Image, multiModelPath.Child("router").Child("image"))...)
+	}
+	return errList
+}
+
+// validateNIMCacheGrant ensures that a NIMCache referenced from another namespace is granted to the NIMService namespace,
+// and is not cached in an object store whose credentials only exist in the NIMCache namespace.
+// Grants are not checked when no client is configured, the controller still enforces them on reconcile.
+func validateNIMCacheGrant(ctx context.Context, reader client.Reader, nimService *appsv1alpha1.NIMService, fldPath *field.Path) field.ErrorList {
+	errList := field.ErrorList{}
+	if reader == nil || !nimService.IsCrossNamespaceNIMCache() || nimService.Spec.Storage.NIMCache.Name == "" {
+		return errList
+	}
+
+	nimCache := types.NamespacedName{Name: nimService.Spec.Storage.NIMCache.Name, Namespace: nimService.GetNIMCacheNamespace()}
+	granted, err := shared.IsNIMCacheGranted(ctx, reader, nimService.GetNamespace(), nimCache)
+	if err != nil {
+		errList = append(errList, field.InternalError(fldPath.Child("namespace"), err))
+	} else if !granted {
+		errList = append(errList, field.Forbidden(fldPath.Child("namespace"), fmt.Sprintf("no NIMCacheGrant in namespace %s allows namespace %s to reference NIMCache %s", nimCache.Namespace, nimService.GetNamespace(), nimCache.Name)))
+		return errList
+	}
+
+	cache := &appsv1alpha1.NIMCache{}
+	if err := reader.Get(ctx, nimCache, cache); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			errList = append(errList, field.InternalError(fldPath.Child("namespace"), err))
+		}
+		return errList
+	}
+	if cache.IsObjectStoreEnabled() {
+		errList = append(errList, field.Forbidden(fldPath.Child("namespace"), fmt.Sprintf("NIMCache %s is cached in an object store whose credentials are not shared with namespace %s", nimCache, nimService.GetNamespace())))
+	}
 	return errList
 }
 
@@ -17,12 +17,18 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	appsv1alpha1 "github.com/NVIDIA/k8s-nim-operator/api/apps/v1alpha1"
 )
@@ -220,7 +226,7 @@ func TestValidateDRAResourcesConfiguration(t *testing.T) {
 			},
 			k8sVersion:  "v1.34.0",
 			wantErrs:    1,
-			wantErrMsgs: []string{"spec.draResources[0]: Invalid value: \"multiple dra resource sources defined\": must specify exactly one of spec.resourceClaimName, spec.resourceClaimTemplateName, or spec.claimCreationSpec"},
+			wantErrMsgs: []string{"spec.draResources[0]: Invalid value: \"multiple dra resource sources defined\": must specify exactly one of spec.resourceClaimName, spec.resourceClaimTemplateName, spec.claimCreationSpec, or spec.auto"},
 		},
 		{
 			name: "both name and claimCreationSpec provided",
@@ -239,7 +245,7 @@ func TestValidateDRAResourcesConfiguration(t *testing.T) {
 			},
 			k8sVersion:  "v1.34.0",
 			wantErrs:    1,
-			wantErrMsgs: []string{"spec.draResources[0]: Invalid value: \"multiple dra resource sources defined\": must specify exactly one of spec.resourceClaimName, spec.resourceClaimTemplateName, or spec.claimCreationSpec"},
+			wantErrMsgs: []string{"spec.draResources[0]: Invalid value: \"multiple dra resource sources defined\": must specify exactly one of spec.resourceClaimName, spec.resourceClaimTemplateName, spec.claimCreationSpec, or spec.auto"},
 		},
 		{
 			name: "both template and claimCreationSpec provided",
@@ -258,7 +264,7 @@ func TestValidateDRAResourcesConfiguration(t *testing.T) {
 			},
 			k8sVersion:  "v1.34.0",
 			wantErrs:    1,
-			wantErrMsgs: []string{"spec.draResources[0]: Invalid value: \"multiple dra resource sources defined\": must specify exactly one of spec.resourceClaimName, spec.resourceClaimTemplateName, or spec.claimCreationSpec"},
+			wantErrMsgs: []string{"spec.draResources[0]: Invalid value: \"multiple dra resource sources defined\": must specify exactly one of spec.resourceClaimName, spec.resourceClaimTemplateName, spec.claimCreationSpec, or spec.auto"},
 		},
 		{
 			name: "all three fields provided",
@@ -278,7 +284,7 @@ func TestValidateDRAResourcesConfiguration(t *testing.T) {
 			},
 			k8sVersion:  "v1.34.0",
 			wantErrs:    1,
-			wantErrMsgs: []string{"spec.draResources[0]: Invalid value: \"multiple dra resource sources defined\": must specify exactly one of spec.resourceClaimName, spec.resourceClaimTemplateName, or spec.claimCreationSpec"},
+			wantErrMsgs: []string{"spec.draResources[0]: Invalid value: \"multiple dra resource sources defined\": must specify exactly one of spec.resourceClaimName, spec.resourceClaimTemplateName, spec.claimCreationSpec, or spec.auto"},
 		},
 		{
 			name: "neither name nor template nor claimCreationSpec provided",
@@ -287,7 +293,7 @@ func TestValidateDRAResourcesConfiguration(t *testing.T) {
 			},
 			k8sVersion:  "v1.34.0",
 			wantErrs:    1,
-			wantErrMsgs: []string{"spec.draResources[0]: Required value: one of spec.resourceClaimName, spec.resourceClaimTemplateName, or spec.claimCreationSpec must be provided"},
+			wantErrMsgs: []string{"spec.draResources[0]: Required value: one of spec.resourceClaimName, spec.resourceClaimTemplateName, spec.claimCreationSpec, or spec.auto must be provided"},
 		},
 		{
 			name: "resourceClaimName with replicas>1",
@@ -739,6 +745,196 @@ func TestValidateDRAResourcesConfiguration(t *testing.T) {
 			wantErrs:    0,
 			wantErrMsgs: nil,
 		},
+		{
+			name: "valid claimCreationSpec with time-sliced MIG partitions",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.DRAResources = []appsv1alpha1.DRAResource{{
+					ClaimCreationSpec: &appsv1alpha1.DRAClaimCreationSpec{
+						Devices: []appsv1alpha1.DRADeviceSpec{{
+							Name:            "gpu",
+							Count:           1,
+							DeviceClassName: "gpu.nvidia.com",
+							DriverName:      "gpu.nvidia.com",
+							MIG:             &appsv1alpha1.DRAMIGSpec{Profile: "3g.40gb"},
+							Sharing:         &appsv1alpha1.DRADeviceSharingSpec{Strategy: appsv1alpha1.DRADeviceSharingStrategyTimeSlicing},
+						}},
+					},
+				}}
+			},
+			k8sVersion:  "v1.34.0",
+			wantErrs:    0,
+			wantErrMsgs: nil,
+		},
+		{
+			name: "claimCreationSpec with invalid MIG profile",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.DRAResources = []appsv1alpha1.DRAResource{{
+					ClaimCreationSpec: &appsv1alpha1.DRAClaimCreationSpec{
+						Devices: []appsv1alpha1.DRADeviceSpec{{
+							Name:            "gpu",
+							Count:           1,
+							DeviceClassName: "gpu.nvidia.com",
+							DriverName:      "gpu.nvidia.com",
+							MIG:             &appsv1alpha1.DRAMIGSpec{Profile: "40gb"},
+						}},
+					},
+				}}
+			},
+			k8sVersion:  "v1.34.0",
+			wantErrs:    1,
+			wantErrMsgs: []string{"spec.draResources[0].claimCreationSpec.devices[0].mig.profile: Invalid value: \"40gb\": must be a valid MIG profile, e.g. 1g.10gb"},
+		},
+		{
+			name: "claimCreationSpec with time-slicing interval for MIG partitions",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.DRAResources = []appsv1alpha1.DRAResource{{
+					ClaimCreationSpec: &appsv1alpha1.DRAClaimCreationSpec{
+						Devices: []appsv1alpha1.DRADeviceSpec{{
+							Name:            "gpu",
+							Count:           1,
+							DeviceClassName: "gpu.nvidia.com",
+							DriverName:      "gpu.nvidia.com",
+							MIG:             &appsv1alpha1.DRAMIGSpec{Profile: "1g.10gb"},
+							Sharing: &appsv1alpha1.DRADeviceSharingSpec{
+								Strategy:            appsv1alpha1.DRADeviceSharingStrategyTimeSlicing,
+								TimeSlicingInterval: appsv1alpha1.DRATimeSlicingIntervalLong,
+							},
+						}},
+					},
+				}}
+			},
+			k8sVersion:  "v1.34.0",
+			wantErrs:    1,
+			wantErrMsgs: []string{"spec.draResources[0].claimCreationSpec.devices[0].sharing.timeSlicingInterval: Forbidden: is not supported for MIG partitions"},
+		},
+		{
+			name: "claimCreationSpec with MPS settings for time-sliced GPUs",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.DRAResources = []appsv1alpha1.DRAResource{{
+					ClaimCreationSpec: &appsv1alpha1.DRAClaimCreationSpec{
+						Devices: []appsv1alpha1.DRADeviceSpec{{
+							Name:            "gpu",
+							Count:           1,
+							DeviceClassName: "gpu.nvidia.com",
+							DriverName:      "gpu.nvidia.com",
+							Sharing: &appsv1alpha1.DRADeviceSharingSpec{
+								Strategy:                  appsv1alpha1.DRADeviceSharingStrategyTimeSlicing,
+								MPSActiveThreadPercentage: ptr.To[int32](50),
+							},
+						}},
+					},
+				}}
+			},
+			k8sVersion:  "v1.34.0",
+			wantErrs:    1,
+			wantErrMsgs: []string{"spec.draResources[0].claimCreationSpec.devices[0].sharing.mpsActiveThreadPercentage: Forbidden: is only supported when spec.draResources[0].claimCreationSpec.devices[0].sharing.strategy is MPS"},
+		},
+		{
+			name: "claimCreationSpec with invalid MPS settings",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.DRAResources = []appsv1alpha1.DRAResource{{
+					ClaimCreationSpec: &appsv1alpha1.DRAClaimCreationSpec{
+						Devices: []appsv1alpha1.DRADeviceSpec{{
+							Name:            "gpu",
+							Count:           1,
+							DeviceClassName: "gpu.nvidia.com",
+							DriverName:      "gpu.nvidia.com",
+							Sharing: &appsv1alpha1.DRADeviceSharingSpec{
+								Strategy:                  appsv1alpha1.DRADeviceSharingStrategyMPS,
+								MPSActiveThreadPercentage: ptr.To[int32](120),
+								MPSPinnedMemoryLimit:      resource.NewQuantity(0, resource.BinarySI),
+							},
+						}},
+					},
+				}}
+			},
+			k8sVersion: "v1.34.0",
+			wantErrs:   2,
+			wantErrMsgs: []string{
+				"spec.draResources[0].claimCreationSpec.devices[0].sharing.mpsActiveThreadPercentage: Invalid value: 120: must be between 1 and 100",
+				"spec.draResources[0].claimCreationSpec.devices[0].sharing.mpsPinnedMemoryLimit: Invalid value: \"0\": must be > 0",
+			},
+		},
+		{
+			name: "valid auto dra resource",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Storage.NIMCache = appsv1alpha1.NIMCacheVolSpec{Name: "cache", Profile: "profile"}
+				ns.Spec.DRAResources = []appsv1alpha1.DRAResource{{
+					Auto: &appsv1alpha1.DRAAutoClaimSpec{},
+				}}
+			},
+			k8sVersion:  "v1.34.0",
+			wantErrs:    0,
+			wantErrMsgs: nil,
+		},
+		{
+			name: "both claimCreationSpec and auto provided",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Storage.NIMCache = appsv1alpha1.NIMCacheVolSpec{Name: "cache", Profile: "profile"}
+				ns.Spec.DRAResources = []appsv1alpha1.DRAResource{{
+					Auto: &appsv1alpha1.DRAAutoClaimSpec{},
+					ClaimCreationSpec: &appsv1alpha1.DRAClaimCreationSpec{
+						Devices: []appsv1alpha1.DRADeviceSpec{{
+							Name:            "gpu",
+							Count:           1,
+							DeviceClassName: "gpu.nvidia.com",
+							DriverName:      "gpu.nvidia.com",
+						}},
+					},
+				}}
+			},
+			k8sVersion:  "v1.34.0",
+			wantErrs:    1,
+			wantErrMsgs: []string{"spec.draResources[0]: Invalid value: \"multiple dra resource sources defined\": must specify exactly one of spec.resourceClaimName, spec.resourceClaimTemplateName, spec.claimCreationSpec, or spec.auto"},
+		},
+		{
+			name: "auto dra resource on kserve platform",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Storage.NIMCache = appsv1alpha1.NIMCacheVolSpec{Name: "cache", Profile: "profile"}
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeKServe
+				ns.Spec.DRAResources = []appsv1alpha1.DRAResource{{
+					Auto: &appsv1alpha1.DRAAutoClaimSpec{},
+				}}
+			},
+			k8sVersion:  "v1.34.0",
+			wantErrs:    1,
+			wantErrMsgs: []string{"spec.draResources[0].auto: Forbidden: can only be set when spec.inferencePlatform is standalone"},
+		},
+		{
+			name: "auto dra resource with NIMBuild",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Storage.NIMCache = appsv1alpha1.NIMCacheVolSpec{Name: "cache", NIMBuild: "build"}
+				ns.Spec.DRAResources = []appsv1alpha1.DRAResource{{
+					Auto: &appsv1alpha1.DRAAutoClaimSpec{},
+				}}
+			},
+			k8sVersion:  "v1.34.0",
+			wantErrs:    0,
+			wantErrMsgs: nil,
+		},
+		{
+			name: "auto dra resource without NIMCache profile",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Storage.NIMCache = appsv1alpha1.NIMCacheVolSpec{Name: "cache"}
+				ns.Spec.DRAResources = []appsv1alpha1.DRAResource{{
+					Auto: &appsv1alpha1.DRAAutoClaimSpec{},
+				}}
+			},
+			k8sVersion:  "v1.34.0",
+			wantErrs:    1,
+			wantErrMsgs: []string{"spec.storage.nimCache.profile: Required value: is required when spec.draResources[0].auto is set without spec.storage.nimCache.nimBuild"},
+		},
+		{
+			name: "auto dra resource without NIMCache",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.DRAResources = []appsv1alpha1.DRAResource{{
+					Auto: &appsv1alpha1.DRAAutoClaimSpec{},
+				}}
+			},
+			k8sVersion:  "v1.34.0",
+			wantErrs:    1,
+			wantErrMsgs: []string{"spec.storage.nimCache.name: Required value: is required when spec.draResources[0].auto is set"},
+		},
 	}
 
 	for _, tc := range cases {
@@ -842,6 +1038,111 @@ func TestValidateExposeIngressConfiguration(t *testing.T) {
 	}
 }
 
+// TestValidateRouterConfiguration table-driven.
+func TestValidateRouterConfiguration(t *testing.T) {
+	fld := field.NewPath("spec").Child("expose").Child("router")
+
+	cases := []struct {
+		name     string
+		modify   func(*appsv1alpha1.NIMService)
+		wantErrs int
+	}{
+		{
+			name:     "router not set",
+			modify:   func(ns *appsv1alpha1.NIMService) {},
+			wantErrs: 0,
+		},
+		{
+			name: "valid router",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Expose.Router = &appsv1alpha1.Router{
+					Gateway:   appsv1alpha1.GatewayReference{Name: "inference-gateway", Namespace: "gateway"},
+					Hostnames: []string{"llm.example.com"},
+				}
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "missing gateway name",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Expose.Router = &appsv1alpha1.Router{}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "duplicate hostnames",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Expose.Router = &appsv1alpha1.Router{
+					Gateway:   appsv1alpha1.GatewayReference{Name: "inference-gateway"},
+					Hostnames: []string{"llm.example.com", "llm.example.com"},
+				}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "valid inference pool",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Expose.Router = &appsv1alpha1.Router{
+					Gateway: appsv1alpha1.GatewayReference{Name: "inference-gateway"},
+					InferencePool: &appsv1alpha1.InferencePoolRouting{
+						Name:           "llama",
+						EndpointPicker: appsv1alpha1.EndpointPickerReference{Name: "llama-epp"},
+					},
+				}
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "inference pool without endpoint picker",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Expose.Router = &appsv1alpha1.Router{
+					Gateway:       appsv1alpha1.GatewayReference{Name: "inference-gateway"},
+					InferencePool: &appsv1alpha1.InferencePoolRouting{Name: "llama"},
+				}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "inference pool with multi-node",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.MultiNode = &appsv1alpha1.NimServiceMultiNodeConfig{}
+				ns.Spec.Expose.Router = &appsv1alpha1.Router{
+					Gateway: appsv1alpha1.GatewayReference{Name: "inference-gateway"},
+					InferencePool: &appsv1alpha1.InferencePoolRouting{
+						Name:           "llama",
+						EndpointPicker: appsv1alpha1.EndpointPickerReference{Name: "llama-epp"},
+					},
+				}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "kserve platform",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeKServe
+				ns.Spec.Expose.Router = &appsv1alpha1.Router{
+					Gateway: appsv1alpha1.GatewayReference{Name: "inference-gateway"},
+				}
+			},
+			wantErrs: 1,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ns := baseNIMService()
+			tc.modify(ns)
+			errs := validateRouterConfiguration(&ns.Spec, fld)
+			if got := len(errs); got != tc.wantErrs {
+				t.Logf("Validation errors:")
+				for i, err := range errs {
+					t.Logf("  %d: %s", i+1, err.Error())
+				}
+				t.Fatalf("got %d errs, want %d", got, tc.wantErrs)
+			}
+		})
+	}
+}
+
 // TestValidateMetricsConfiguration table-driven.
 func TestValidateMetricsConfiguration(t *testing.T) {
 	fld := field.NewPath("spec").Child("metrics")
@@ -902,6 +1203,56 @@ func TestValidateScaleConfiguration(t *testing.T) {
 		{"autoscaling disabled", func(ns *appsv1alpha1.NIMService) {}, 0},
 		{"enabled empty HPA", func(ns *appsv1alpha1.NIMService) { ns.Spec.Scale.Enabled = &enabled }, 1},
 		{"enabled valid HPA", func(ns *appsv1alpha1.NIMService) { ns.Spec.Scale.Enabled = &enabled; ns.Spec.Scale.HPA.MaxReplicas = 3 }, 0},
+		{"KEDA backend without keda", func(ns *appsv1alpha1.NIMService) {
+			ns.Spec.Scale.Enabled = &enabled
+			ns.Spec.Scale.Backend = appsv1alpha1.AutoscalingBackendKEDA
+		}, 1},
+		{"KEDA backend valid", func(ns *appsv1alpha1.NIMService) {
+			ns.Spec.Scale.Enabled = &enabled
+			ns.Spec.Scale.Backend = appsv1alpha1.AutoscalingBackendKEDA
+			ns.Spec.Scale.KEDA = &appsv1alpha1.KEDAScaledObjectSpec{
+				MaxReplicas: 4,
+				Triggers:    []appsv1alpha1.KEDAPrometheusTrigger{{Metric: appsv1alpha1.NIMMetricQueueDepth, Threshold: "10"}},
+			}
+		}, 0},
+		{"KEDA trigger with metric and query", func(ns *appsv1alpha1.NIMService) {
+			ns.Spec.Scale.Enabled = &enabled
+			ns.Spec.Scale.Backend = appsv1alpha1.AutoscalingBackendKEDA
+			ns.Spec.Scale.KEDA = &appsv1alpha1.KEDAScaledObjectSpec{
+				MaxReplicas: 4,
+				Triggers:    []appsv1alpha1.KEDAPrometheusTrigger{{Metric: appsv1alpha1.NIMMetricQueueDepth, Query: "sum(up)", Threshold: "10"}},
+			}
+		}, 1},
+		{"KEDA minReplicas above maxReplicas", func(ns *appsv1alpha1.NIMService) {
+			ns.Spec.Scale.Enabled = &enabled
+			ns.Spec.Scale.Backend = appsv1alpha1.AutoscalingBackendKEDA
+			ns.Spec.Scale.KEDA = &appsv1alpha1.KEDAScaledObjectSpec{
+				MinReplicas: ptr.To[int32](5),
+				MaxReplicas: 4,
+				Triggers:    []appsv1alpha1.KEDAPrometheusTrigger{{Metric: appsv1alpha1.NIMMetricRequestsWaiting, Threshold: "10"}},
+			}
+		}, 1},
+		{"KEDA scale to zero on NIM metrics only", func(ns *appsv1alpha1.NIMService) {
+			ns.Spec.Scale.Enabled = &enabled
+			ns.Spec.Scale.Backend = appsv1alpha1.AutoscalingBackendKEDA
+			ns.Spec.Scale.KEDA = &appsv1alpha1.KEDAScaledObjectSpec{
+				MinReplicas: ptr.To[int32](0),
+				MaxReplicas: 4,
+				Triggers:    []appsv1alpha1.KEDAPrometheusTrigger{{Metric: appsv1alpha1.NIMMetricKVCacheUtilization, Threshold: "0.8"}},
+			}
+		}, 1},
+		{"KEDA scale to zero on gateway requests", func(ns *appsv1alpha1.NIMService) {
+			ns.Spec.Scale.Enabled = &enabled
+			ns.Spec.Scale.Backend = appsv1alpha1.AutoscalingBackendKEDA
+			ns.Spec.Scale.KEDA = &appsv1alpha1.KEDAScaledObjectSpec{
+				MinReplicas: ptr.To[int32](0),
+				MaxReplicas: 4,
+				Triggers: []appsv1alpha1.KEDAPrometheusTrigger{
+					{Metric: appsv1alpha1.NIMMetricQueueDepth, Threshold: "10"},
+					{Query: "sum(rate(envoy_cluster_upstream_rq_total[1m]))", Threshold: "5", ActivationThreshold: "0.1"},
+				},
+			}
+		}, 0},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1048,8 +1399,18 @@ func TestValidateKServeConfiguration(t *testing.T) {
 			name: "kserve serverless (annotation present) – autoscaling set",
 			modify: func(ns *appsv1alpha1.NIMService) {
 				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeKServe
-				ns.Spec.Annotations = map[string]string{"serving.kserve.org/deploymentMode": "Serverless"}
+				ns.Spec.Annotations = map[string]string{"serving.kserve.io/deploymentMode": "Serverless"}
+				ns.Spec.Scale.Enabled = &trueVal
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "kserve raw deployment – KEDA backend",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeKServe
+				ns.Spec.Annotations = map[string]string{"serving.kserve.io/deploymentMode": "RawDeployment"}
 				ns.Spec.Scale.Enabled = &trueVal
+				ns.Spec.Scale.Backend = appsv1alpha1.AutoscalingBackendKEDA
 			},
 			wantErrs: 1,
 		},
@@ -1082,32 +1443,97 @@ func TestValidateKServeConfiguration(t *testing.T) {
 			wantErrs: 3,
 		},
 		{
-			name: "kserve rawdeployment – allowed autoscaling, but multidnode forbidden",
+			name: "kserve rawdeployment – multinode allowed",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeKServe
+				ns.Spec.Annotations = map[string]string{"serving.kserve.io/deploymentMode": "RawDeployment"}
+				ns.Spec.MultiNode = &appsv1alpha1.NimServiceMultiNodeConfig{Parallelism: &appsv1alpha1.ParallelismSpec{Pipeline: ptr.To(uint32(2))}}
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "kserve rawdeployment – multinode with several replicas and a single node",
 			modify: func(ns *appsv1alpha1.NIMService) {
 				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeKServe
-				ns.Spec.Annotations = map[string]string{"serving.kserve.org/deploymentMode": "RawDeployment"}
-				ns.Spec.Scale.Enabled = &trueVal // should be fine
+				ns.Spec.KServe = &appsv1alpha1.KServeSpec{DeploymentMode: appsv1alpha1.KServeDeploymentModeRawDeployment}
+				ns.Spec.Replicas = 2
 				ns.Spec.MultiNode = &appsv1alpha1.NimServiceMultiNodeConfig{Parallelism: &appsv1alpha1.ParallelismSpec{Pipeline: ptr.To(uint32(1))}}
 			},
-			wantErrs: 1, // only multiNode should trigger
+			wantErrs: 2,
 		},
 		{
-			name: "kserve – multidnode alone",
+			name: "kserve serverless – scale to zero",
 			modify: func(ns *appsv1alpha1.NIMService) {
 				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeKServe
-				ns.Spec.MultiNode = &appsv1alpha1.NimServiceMultiNodeConfig{Parallelism: &appsv1alpha1.ParallelismSpec{Pipeline: ptr.To(uint32(2))}}
+				ns.Spec.KServe = &appsv1alpha1.KServeSpec{
+					DeploymentMode: appsv1alpha1.KServeDeploymentModeServerless,
+					Serverless: &appsv1alpha1.KServeServerlessSpec{
+						MinScale:               ptr.To[int32](0),
+						MaxScale:               ptr.To[int32](4),
+						Target:                 ptr.To[int32](8),
+						ScaleToZeroGracePeriod: &metav1.Duration{Duration: 5 * time.Minute},
+					},
+				}
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "kserve options on standalone platform",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeStandalone
+				ns.Spec.KServe = &appsv1alpha1.KServeSpec{DeploymentMode: appsv1alpha1.KServeDeploymentModeServerless}
 			},
 			wantErrs: 1,
 		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			ns := baseNIMService()
-			// Ensure nested structs are initialised to avoid nil panics when we set sub-fields.
-			ns.Spec.Scale = appsv1alpha1.Autoscaling{}
-			ns.Spec.Expose = appsv1alpha1.Expose{}
-			ns.Spec.Expose.Ingress = appsv1alpha1.Ingress{}
+		{
+			name: "kserve deployment mode conflicts with annotation",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeKServe
+				ns.Spec.Annotations = map[string]string{"serving.kserve.io/deploymentMode": "Serverless"}
+				ns.Spec.KServe = &appsv1alpha1.KServeSpec{DeploymentMode: appsv1alpha1.KServeDeploymentModeRawDeployment}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "kserve raw deployment – serverless options set",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeKServe
+				ns.Spec.Annotations = map[string]string{"serving.kserve.io/deploymentMode": "RawDeployment"}
+				ns.Spec.KServe = &appsv1alpha1.KServeSpec{Serverless: &appsv1alpha1.KServeServerlessSpec{MinScale: ptr.To[int32](0)}}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "kserve serverless – invalid scale bounds and grace period without scale to zero",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeKServe
+				ns.Spec.KServe = &appsv1alpha1.KServeSpec{
+					Serverless: &appsv1alpha1.KServeServerlessSpec{
+						MinScale:               ptr.To[int32](3),
+						MaxScale:               ptr.To[int32](2),
+						ScaleToZeroGracePeriod: &metav1.Duration{Duration: time.Minute},
+					},
+				}
+			},
+			wantErrs: 2,
+		},
+		{
+			name: "kserve – multidnode alone",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeKServe
+				ns.Spec.MultiNode = &appsv1alpha1.NimServiceMultiNodeConfig{Parallelism: &appsv1alpha1.ParallelismSpec{Pipeline: ptr.To(uint32(2))}}
+			},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ns := baseNIMService()
+			// Ensure nested structs are initialised to avoid nil panics when we set sub-fields.
+			ns.Spec.Scale = appsv1alpha1.Autoscaling{}
+			ns.Spec.Expose = appsv1alpha1.Expose{}
+			ns.Spec.Expose.Ingress = appsv1alpha1.Ingress{}
 			ns.Spec.Metrics = appsv1alpha1.Metrics{}
 
 			tc.modify(ns)
@@ -1122,3 +1548,429 @@ func TestValidateKServeConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRolloutConfiguration(t *testing.T) {
+	fld := field.NewPath("spec")
+
+	tests := []struct {
+		name     string
+		modify   func(*appsv1alpha1.NIMService)
+		wantErrs int
+	}{
+		{
+			name:     "rollout unset – no errors",
+			modify:   func(ns *appsv1alpha1.NIMService) {},
+			wantErrs: 0,
+		},
+		{
+			name: "canary on standalone – valid",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Rollout = &appsv1alpha1.NIMServiceRolloutSpec{
+					Strategy: appsv1alpha1.RolloutStrategyCanary,
+					Canary:   &appsv1alpha1.CanaryRolloutSpec{Weight: 20},
+				}
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "blue/green with canary settings",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Rollout = &appsv1alpha1.NIMServiceRolloutSpec{
+					Strategy: appsv1alpha1.RolloutStrategyBlueGreen,
+					Canary:   &appsv1alpha1.CanaryRolloutSpec{Weight: 20},
+				}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "blue/green on kserve",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeKServe
+				ns.Spec.Rollout = &appsv1alpha1.NIMServiceRolloutSpec{Strategy: appsv1alpha1.RolloutStrategyBlueGreen}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "canary with multinode",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.MultiNode = &appsv1alpha1.NimServiceMultiNodeConfig{Parallelism: &appsv1alpha1.ParallelismSpec{Pipeline: ptr.To(uint32(2))}}
+				ns.Spec.Rollout = &appsv1alpha1.NIMServiceRolloutSpec{Strategy: appsv1alpha1.RolloutStrategyCanary}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "rolling update with multinode – valid",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.MultiNode = &appsv1alpha1.NimServiceMultiNodeConfig{Parallelism: &appsv1alpha1.ParallelismSpec{Pipeline: ptr.To(uint32(2))}}
+				ns.Spec.Rollout = &appsv1alpha1.NIMServiceRolloutSpec{Strategy: appsv1alpha1.RolloutStrategyRollingUpdate}
+			},
+			wantErrs: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ns := baseNIMService()
+			tc.modify(ns)
+
+			errs := validateRolloutConfiguration(&ns.Spec, fld)
+			if got := len(errs); got != tc.wantErrs {
+				for i, err := range errs {
+					t.Logf("  %d: %s", i+1, err.Error())
+				}
+				t.Fatalf("got %d errs, want %d", got, tc.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateLoRAConfiguration(t *testing.T) {
+	fld := field.NewPath("spec")
+
+	tests := []struct {
+		name     string
+		modify   func(*appsv1alpha1.NIMService)
+		wantErrs int
+	}{
+		{
+			name:     "lora unset – no errors",
+			modify:   func(ns *appsv1alpha1.NIMService) {},
+			wantErrs: 0,
+		},
+		{
+			name: "adapters from pvc and nimcache – valid",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.LoRA = &appsv1alpha1.LoRASpec{
+					Adapters: []appsv1alpha1.LoRAAdapter{
+						{Name: "math", PVC: &appsv1alpha1.LoRAPVCSource{Name: "lora-pvc", SubPath: "math"}},
+						{Name: "squad", NIMCache: &appsv1alpha1.LoRANIMCacheSource{Name: "squad-cache"}},
+					},
+				}
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "duplicate adapter names",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.LoRA = &appsv1alpha1.LoRASpec{
+					Adapters: []appsv1alpha1.LoRAAdapter{
+						{Name: "math", PVC: &appsv1alpha1.LoRAPVCSource{Name: "lora-pvc", SubPath: "math"}},
+						{Name: "math", NIMCache: &appsv1alpha1.LoRANIMCacheSource{Name: "math-cache"}},
+					},
+				}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "adapters with multinode",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.MultiNode = &appsv1alpha1.NimServiceMultiNodeConfig{Parallelism: &appsv1alpha1.ParallelismSpec{Pipeline: ptr.To(uint32(2))}}
+				ns.Spec.LoRA = &appsv1alpha1.LoRASpec{
+					Adapters: []appsv1alpha1.LoRAAdapter{
+						{Name: "math", PVC: &appsv1alpha1.LoRAPVCSource{Name: "lora-pvc"}},
+					},
+				}
+			},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ns := baseNIMService()
+			tc.modify(ns)
+
+			errs := validateLoRAConfiguration(&ns.Spec, fld)
+			if got := len(errs); got != tc.wantErrs {
+				for i, err := range errs {
+					t.Logf("  %d: %s", i+1, err.Error())
+				}
+				t.Fatalf("got %d errs, want %d", got, tc.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateLLMDConfiguration(t *testing.T) {
+	fld := field.NewPath("spec")
+
+	tests := []struct {
+		name     string
+		modify   func(*appsv1alpha1.NIMService)
+		wantErrs int
+	}{
+		{
+			name:     "standalone platform – no errors",
+			modify:   func(ns *appsv1alpha1.NIMService) {},
+			wantErrs: 0,
+		},
+		{
+			name: "llmd options on standalone platform",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.LLMD = &appsv1alpha1.LLMDSpec{}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "llm-d platform – valid",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeLLMD
+				ns.Spec.Expose.Service.Port = ptr.To[int32](8000)
+				ns.Spec.LLMD = &appsv1alpha1.LLMDSpec{
+					Prefill: appsv1alpha1.LLMDRoleSpec{Replicas: ptr.To[int32](2)},
+				}
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "llm-d platform with multinode, autoscaling and lora",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeLLMD
+				ns.Spec.MultiNode = &appsv1alpha1.NimServiceMultiNodeConfig{}
+				ns.Spec.Scale.Enabled = ptr.To(true)
+				ns.Spec.LoRA = &appsv1alpha1.LoRASpec{
+					Adapters: []appsv1alpha1.LoRAAdapter{
+						{Name: "math", PVC: &appsv1alpha1.LoRAPVCSource{Name: "lora-pvc"}},
+					},
+				}
+			},
+			wantErrs: 3,
+		},
+		{
+			name: "llm-d platform with canary rollout",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeLLMD
+				ns.Spec.Rollout = &appsv1alpha1.NIMServiceRolloutSpec{Strategy: appsv1alpha1.RolloutStrategyCanary}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "llm-d decode target port conflicts with service port",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeLLMD
+				ns.Spec.Expose.Service.Port = ptr.To[int32](appsv1alpha1.DefaultLLMDDecodeTargetPort)
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "llm-d platform with role dra resources",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeLLMD
+				ns.Spec.LLMD = &appsv1alpha1.LLMDSpec{
+					Prefill: appsv1alpha1.LLMDRoleSpec{
+						Replicas:     ptr.To[int32](2),
+						DRAResources: []appsv1alpha1.DRAResource{{ResourceClaimTemplateName: ptr.To("prefill-gpus")}},
+					},
+					Decode: appsv1alpha1.LLMDRoleSpec{
+						DRAResources: []appsv1alpha1.DRAResource{{ResourceClaimName: ptr.To("decode-gpu")}},
+					},
+				}
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "llm-d platform with shared resource claim across prefill replicas and spec dra resources",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeLLMD
+				ns.Spec.DRAResources = []appsv1alpha1.DRAResource{{ResourceClaimTemplateName: ptr.To("gpus")}}
+				ns.Spec.LLMD = &appsv1alpha1.LLMDSpec{
+					Prefill: appsv1alpha1.LLMDRoleSpec{
+						Replicas:     ptr.To[int32](2),
+						DRAResources: []appsv1alpha1.DRAResource{{ResourceClaimName: ptr.To("prefill-gpu")}},
+					},
+				}
+			},
+			wantErrs: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ns := baseNIMService()
+			tc.modify(ns)
+
+			errs := validateLLMDConfiguration(&ns.Spec, fld, "v1.34.0")
+			if got := len(errs); got != tc.wantErrs {
+				for i, err := range errs {
+					t.Logf("  %d: %s", i+1, err.Error())
+				}
+				t.Fatalf("got %d errs, want %d", got, tc.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateNIMCacheGrant(t *testing.T) {
+	fld := field.NewPath("spec").Child("storage").Child("nimCache")
+
+	scheme := runtime.NewScheme()
+	if err := appsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	grant := &appsv1alpha1.NIMCacheGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: "models"},
+		Spec: appsv1alpha1.NIMCacheGrantSpec{
+			From: []appsv1alpha1.NIMCacheGrantFrom{{Namespace: "team-a"}},
+		},
+	}
+	objectStoreCache := &appsv1alpha1.NIMCache{
+		ObjectMeta: metav1.ObjectMeta{Name: "s3-cache", Namespace: "models"},
+		Spec: appsv1alpha1.NIMCacheSpec{
+			Storage: appsv1alpha1.NIMCacheStorage{
+				ObjectStore: &appsv1alpha1.NIMCacheObjectStore{Bucket: "models"},
+			},
+		},
+	}
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(grant, objectStoreCache).Build()
+
+	tests := []struct {
+		name     string
+		reader   client.Reader
+		modify   func(*appsv1alpha1.NIMService)
+		wantErrs int
+	}{
+		{
+			name:     "nimcache in same namespace – no errors",
+			reader:   reader,
+			modify:   func(ns *appsv1alpha1.NIMService) {},
+			wantErrs: 0,
+		},
+		{
+			name:   "granted nimcache in other namespace",
+			reader: reader,
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Storage.NIMCache.Namespace = "models"
+			},
+			wantErrs: 0,
+		},
+		{
+			name:   "nimcache in other namespace without grant",
+			reader: reader,
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Namespace = "team-b"
+				ns.Spec.Storage.NIMCache.Namespace = "models"
+			},
+			wantErrs: 1,
+		},
+		{
+			name:   "granted object store nimcache in other namespace",
+			reader: reader,
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Storage.NIMCache.Name = "s3-cache"
+				ns.Spec.Storage.NIMCache.Namespace = "models"
+			},
+			wantErrs: 1,
+		},
+		{
+			name:   "no client – grant is not checked",
+			reader: nil,
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Namespace = "team-b"
+				ns.Spec.Storage.NIMCache.Namespace = "models"
+			},
+			wantErrs: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ns := baseNIMService()
+			ns.Namespace = "team-a"
+			ns.Spec.Storage.NIMCache.Name = "shared-cache"
+			tc.modify(ns)
+
+			errs := validateNIMCacheGrant(context.Background(), tc.reader, ns, fld)
+			if got := len(errs); got != tc.wantErrs {
+				for i, err := range errs {
+					t.Logf("  %d: %s", i+1, err.Error())
+				}
+				t.Fatalf("got %d errs, want %d", got, tc.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateMultiModelConfiguration(t *testing.T) {
+	fld := field.NewPath("spec")
+	models := func() []appsv1alpha1.NIMServiceModelSpec {
+		return []appsv1alpha1.NIMServiceModelSpec{
+			{Name: "mistral", NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: "mistral-cache"}},
+			{Name: "embedding", NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: "embedding-cache", Profile: "p"}},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(*appsv1alpha1.NIMService)
+		wantErrs int
+	}{
+		{
+			name:     "no additional models – no errors",
+			modify:   func(ns *appsv1alpha1.NIMService) {},
+			wantErrs: 0,
+		},
+		{
+			name: "additional models – valid",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Expose.Service.Port = ptr.To[int32](8000)
+				ns.Spec.MultiModel = &appsv1alpha1.MultiModelSpec{Models: models()}
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "additional models on kserve platform",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.InferencePlatform = appsv1alpha1.PlatformTypeKServe
+				ns.Spec.MultiModel = &appsv1alpha1.MultiModelSpec{Models: models()}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "additional models with lora, grpc port and canary rollout",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.MultiModel = &appsv1alpha1.MultiModelSpec{Models: models()}
+				ns.Spec.LoRA = &appsv1alpha1.LoRASpec{
+					Adapters: []appsv1alpha1.LoRAAdapter{
+						{Name: "math", PVC: &appsv1alpha1.LoRAPVCSource{Name: "lora-pvc"}},
+					},
+				}
+				ns.Spec.Expose.Service.GRPCPort = ptr.To[int32](8001)
+				ns.Spec.Rollout = &appsv1alpha1.NIMServiceRolloutSpec{Strategy: appsv1alpha1.RolloutStrategyCanary}
+			},
+			wantErrs: 3,
+		},
+		{
+			name: "service port conflicts with the NIM container ports",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.Expose.Service.Port = ptr.To[int32](appsv1alpha1.DefaultMultiModelBasePort + 2)
+				ns.Spec.MultiModel = &appsv1alpha1.MultiModelSpec{Models: models()}
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "duplicate, reserved and cross-namespace models",
+			modify: func(ns *appsv1alpha1.NIMService) {
+				ns.Spec.MultiModel = &appsv1alpha1.MultiModelSpec{Models: append(models(),
+					appsv1alpha1.NIMServiceModelSpec{Name: "mistral", NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: "other-cache"}},
+					appsv1alpha1.NIMServiceModelSpec{Name: appsv1alpha1.DefaultModelRouterContainerName, NIMCache: appsv1alpha1.NIMCacheVolSpec{Name: "cache", Namespace: "shared"}},
+				)}
+			},
+			wantErrs: 3,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ns := baseNIMService()
+			tc.modify(ns)
+
+			errs := validateMultiModelConfiguration(&ns.Spec, fld)
+			if got := len(errs); got != tc.wantErrs {
+				for i, err := range errs {
+					t.Logf("  %d: %s", i+1, err.Error())
+				}
+				t.Fatalf("got %d errs, want %d", got, tc.wantErrs)
+			}
+		})
+	}
+}